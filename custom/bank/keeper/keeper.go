@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	accountkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -20,7 +21,9 @@ import (
 type Keeper struct {
 	bankkeeper.BaseKeeper
 
-	ak   furyakeeper.Keeper
+	// ak is shared by all copies of the keeper since other modules receive the bank keeper before the furya keeper
+	// is registered
+	ak   *furyakeeper.Keeper
 	sk   banktypes.StakingKeeper
 	acck accountkeeper.AccountKeeper
 }
@@ -38,7 +41,7 @@ func NewBaseKeeper(
 ) Keeper {
 	keeper := Keeper{
 		BaseKeeper: bankkeeper.NewBaseKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
-		ak:         furyakeeper.Keeper{},
+		ak:         &furyakeeper.Keeper{},
 		sk:         stakingkeeper.Keeper{},
 		acck:       ak,
 	}
@@ -46,7 +49,7 @@ func NewBaseKeeper(
 }
 
 func (k *Keeper) RegisterKeepers(ak furyakeeper.Keeper, sk banktypes.StakingKeeper) {
-	k.ak = ak
+	*k.ak = ak
 	k.sk = sk
 }
 
@@ -92,3 +95,106 @@ func (k Keeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequ
 
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
+
+// SendCoins settles the rewards of tokenized furya delegations held by both accounts before sending coins
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !hasTokenizedShares(amt) {
+		return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	}
+	if err := k.ak.BeforeTokenizedSharesTransfer(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins settles the rewards of tokenized furya delegations held by all accounts before sending coins
+func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	var addrs []sdk.AccAddress
+	coins := sdk.NewCoins()
+	for _, in := range inputs {
+		coins = coins.Add(in.Coins...)
+	}
+	if !hasTokenizedShares(coins) {
+		return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	}
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	if err := k.ak.BeforeTokenizedSharesTransfer(ctx, addrs, coins); err != nil {
+		return err
+	}
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount settles the rewards of tokenized furya delegations held by the module and the account
+// before sending coins
+func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !hasTokenizedShares(amt) {
+		return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	}
+	senderAddr := k.acck.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+	if err := k.ak.BeforeTokenizedSharesTransfer(ctx, []sdk.AccAddress{senderAddr, recipientAddr}, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule settles the rewards of tokenized furya delegations held by the account and the module
+// before sending coins
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if !hasTokenizedShares(amt) {
+		return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	}
+	recipientAddr := k.acck.GetModuleAddress(recipientModule)
+	if recipientAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+	if err := k.ak.BeforeTokenizedSharesTransfer(ctx, []sdk.AccAddress{senderAddr, recipientAddr}, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToModule settles the rewards of tokenized furya delegations held by both modules before sending coins
+func (k Keeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if !hasTokenizedShares(amt) {
+		return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	}
+	senderAddr := k.acck.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+	recipientAddr := k.acck.GetModuleAddress(recipientModule)
+	if recipientAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+	if err := k.ak.BeforeTokenizedSharesTransfer(ctx, []sdk.AccAddress{senderAddr, recipientAddr}, amt); err != nil {
+		return err
+	}
+	return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+// hasTokenizedShares returns true if any of the coins are tokenized furya delegations, which are the only coins
+// whose transfers need to settle rewards
+func hasTokenizedShares(coins sdk.Coins) bool {
+	for _, coin := range coins {
+		if furyatypes.IsTokenizedDenom(coin.Denom) {
+			return true
+		}
+	}
+	return false
+}
@@ -20,10 +20,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		panic(fmt.Errorf("Failed to complete undelegations from x/furya module: %s", err))
 	}
 
+	k.ForceUndelegationHook(ctx)
+	k.AutoCompoundHook(ctx)
+	k.IncentiveHook(ctx)
+	k.RewardDustSweepHook(ctx)
+
 	assets := k.GetAllAssets(ctx)
 	if _, err := k.DeductAssetsHook(ctx, assets); err != nil {
 		panic(fmt.Errorf("Failed to deduct take rate from furya in x/furya module: %s", err))
 	}
+	k.RewardWeightScheduleHook(ctx, assets)
 	k.RewardWeightChangeHook(ctx, assets)
 	if err := k.RebalanceHook(ctx, assets); err != nil {
 		panic(fmt.Errorf("Failed to rebalance assets in x/furya module: %s", err))
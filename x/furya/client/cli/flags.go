@@ -0,0 +1,28 @@
+package cli
+
+const (
+	FlagMinDelegationAmount = "min-delegation-amount"
+	FlagMaxTotalTokens      = "max-total-tokens"
+	FlagAllowedDenoms       = "allowed-denoms"
+	FlagBlockedDenoms       = "blocked-denoms"
+	FlagAllowedValidators   = "allowed-validators"
+	FlagDenyValidators      = "deny-validators"
+	FlagMaxTokens           = "max-tokens"
+	FlagExpiration          = "expiration"
+	FlagPricePegTargetRatio = "price-peg-target-ratio"
+	FlagPricePegMinWeight   = "price-peg-min-weight"
+	FlagPricePegMaxWeight   = "price-peg-max-weight"
+	FlagMinRewardWeight     = "min-reward-weight"
+	FlagMaxRewardWeight     = "max-reward-weight"
+	FlagSlashMultiplier     = "slash-multiplier"
+
+	FlagRewardWeightSchedule       = "reward-weight-schedule"
+	FlagRewardWeightInterpolation  = "reward-weight-interpolation"
+	FlagRewardWeightUpdateInterval = "reward-weight-update-interval"
+
+	FlagIncentiveValidator = "validator"
+
+	FlagSlashValidator = "validator"
+	FlagSlashDelegator = "delegator"
+	FlagSlashDenom     = "denom"
+)
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +9,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
 	"github.com/furya-official/furya/x/furya/types"
+	"strings"
 	"time"
 )
 
@@ -51,6 +53,31 @@ func CreateFurya() *cobra.Command {
 				return err
 			}
 
+			minDelegationAmount, maxTotalTokens, err := parseDelegationLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pricePeg, err := parsePricePegFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			minRewardWeight, maxRewardWeight, err := parseRewardWeightBoundFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightSchedule, err := parseRewardWeightScheduleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			slashMultiplier, err := parseOptionalDecFlag(cmd, FlagSlashMultiplier)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 
 			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
@@ -71,6 +98,13 @@ func CreateFurya() *cobra.Command {
 				takeRate,
 				rewardChangeRate,
 				rewardChangeInterval,
+				minDelegationAmount,
+				maxTotalTokens,
+				pricePeg,
+				minRewardWeight,
+				maxRewardWeight,
+				rewardWeightSchedule,
+				slashMultiplier,
 			)
 
 			err = content.ValidateBasic()
@@ -96,6 +130,17 @@ func CreateFurya() *cobra.Command {
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagMinDelegationAmount, "", "minimum amount that can be delegated at once, no minimum if empty")
+	cmd.Flags().String(FlagMaxTotalTokens, "", "maximum amount of tokens that can be delegated in total, no cap if empty")
+	cmd.Flags().String(FlagPricePegTargetRatio, "", "peg the reward weight to the asset price relative to the native price times this ratio, no peg if empty")
+	cmd.Flags().String(FlagPricePegMinWeight, "", "lowest reward weight that can be set by the price peg")
+	cmd.Flags().String(FlagPricePegMaxWeight, "", "highest reward weight that can be set by the price peg")
+	cmd.Flags().String(FlagMinRewardWeight, "", "lowest reward weight the reward change rate can decay to, no floor if empty")
+	cmd.Flags().String(FlagMaxRewardWeight, "", "highest reward weight the reward change rate can inflate to, no ceiling if empty")
+	cmd.Flags().String(FlagRewardWeightSchedule, "", "comma separated reward weight breakpoints formatted as RFC3339-time=weight, no schedule if empty")
+	cmd.Flags().String(FlagSlashMultiplier, "", "multiplier of the fraction delegations of the asset are slashed by, 1 if empty")
+	cmd.Flags().String(FlagRewardWeightInterpolation, "step", "how the reward weight moves between breakpoints, step or linear")
+	cmd.Flags().Duration(FlagRewardWeightUpdateInterval, time.Hour, "how often the reward weight is updated between breakpoints of a linear schedule")
 	return cmd
 }
 
@@ -139,6 +184,31 @@ func UpdateFurya() *cobra.Command {
 				return err
 			}
 
+			minDelegationAmount, maxTotalTokens, err := parseDelegationLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pricePeg, err := parsePricePegFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			minRewardWeight, maxRewardWeight, err := parseRewardWeightBoundFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightSchedule, err := parseRewardWeightScheduleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			slashMultiplier, err := parseOptionalDecFlag(cmd, FlagSlashMultiplier)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 
 			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
@@ -159,6 +229,13 @@ func UpdateFurya() *cobra.Command {
 				takeRate,
 				rewardChangeRate,
 				rewardChangeInterval,
+				minDelegationAmount,
+				maxTotalTokens,
+				pricePeg,
+				minRewardWeight,
+				maxRewardWeight,
+				rewardWeightSchedule,
+				slashMultiplier,
 			)
 
 			err = content.ValidateBasic()
@@ -184,6 +261,17 @@ func UpdateFurya() *cobra.Command {
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagMinDelegationAmount, "", "minimum amount that can be delegated at once, no minimum if empty")
+	cmd.Flags().String(FlagMaxTotalTokens, "", "maximum amount of tokens that can be delegated in total, no cap if empty")
+	cmd.Flags().String(FlagPricePegTargetRatio, "", "peg the reward weight to the asset price relative to the native price times this ratio, no peg if empty")
+	cmd.Flags().String(FlagPricePegMinWeight, "", "lowest reward weight that can be set by the price peg")
+	cmd.Flags().String(FlagPricePegMaxWeight, "", "highest reward weight that can be set by the price peg")
+	cmd.Flags().String(FlagMinRewardWeight, "", "lowest reward weight the reward change rate can decay to, no floor if empty")
+	cmd.Flags().String(FlagMaxRewardWeight, "", "highest reward weight the reward change rate can inflate to, no ceiling if empty")
+	cmd.Flags().String(FlagRewardWeightSchedule, "", "comma separated reward weight breakpoints formatted as RFC3339-time=weight, no schedule if empty")
+	cmd.Flags().String(FlagSlashMultiplier, "", "multiplier of the fraction delegations of the asset are slashed by, 1 if empty")
+	cmd.Flags().String(FlagRewardWeightInterpolation, "step", "how the reward weight moves between breakpoints, step or linear")
+	cmd.Flags().Duration(FlagRewardWeightUpdateInterval, time.Hour, "how often the reward weight is updated between breakpoints of a linear schedule")
 	return cmd
 }
 
@@ -250,3 +338,134 @@ func DeleteFurya() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	return cmd
 }
+
+func parseDelegationLimitFlags(cmd *cobra.Command) (minDelegationAmount *sdk.Int, maxTotalTokens *sdk.Int, err error) {
+	minDelegationAmount, err = parseOptionalIntFlag(cmd, FlagMinDelegationAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxTotalTokens, err = parseOptionalIntFlag(cmd, FlagMaxTotalTokens)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minDelegationAmount, maxTotalTokens, nil
+}
+
+func parsePricePegFlags(cmd *cobra.Command) (*types.RewardWeightPricePeg, error) {
+	targetRatio, err := cmd.Flags().GetString(FlagPricePegTargetRatio)
+	if err != nil {
+		return nil, err
+	}
+	if targetRatio == "" {
+		return nil, nil
+	}
+	minWeight, err := cmd.Flags().GetString(FlagPricePegMinWeight)
+	if err != nil {
+		return nil, err
+	}
+	maxWeight, err := cmd.Flags().GetString(FlagPricePegMaxWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var pricePeg types.RewardWeightPricePeg
+	if pricePeg.TargetValueRatio, err = sdk.NewDecFromStr(targetRatio); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", FlagPricePegTargetRatio, err)
+	}
+	if pricePeg.MinWeight, err = sdk.NewDecFromStr(minWeight); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", FlagPricePegMinWeight, err)
+	}
+	if pricePeg.MaxWeight, err = sdk.NewDecFromStr(maxWeight); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", FlagPricePegMaxWeight, err)
+	}
+	return &pricePeg, nil
+}
+
+func parseRewardWeightBoundFlags(cmd *cobra.Command) (minRewardWeight *sdk.Dec, maxRewardWeight *sdk.Dec, err error) {
+	minRewardWeight, err = parseOptionalDecFlag(cmd, FlagMinRewardWeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxRewardWeight, err = parseOptionalDecFlag(cmd, FlagMaxRewardWeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minRewardWeight, maxRewardWeight, nil
+}
+
+func parseRewardWeightScheduleFlags(cmd *cobra.Command) (*types.RewardWeightSchedule, error) {
+	breakpoints, err := cmd.Flags().GetString(FlagRewardWeightSchedule)
+	if err != nil {
+		return nil, err
+	}
+	if breakpoints == "" {
+		return nil, nil
+	}
+	interpolation, err := cmd.Flags().GetString(FlagRewardWeightInterpolation)
+	if err != nil {
+		return nil, err
+	}
+	updateInterval, err := cmd.Flags().GetDuration(FlagRewardWeightUpdateInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := types.RewardWeightSchedule{UpdateInterval: updateInterval}
+	switch interpolation {
+	case "step":
+		schedule.Interpolation = types.RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_STEP
+	case "linear":
+		schedule.Interpolation = types.RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR
+	default:
+		return nil, fmt.Errorf("invalid %s: %s", FlagRewardWeightInterpolation, interpolation)
+	}
+	for _, breakpoint := range strings.Split(breakpoints, ",") {
+		parts := strings.SplitN(breakpoint, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s: %s", FlagRewardWeightSchedule, breakpoint)
+		}
+		breakpointTime, err := time.Parse(time.RFC3339, parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", FlagRewardWeightSchedule, err)
+		}
+		rewardWeight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", FlagRewardWeightSchedule, err)
+		}
+		schedule.Breakpoints = append(schedule.Breakpoints, types.RewardWeightBreakpoint{
+			Time:         breakpointTime,
+			RewardWeight: rewardWeight,
+		})
+	}
+	return &schedule, nil
+}
+
+func parseOptionalIntFlag(cmd *cobra.Command, flag string) (*sdk.Int, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if str == "" {
+		return nil, nil
+	}
+	amount, ok := sdk.NewIntFromString(str)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: %s", flag, str)
+	}
+	return &amount, nil
+}
+
+func parseOptionalDecFlag(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if str == "" {
+		return nil, nil
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", flag, err)
+	}
+	return &dec, nil
+}
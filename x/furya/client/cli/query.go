@@ -34,6 +34,16 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryFuryasDelegationByValidator())
 	cmd.AddCommand(CmdQueryFuryaDelegation())
 	cmd.AddCommand(CmdQueryRewards())
+	cmd.AddCommand(CmdQueryWithdrawAddress())
+	cmd.AddCommand(CmdQueryPowerClamps())
+	cmd.AddCommand(CmdQuerySnapshotCounts())
+	cmd.AddCommand(CmdQueryAPR())
+	cmd.AddCommand(CmdQueryPrices())
+	cmd.AddCommand(CmdQueryIncentives())
+	cmd.AddCommand(CmdQueryTakeRateRevenue())
+	cmd.AddCommand(CmdQueryUnallocatedRewards())
+	cmd.AddCommand(CmdQueryRewardDust())
+	cmd.AddCommand(CmdQuerySlashes())
 
 	return cmd
 }
@@ -360,3 +370,295 @@ func CmdQueryRewards() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryWithdrawAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-address delegator_addr",
+		Short: "Query the address that receives the furya rewards of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryFuryaWithdrawAddressRequest{
+				DelegatorAddr: args[0],
+			}
+
+			res, err := query.FuryaWithdrawAddress(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPowerClamps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power-clamps",
+		Short: "Query how much the furya voting power of each validator is clamped by the power share limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaPowerClamps(context.Background(), &types.QueryFuryaPowerClampsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySnapshotCounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot-counts",
+		Short: "Query the number of reward weight change snapshots stored per furya asset and validator",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaSnapshotCounts(context.Background(), &types.QueryFuryaSnapshotCountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr denom [validator-addr]",
+		Short: "Query the estimated annualized rewards of an furya asset for a validator or weighted across all validators",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &types.QueryFuryaAPRRequest{Denom: args[0]}
+			if len(args) > 1 {
+				valAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddr = valAddr.String()
+			}
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaAPR(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices",
+		Short: "Query the latest prices posted by the furya price feeders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaPrices(context.Background(), &types.QueryFuryaPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIncentives() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "incentives [active|finished]",
+		Short:     "Query the furya incentive programs that are active or finished",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"active", "finished"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryFuryaIncentivesRequest{
+				Pagination: pageReq,
+			}
+
+			var res *types.QueryFuryaIncentivesResponse
+			switch args[0] {
+			case "active":
+				res, err = queryClient.FuryaActiveIncentives(context.Background(), req)
+			case "finished":
+				res, err = queryClient.FuryaFinishedIncentives(context.Background(), req)
+			default:
+				return fmt.Errorf("invalid incentive status %s, expected active or finished", args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentives")
+
+	return cmd
+}
+
+func CmdQueryTakeRateRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take-rate-revenue",
+		Short: "Query the cumulative take rate revenue of every furya asset",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaTakeRateRevenue(context.Background(), &types.QueryFuryaTakeRateRevenueRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryUnallocatedRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unallocated-rewards",
+		Short: "Query the rewards of validators that could not be allocated to any furya delegation yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaUnallocatedRewards(context.Background(), &types.QueryFuryaUnallocatedRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRewardDust() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-dust",
+		Short: "Query the reward truncation dust and the excess of the furya rewards pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FuryaRewardDust(context.Background(), &types.QueryFuryaRewardDustRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes",
+		Short: "Query the slashing history of furya delegations filtered by validator, delegator and denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			validator, err := cmd.Flags().GetString(FlagSlashValidator)
+			if err != nil {
+				return err
+			}
+			delegator, err := cmd.Flags().GetString(FlagSlashDelegator)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagSlashDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FuryaSlashes(context.Background(), &types.QueryFuryaSlashesRequest{
+				ValidatorAddr: validator,
+				DelegatorAddr: delegator,
+				Denom:         denom,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSlashValidator, "", "only query the slashes of this validator")
+	cmd.Flags().String(FlagSlashDelegator, "", "only query the slashes that affected this delegator")
+	cmd.Flags().String(FlagSlashDenom, "", "only query the slashes that affected this furya asset")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashes")
+
+	return cmd
+}
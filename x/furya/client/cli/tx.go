@@ -1,15 +1,20 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/furya-official/furya/x/furya/types"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/spf13/cobra"
 )
 
@@ -21,7 +26,7 @@ func NewTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	txCmd.AddCommand(NewDelegateCmd(), NewRedelegateCmd(), NewUndelegateCmd(), NewClaimDelegationRewardsCmd())
+	txCmd.AddCommand(NewDelegateCmd(), NewRedelegateCmd(), NewUndelegateCmd(), NewClaimDelegationRewardsCmd(), NewClaimAllDelegationRewardsCmd(), NewCancelUndelegationCmd(), NewSetWithdrawAddressCmd(), NewSetAutoCompoundCmd(), NewMultiDelegateCmd(), NewMultiUndelegateCmd(), NewTransferDelegationCmd(), NewTokenizeDelegationCmd(), NewRedeemTokensCmd(), NewClaimTokenRewardsCmd(), NewSetValidatorPreferencesCmd(), NewSetValidatorCommissionCmd(), NewWithdrawValidatorCommissionCmd(), NewGrantStakeAuthorizationCmd(), NewSettleRewardsCmd(), NewPostPricesCmd(), NewCreateIncentiveCmd())
 	return txCmd
 }
 
@@ -215,3 +220,891 @@ $ %s tx furya claim-rewards %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm stake --fr
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewClaimAllDelegationRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-all-rewards",
+		Args:  cobra.NoArgs,
+		Short: "claim rewards from all delegations of the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim all rewards from every delegation across all validators and denoms
+Example:
+$ %s tx furya claim-all-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgClaimAllDelegationRewards{
+				DelegatorAddress: delAddr.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelUndelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancel-undelegation validator-addr amount completion-time",
+		Args:  cobra.ExactArgs(3),
+		Short: "Cancel an undelegation and delegate the tokens back to the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an amount of an unbonding furya delegation and delegate it back to the validator.
+The undelegation is identified by its completion time in RFC3339 format.
+
+Example:
+$ %s tx furya cancel-undelegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 2022-12-01T15:04:05Z --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			completionTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelUndelegation{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           amount,
+				CompletionTime:   completionTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetWithdrawAddressCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-address withdraw-addr",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the address that receives furya rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the address that receives the furya rewards of all your delegations.
+Setting it to your own address resets it.
+
+Example:
+$ %s tx furya set-withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFuryaWithdrawAddress{
+				DelegatorAddress: delAddr.String(),
+				WithdrawAddress:  withdrawAddr.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound validator-addr denom true|false",
+		Args:  cobra.ExactArgs(3),
+		Short: "Enable or disable auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding of the rewards of a delegation.
+Rewards in denoms that are whitelisted furya assets are delegated back to the validator, other rewards are paid out.
+
+Example:
+$ %s tx furya set-auto-compound %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm stake true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgSetAutoCompound{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Denom:            args[1],
+				Enabled:          enabled,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTransferDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "transfer-delegation validator-addr recipient-addr amount",
+		Args:  cobra.ExactArgs(3),
+		Short: "Transfer a furya delegation to another account without unbonding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer some or all of a furya delegation to another account. The tokens stay delegated to the same validator.
+Pending rewards of both accounts are claimed before the transfer.
+
+Example:
+$ %s tx furya transfer-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			recipientAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgTransferDelegation{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				RecipientAddress: recipientAddr.String(),
+				Amount:           amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// multiDelegationFileEntry is a single entry of the JSON file read by the multi-delegate and multi-undelegate commands
+type multiDelegationFileEntry struct {
+	ValidatorAddress string `json:"validator_address"`
+	Amount           string `json:"amount"`
+}
+
+func parseMultiDelegationFile(path string) ([]types.MultiDelegationEntry, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileEntries []multiDelegationFileEntry
+	if err := json.Unmarshal(contents, &fileEntries); err != nil {
+		return nil, err
+	}
+
+	entries := make([]types.MultiDelegationEntry, 0, len(fileEntries))
+	for _, fileEntry := range fileEntries {
+		valAddr, err := sdk.ValAddressFromBech32(fileEntry.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := sdk.ParseCoinNormalized(fileEntry.Amount)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, types.MultiDelegationEntry{
+			ValidatorAddress: valAddr.String(),
+			Amount:           amount,
+		})
+	}
+	return entries, nil
+}
+
+func NewMultiDelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "multi-delegate [delegations-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate furya enabled tokens to many validators in a single transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate furya enabled tokens to many validators in a single transaction.
+Either all delegations succeed or none of them are applied.
+
+Example:
+$ %s tx furya multi-delegate path/to/delegations.json --from mykey
+
+Where delegations.json contains:
+[
+  {"validator_address": "%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm", "amount": "1000stake"},
+  {"validator_address": "%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj", "amount": "500stake"}
+]
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseMultiDelegationFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgMultiDelegate{
+				DelegatorAddress: delAddr.String(),
+				Delegations:      entries,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewMultiUndelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "multi-undelegate [undelegations-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Undelegate furya enabled tokens from many validators in a single transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Undelegate furya enabled tokens from many validators in a single transaction.
+Either all undelegations succeed or none of them are applied.
+
+Example:
+$ %s tx furya multi-undelegate path/to/undelegations.json --from mykey
+
+Where undelegations.json contains:
+[
+  {"validator_address": "%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm", "amount": "1000stake"},
+  {"validator_address": "%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj", "amount": "500stake"}
+]
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := parseMultiDelegationFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgMultiUndelegate{
+				DelegatorAddress: delAddr.String(),
+				Undelegations:    entries,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTokenizeDelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-delegation validator-addr amount",
+		Args:  cobra.ExactArgs(2),
+		Short: "Convert a furya delegation into transferable tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert some or all of a furya delegation into tokens of the denom furya/{validator-addr}/{denom}.
+The tokens stay delegated to the validator and earn rewards for whoever holds them.
+
+Example:
+$ %s tx furya tokenize-delegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgTokenizeFuryaDelegation{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRedeemTokensCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens amount",
+		Args:  cobra.ExactArgs(1),
+		Short: "Redeem tokenized furya shares back into a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn tokenized furya shares and receive the underlying delegation.
+
+Example:
+$ %s tx furya redeem-tokens 1000furya/%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm/stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgRedeemFuryaTokens{
+				DelegatorAddress: delAddr.String(),
+				Amount:           amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimTokenRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "claim-token-rewards token-denom",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the rewards earned by held tokenized furya shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the rewards earned by tokenized furya shares held in your wallet.
+
+Example:
+$ %s tx furya claim-token-rewards furya/%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm/stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			holderAddr := clientCtx.GetFromAddress()
+			msg := &types.MsgClaimFuryaTokenRewards{
+				HolderAddress: holderAddr.String(),
+				TokenDenom:    args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetValidatorPreferencesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-preferences",
+		Args:  cobra.NoArgs,
+		Short: "Set which furya assets can be delegated to your validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set which furya assets can be delegated to the validator operated by the signer.
+When allowed denoms are set only those can be delegated, blocked denoms can never be delegated.
+Existing delegations of assets that are no longer allowed are undelegated at the end of the block.
+Calling the command without flags removes all restrictions.
+
+Example:
+$ %s tx furya set-validator-preferences --allowed-denoms=ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from mykey
+$ %s tx furya set-validator-preferences --blocked-denoms=stake,uatom --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+			if err != nil {
+				return err
+			}
+
+			blockedDenoms, err := cmd.Flags().GetStringSlice(FlagBlockedDenoms)
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			msg := &types.MsgSetValidatorFuryaPreferences{
+				ValidatorAddress: valAddr.String(),
+				AllowedDenoms:    allowedDenoms,
+				BlockedDenoms:    blockedDenoms,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "denoms that are allowed to be delegated, all denoms are allowed if empty")
+	cmd.Flags().StringSlice(FlagBlockedDenoms, []string{}, "denoms that are not allowed to be delegated")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetValidatorCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-commission [rate] [max-rate] [max-change-rate]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the commission your validator takes from furya rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the commission the validator operated by the signer takes from the furya rewards of its delegators.
+The max rate and max change rate are fixed the first time the commission is set and must be repeated on later updates.
+The rate can only be changed once every 24 hours by at most the max change rate.
+
+Example:
+$ %s tx furya set-validator-commission 0.05 0.2 0.01 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			maxRate, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxChangeRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			msg := &types.MsgSetValidatorFuryaCommission{
+				ValidatorAddress: valAddr.String(),
+				Rate:             rate,
+				MaxRate:          maxRate,
+				MaxChangeRate:    maxChangeRate,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawValidatorCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-validator-commission",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the furya commission accrued by your validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the furya commission accrued by the validator operated by the signer.
+The commission is sent to the withdraw address of the operator account.
+
+Example:
+$ %s tx furya withdraw-validator-commission --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			msg := &types.MsgWithdrawValidatorFuryaCommission{
+				ValidatorAddress: valAddr.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewGrantStakeAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-stake-authorization [grantee] [delegate|undelegate|redelegate]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Grant an account the authorization to delegate, undelegate or redelegate furya assets on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account the authorization to execute furya delegate, undelegate or redelegate messages on behalf of the signer.
+The validators can be restricted with an allow or a deny list, for redelegations the destination validator is checked.
+When max tokens are set, only those denoms can be used and each amount decreases as it is used.
+
+Example:
+$ %s tx furya grant-stake-authorization furya1... delegate --allowed-validators=furyavaloper1... --max-tokens=1000000uatom --from mykey
+$ %s tx furya grant-stake-authorization furya1... redelegate --deny-validators=furyavaloper1... --allowed-denoms=uatom --expiration=1700000000 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authzType types.FuryaAuthorizationType
+			switch args[1] {
+			case "delegate":
+				authzType = types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_DELEGATE
+			case "undelegate":
+				authzType = types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNDELEGATE
+			case "redelegate":
+				authzType = types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_REDELEGATE
+			default:
+				return fmt.Errorf("invalid authorization type %s, expected delegate, undelegate or redelegate", args[1])
+			}
+
+			allowedValidators, err := parseValidatorsFlag(cmd, FlagAllowedValidators)
+			if err != nil {
+				return err
+			}
+
+			denyValidators, err := parseValidatorsFlag(cmd, FlagDenyValidators)
+			if err != nil {
+				return err
+			}
+
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+			if err != nil {
+				return err
+			}
+
+			maxTokensStr, err := cmd.Flags().GetString(FlagMaxTokens)
+			if err != nil {
+				return err
+			}
+			maxTokens, err := sdk.ParseCoinsNormalized(maxTokensStr)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			authorization, err := types.NewFuryaStakeAuthorization(allowedValidators, denyValidators, allowedDenoms, maxTokens, authzType)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "validators the grantee is allowed to use")
+	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "validators the grantee is not allowed to use")
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "denoms the grantee is allowed to use, all denoms are allowed if empty")
+	cmd.Flags().String(FlagMaxTokens, "", "maximum amount per denom the grantee can use")
+	cmd.Flags().Int64(FlagExpiration, 0, "expire time as unix timestamp, zero for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseValidatorsFlag(cmd *cobra.Command, flag string) ([]sdk.ValAddress, error) {
+	addresses, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, err
+	}
+	valAddrs := make([]sdk.ValAddress, len(addresses))
+	for i, address := range addresses {
+		valAddrs[i], err = sdk.ValAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return valAddrs, nil
+}
+
+func NewSettleRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-rewards [limit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Settle the accrued rewards of a batch of furya delegations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Move the accrued rewards of up to [limit] furya delegations into their pending rewards.
+Pending rewards are paid out the next time the delegator claims rewards.
+Delegations are settled in sweeps, reward weight change snapshots that are no longer referenced are pruned once a sweep completes.
+Anyone can settle rewards.
+
+Example:
+$ %s tx furya settle-rewards 100 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSettleFuryaRewards{
+				SettlerAddress: clientCtx.GetFromAddress().String(),
+				Limit:          limit,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewPostPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-prices [prices]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Post the prices of furya denoms and the native denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Post the prices of furya denoms and the native denom that are used to peg reward weights.
+Prices are quoted in the same unit for all denoms. Only the price feeders set in the module params can post prices.
+
+Example:
+$ %s tx furya post-prices 1.05ufurya,12.3ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			prices, err := sdk.ParseDecCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPostFuryaPrices{
+				FeederAddress: clientCtx.GetFromAddress().String(),
+				Prices:        prices,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-incentive [denom] [rewards] [start-time] [end-time]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Stream rewards to the delegators of a furya asset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow rewards that are streamed every block to the delegators of a furya asset between start-time and end-time.
+Times are formatted as RFC3339. Rewards that could not be distributed, e.g. because nothing was delegated, are refunded once the incentive ends.
+
+Example:
+$ %s tx furya create-incentive ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 1000000uusdc 2023-01-01T00:00:00Z 2023-02-01T00:00:00Z --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewards, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			startTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+			validator, err := cmd.Flags().GetString(FlagIncentiveValidator)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateFuryaIncentive{
+				FunderAddress:    clientCtx.GetFromAddress().String(),
+				Denom:            args[0],
+				ValidatorAddress: validator,
+				Rewards:          rewards,
+				StartTime:        startTime,
+				EndTime:          endTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagIncentiveValidator, "", "only reward the delegators of this validator, all validators if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
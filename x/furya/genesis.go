@@ -3,6 +3,8 @@ package furya
 import (
 	"github.com/furya-official/furya/x/furya/types"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ValidateGenesis
@@ -11,6 +13,9 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if params.TakeRateClaimInterval <= 0 {
 		return types.ErrInvalidGenesisState.Wrap("reward_claim_interval has to be more than 0")
 	}
+	if err := types.ValidateTakeRateDestinations(params.TakeRateDestinations); err != nil {
+		return types.ErrInvalidGenesisState.Wrap(err.Error())
+	}
 	if len(data.Delegations) > 0 && len(data.Assets) == 0 {
 		return types.ErrInvalidGenesisState.Wrap("cannot have delegations without furya assets")
 	}
@@ -20,6 +25,67 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if len(data.Redelegations) > 0 && len(data.Delegations) == 0 {
 		return types.ErrInvalidGenesisState.Wrap("cannot have redelegations without delegations")
 	}
+	for _, commission := range data.ValidatorCommissions {
+		if err := commission.Commission.Validate(); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("invalid furya commission of %s: %s", commission.ValidatorAddress, err)
+		}
+	}
+	for _, asset := range data.Assets {
+		if err := types.ValidateRewardWeightBounds(asset.MinRewardWeight, asset.MaxRewardWeight); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("invalid reward weight bounds of %s: %s", asset.Denom, err)
+		}
+		if err := types.ValidateSlashMultiplier(asset.SlashMultiplier); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("invalid slash multiplier of %s: %s", asset.Denom, err)
+		}
+		if asset.RewardWeightSchedule != nil {
+			if asset.PricePeg != nil {
+				return types.ErrInvalidGenesisState.Wrapf("%s cannot have both a price peg and a reward weight schedule", asset.Denom)
+			}
+			if err := asset.RewardWeightSchedule.Validate(); err != nil {
+				return types.ErrInvalidGenesisState.Wrapf("invalid reward weight schedule of %s: %s", asset.Denom, err)
+			}
+		}
+		if asset.PricePeg == nil {
+			continue
+		}
+		if err := asset.PricePeg.Validate(); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("invalid price peg of %s: %s", asset.Denom, err)
+		}
+	}
+	for _, price := range data.Prices {
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return types.ErrInvalidGenesisState.Wrapf("price of %s must be positive", price.Denom)
+		}
+	}
+	incentiveIDs := make(map[uint64]bool)
+	for _, incentive := range append(data.Incentives, data.FinishedIncentives...) {
+		if incentiveIDs[incentive.Id] {
+			return types.ErrInvalidGenesisState.Wrapf("duplicate furya incentive id %d", incentive.Id)
+		}
+		incentiveIDs[incentive.Id] = true
+		if !incentive.DistributedRewards.IsAllLTE(incentive.TotalRewards) {
+			return types.ErrInvalidGenesisState.Wrapf("furya incentive %d distributed more than its total rewards", incentive.Id)
+		}
+	}
+	if err := data.TakeRateRevenues.Validate(); err != nil {
+		return types.ErrInvalidGenesisState.Wrapf("invalid take rate revenues: %s", err)
+	}
+	if err := data.RewardDust.Dust.Validate(); err != nil {
+		return types.ErrInvalidGenesisState.Wrapf("invalid reward dust: %s", err)
+	}
+	if err := data.RewardDust.Swept.Validate(); err != nil {
+		return types.ErrInvalidGenesisState.Wrapf("invalid swept reward dust: %s", err)
+	}
+	slashIDs := make(map[uint64]bool)
+	for _, slash := range data.Slashes {
+		if slashIDs[slash.Id] {
+			return types.ErrInvalidGenesisState.Wrapf("duplicate furya slash id %d", slash.Id)
+		}
+		slashIDs[slash.Id] = true
+		if _, err := sdk.ValAddressFromBech32(slash.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("invalid validator address of furya slash %d: %s", slash.Id, err)
+		}
+	}
 	return nil
 }
 
@@ -29,6 +95,33 @@ func DefaultGenesisState() *types.GenesisState {
 			RewardDelayTime:       24 * 60 * 60 * 1000_000_000,
 			TakeRateClaimInterval: 5 * 60 * 1000_000_000,
 			LastTakeRateClaimTime: time.Now(),
+			AutoCompoundInterval:  24 * 60 * 60 * 1000_000_000,
+			LastAutoCompoundTime:  time.Time{},
+
+			MaxValidatorFuryaPowerShare: sdk.ZeroDec(),
+			MaxFuryaPowerShare:          sdk.ZeroDec(),
+
+			RewardIndexSampleInterval: 60 * 60 * 1000_000_000,
+			RewardIndexSampleSize:     24 * 7,
+
+			PriceFeeders: []string{},
+			MaxPriceAge:  60 * 60 * 1000_000_000,
+
+			IncentiveCreationFee:     sdk.Coins{},
+			MaxActiveIncentives:      100,
+			MaxIncentiveRewardDenoms: 3,
+
+			TakeRateDestinations: []types.TakeRateDestination{
+				{
+					Type:   types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR,
+					Weight: sdk.OneDec(),
+				},
+			},
+
+			RewardDustSweepInterval: 0,
+			LastRewardDustSweepTime: time.Time{},
+
+			SlashDestination: types.SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR,
 		},
 		Assets:                     []types.FuryaAsset{},
 		ValidatorInfos:             []types.ValidatorInfoState{},
@@ -36,5 +129,17 @@ func DefaultGenesisState() *types.GenesisState {
 		Delegations:                []types.Delegation{},
 		Redelegations:              []types.RedelegationState{},
 		Undelegations:              []types.UndelegationState{},
+		WithdrawAddresses:          []types.WithdrawAddressState{},
+		AutoCompounds:              []types.AutoCompoundState{},
+		TokenizedDelegations:       []types.TokenizedDelegation{},
+		TokenHolderRewardHistories: []types.TokenHolderRewardHistory{},
+		ValidatorPreferences:       []types.ValidatorFuryaPreferences{},
+		ValidatorCommissions:       []types.ValidatorFuryaCommission{},
+		Prices:                     []types.FuryaPrice{},
+		Incentives:                 []types.FuryaIncentive{},
+		FinishedIncentives:         []types.FuryaIncentive{},
+		TakeRateRevenues:           sdk.Coins{},
+		RewardDust:                 types.RewardDust{},
+		Slashes:                    []types.FuryaSlash{},
 	}
 }
@@ -10,6 +10,8 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k keeper.Keeper) {
 	ir.RegisterRoute(types.ModuleName, "validator-shares", ValidatorSharesInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares", DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "rewards-pool", RewardsPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
 }
 
 func RunAllInvariants(ctx sdk.Context, k keeper.Keeper) (res string, stop bool) {
@@ -18,6 +20,14 @@ func RunAllInvariants(ctx sdk.Context, k keeper.Keeper) (res string, stop bool)
 		return res, stop
 	}
 	res, stop = DelegatorSharesInvariant(k)(ctx)
+	if stop {
+		return res, stop
+	}
+	res, stop = RewardsPoolInvariant(k)(ctx)
+	if stop {
+		return res, stop
+	}
+	res, stop = ModuleBalanceInvariant(k)(ctx)
 	return res, stop
 }
 
@@ -116,3 +126,48 @@ func DelegatorSharesInvariant(k keeper.Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegations shares", msg), broken
 	}
 }
+
+// RewardsPoolInvariant checks that the rewards pool holds at least the rewards that are still owed
+func RewardsPoolInvariant(k keeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		balance := k.RewardsPoolBalance(ctx)
+		outstanding, err := k.OutstandingRewards(ctx)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("broken furya rewards pool invariance: \n"+
+				"outstanding rewards cannot be calculated: %s\n", err)
+		} else if !outstanding.IsAllLTE(balance) {
+			broken = true
+			msg += fmt.Sprintf("broken furya rewards pool invariance: \n"+
+				"rewards pool balance: %s\n"+
+				"outstanding rewards: %s\n", balance, outstanding)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "rewards pool", msg), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds the total tokens of every furya asset and the tokens of
+// the pending undelegations. The module account is not a blocked address since the distribution module withdraws the
+// rewards of the module delegations to it, so anyone can send furya assets to it with a bank transfer. Those coins are
+// not tracked by any asset which makes equality impossible to enforce and only a shortfall breaks the invariant.
+func ModuleBalanceInvariant(k keeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		balance := k.ModuleAssetBalance(ctx)
+		tracked := k.TrackedModuleAssetBalance(ctx)
+		if !tracked.IsAllLTE(balance) {
+			broken = true
+			msg += fmt.Sprintf("broken furya module balance invariance: \n"+
+				"module balance: %s\n"+
+				"total tokens and pending undelegations: %s\n", balance, tracked)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "module balance", msg), broken
+	}
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// year is the duration used to annualize rewards and take rates
+const year = time.Hour * 24 * 365
+
+// sampleRewardIndices writes the reward indices of a validator into its ring buffer of samples.
+// At most one sample is written per RewardIndexSampleInterval, the oldest sample is overwritten once the buffer is full.
+func (k Keeper) sampleRewardIndices(ctx sdk.Context, valAddr sdk.ValAddress, rewardHistories types.RewardHistories) {
+	size := uint64(k.RewardIndexSampleSize(ctx))
+	if size == 0 {
+		return
+	}
+	head, found := k.getRewardIndexSampleHead(ctx, valAddr)
+	if found && ctx.BlockTime().Before(head.LastSampleTime.Add(k.RewardIndexSampleInterval(ctx))) {
+		return
+	}
+
+	// The slot is wrapped again in case the size was reduced since the last sample
+	slot := head.NextSlot % size
+	sample := types.RewardIndexSample{
+		Time:            ctx.BlockTime(),
+		RewardHistories: rewardHistories,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardIndexSampleKey(valAddr, slot), k.cdc.MustMarshal(&sample))
+
+	head.NextSlot = (slot + 1) % size
+	head.LastSampleTime = ctx.BlockTime()
+	store.Set(types.GetRewardIndexSampleHeadKey(valAddr), k.cdc.MustMarshal(&head))
+}
+
+func (k Keeper) getRewardIndexSampleHead(ctx sdk.Context, valAddr sdk.ValAddress) (head types.RewardIndexSampleHead, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetRewardIndexSampleHeadKey(valAddr))
+	if b == nil {
+		return head, false
+	}
+	k.cdc.MustUnmarshal(b, &head)
+	return head, true
+}
+
+// GetRewardIndexSamples returns the reward index samples of a validator ordered from the oldest to the newest.
+// Samples in slots beyond the current RewardIndexSampleSize are ignored.
+func (k Keeper) GetRewardIndexSamples(ctx sdk.Context, valAddr sdk.ValAddress) (samples []types.RewardIndexSample) {
+	size := uint64(k.RewardIndexSampleSize(ctx))
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetRewardIndexSamplesKey(valAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, slot := types.ParseRewardIndexSampleKey(iter.Key())
+		if slot >= size {
+			continue
+		}
+		var sample types.RewardIndexSample
+		k.cdc.MustUnmarshal(iter.Value(), &sample)
+		samples = append(samples, sample)
+	}
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+	return samples
+}
+
+// EstimateValidatorAPR estimates the rewards per year and token of an asset delegated to a validator from the growth of
+// the validator's reward indices between its oldest and newest sample. Reward indices are sampled after the furya
+// commission of the validator is taken so the estimate is net of the commission. It is not reduced by the take rate.
+func (k Keeper) EstimateValidatorAPR(ctx sdk.Context, asset types.FuryaAsset, valAddr sdk.ValAddress) (apr sdk.DecCoins, sampleDuration time.Duration) {
+	samples := k.GetRewardIndexSamples(ctx, valAddr)
+	if len(samples) < 2 {
+		return sdk.NewDecCoins(), 0
+	}
+	oldest := samples[0]
+	newest := samples[len(samples)-1]
+	sampleDuration = newest.Time.Sub(oldest.Time)
+	if sampleDuration <= 0 {
+		return sdk.NewDecCoins(), 0
+	}
+
+	oldHistories := types.NewRewardHistories(oldest.RewardHistories)
+	apr = sdk.NewDecCoins()
+	for _, history := range newest.RewardHistories {
+		oldIndex := sdk.ZeroDec()
+		if oldHistory, found := oldHistories.GetIndexByDenom(history.Denom); found {
+			oldIndex = oldHistory.Index
+		}
+		if history.Index.LTE(oldIndex) {
+			continue
+		}
+		// Reward indices are rewards per token and reward weight
+		rewards := history.Index.Sub(oldIndex).Mul(asset.RewardWeight).MulInt64(int64(year)).QuoInt64(int64(sampleDuration))
+		apr = apr.Add(sdk.NewDecCoinFromDec(history.Denom, rewards))
+	}
+	return apr, sampleDuration
+}
+
+// AnnualTakeRate returns the share of an asset that is deducted by its take rate over a year
+func (k Keeper) AnnualTakeRate(ctx sdk.Context, asset types.FuryaAsset) sdk.Dec {
+	interval := k.RewardClaimInterval(ctx)
+	if !asset.TakeRate.IsPositive() || interval <= 0 {
+		return sdk.ZeroDec()
+	}
+	intervalsPerYear := uint64(year / interval)
+	return sdk.OneDec().Sub(sdk.OneDec().Sub(asset.TakeRate).Power(intervalsPerYear))
+}
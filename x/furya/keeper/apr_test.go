@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuryaAPR(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.TakeRateClaimInterval = time.Hour * 24 * 365
+	params.RewardIndexSampleInterval = time.Hour
+	params.RewardIndexSampleSize = 3
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.MustNewDecFromStr("0.1"), startTime),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	addRewards := func() {
+		err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+	}
+
+	// A single sample is not enough to estimate the APR
+	addRewards()
+	apr, _ := app.FuryaKeeper.EstimateValidatorAPR(ctx, types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime), valAddr)
+	require.True(t, apr.IsZero())
+
+	// Samples are taken at most once per interval
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute * 30))
+	addRewards()
+	require.Len(t, app.FuryaKeeper.GetRewardIndexSamples(ctx, valAddr), 1)
+
+	// The oldest sample is overwritten once the ring buffer is full
+	for i := 1; i <= 3; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Hour * time.Duration(i)))
+		addRewards()
+	}
+	samples := app.FuryaKeeper.GetRewardIndexSamples(ctx, valAddr)
+	require.Len(t, samples, 3)
+	require.Equal(t, startTime.Add(time.Hour).UTC(), samples[0].Time.UTC())
+	require.Equal(t, startTime.Add(time.Hour*3).UTC(), samples[2].Time.UTC())
+
+	// 1 stake is distributed per hour for every token. 10% of the tokens are taken every year.
+	expectedAPR := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(24*365).Mul(sdk.MustNewDecFromStr("0.9"))))
+	res, err := queryServer.FuryaAPR(ctx, &types.QueryFuryaAPRRequest{
+		Denom:         FURYA_TOKEN_DENOM,
+		ValidatorAddr: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedAPR, res.RewardsApr)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), res.TakeRateApr)
+	require.Equal(t, []types.FuryaValidatorAPR{
+		{
+			ValidatorAddress: valAddr.String(),
+			RewardsApr:       expectedAPR,
+			CommissionRate:   sdk.ZeroDec(),
+			SampleDuration:   time.Hour * 2,
+		},
+	}, res.Validators)
+
+	// The validator holds all tokens of the asset so the weighted APR is the same
+	res, err = queryServer.FuryaAPR(ctx, &types.QueryFuryaAPRRequest{
+		Denom: FURYA_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedAPR, res.RewardsApr)
+
+	_, err = queryServer.FuryaAPR(ctx, &types.QueryFuryaAPRRequest{
+		Denom: "unknown",
+	})
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+}
+
+func TestFuryaAPRWithCommission(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.RewardIndexSampleInterval = time.Hour
+	params.RewardIndexSampleSize = 3
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	commissionRate := sdk.MustNewDecFromStr("0.1")
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, stakingtypes.NewCommissionRates(commissionRate, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.01")))
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Hour * time.Duration(i)))
+		err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+	}
+
+	// 1 stake is distributed per hour for every token and the validator takes 10% of it as commission
+	expectedAPR := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(24*365).Mul(sdk.MustNewDecFromStr("0.9"))))
+	res, err := queryServer.FuryaAPR(ctx, &types.QueryFuryaAPRRequest{
+		Denom:         FURYA_TOKEN_DENOM,
+		ValidatorAddr: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedAPR, res.RewardsApr)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, expectedAPR, res.Validators[0].RewardsApr)
+	require.Equal(t, commissionRate, res.Validators[0].CommissionRate)
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/furya-official/furya/x/furya/types"
 	"math"
@@ -22,18 +21,23 @@ func (k Keeper) UpdateFuryaAsset(ctx sdk.Context, newAsset types.FuryaAsset) err
 	var err error
 	// Only add a snapshot if reward weight changes
 	if !newAsset.RewardWeight.Equal(asset.RewardWeight) {
+		// Validators are collected first since taking a snapshot iterates over the store
+		var valAddrs []sdk.ValAddress
 		k.IterateFuryaValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.FuryaValidatorInfo) bool {
+			valAddrs = append(valAddrs, valAddr)
+			return false
+		})
+		for _, valAddr := range valAddrs {
 			validator, err := k.GetFuryaValidator(ctx, valAddr)
 			if err != nil {
-				return true
+				break
 			}
 			_, err = k.ClaimValidatorRewards(ctx, validator)
 			if err != nil {
-				return true
+				break
 			}
 			k.SetRewardWeightChangeSnapshot(ctx, asset, validator)
-			return false
-		})
+		}
 		if err != nil {
 			return err
 		}
@@ -58,6 +62,13 @@ func (k Keeper) UpdateFuryaAsset(ctx sdk.Context, newAsset types.FuryaAsset) err
 	asset.RewardChangeRate = newAsset.RewardChangeRate
 	asset.RewardChangeInterval = newAsset.RewardChangeInterval
 	asset.LastRewardChangeTime = newAsset.LastRewardChangeTime
+	asset.MinDelegationAmount = newAsset.MinDelegationAmount
+	asset.MaxTotalTokens = newAsset.MaxTotalTokens
+	asset.PricePeg = newAsset.PricePeg
+	asset.MinRewardWeight = newAsset.MinRewardWeight
+	asset.MaxRewardWeight = newAsset.MaxRewardWeight
+	asset.RewardWeightSchedule = newAsset.RewardWeightSchedule
+	asset.SlashMultiplier = newAsset.SlashMultiplier
 	k.SetAsset(ctx, asset)
 
 	return nil
@@ -75,14 +86,58 @@ func (k Keeper) RebalanceHook(ctx sdk.Context, assets []*types.FuryaAsset) error
 // It iterates all validators and calculates the expected staked amount based on delegations and delegates/undelegates
 // the difference.
 func (k Keeper) RebalanceBondTokenWeights(ctx sdk.Context, assets []*types.FuryaAsset) (err error) {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	bondedValidators, clamps, err := k.CalculateFuryaBondAmounts(ctx, assets)
+	if err != nil {
+		return err
+	}
+
+	for i, validator := range bondedValidators {
+		currentBondedAmount := k.getFuryaBondedAmountWithValidator(ctx, moduleAddr, validator)
+		expectedBondAmount := clamps[i].BondAmount
+		if expectedBondAmount.GT(currentBondedAmount) {
+			// delegate more tokens to increase the weight
+			bondAmount := expectedBondAmount.Sub(currentBondedAmount).TruncateInt()
+			err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmount)))
+			if err != nil {
+				return nil
+			}
+			_, err = k.stakingKeeper.Delegate(ctx, moduleAddr, bondAmount, stakingtypes.Unbonded, *validator.Validator, true)
+			if err != nil {
+				return err
+			}
+		} else if expectedBondAmount.LT(currentBondedAmount) {
+			// undelegate more tokens to reduce the weight
+			unbondAmount := currentBondedAmount.Sub(expectedBondAmount).TruncateInt()
+			sharesToUnbond, err := k.stakingKeeper.ValidateUnbondAmount(ctx, moduleAddr, validator.GetOperator(), unbondAmount)
+			if err != nil {
+				return err
+			}
+			tokensToBurn, err := k.stakingKeeper.Unbond(ctx, moduleAddr, validator.GetOperator(), sharesToUnbond)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.BurnCoins(ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, tokensToBurn)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CalculateFuryaBondAmounts returns the bonded furya validators with the amount of stake that should be bonded to each
+// of them. The amounts are clamped so that furya delegations do not exceed MaxValidatorFuryaPowerShare of the power of
+// a validator and MaxFuryaPowerShare of the total bonded power.
+func (k Keeper) CalculateFuryaBondAmounts(ctx sdk.Context, assets []*types.FuryaAsset) (bondedValidators []types.FuryaValidator, clamps []types.FuryaPowerClamp, err error) {
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
 	furyaBondAmount := k.GetFuryaBondedAmount(ctx, moduleAddr)
 
 	nativeBondAmount := k.stakingKeeper.TotalBondedTokens(ctx).Sub(furyaBondAmount)
-	bondDenom := k.stakingKeeper.BondDenom(ctx)
 
 	unbondedValidatorShares := sdk.NewDecCoins()
-	var bondedValidators []types.FuryaValidator
 
 	// Iterate through all furya validators to remove those that are unbonded.
 	// Unbonded validators will be ignored when rebalancing.
@@ -100,16 +155,12 @@ func (k Keeper) RebalanceBondTokenWeights(ctx sdk.Context, assets []*types.Furya
 		return false
 	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
+	maxValidatorShare := k.MaxValidatorFuryaPowerShare(ctx)
+	totalBondAmount := sdk.ZeroDec()
 	for _, validator := range bondedValidators {
-		currentBondedAmount := sdk.NewDec(0)
-		delegation, found := k.stakingKeeper.GetDelegation(ctx, moduleAddr, validator.GetOperator())
-		if found {
-			currentBondedAmount = validator.TokensFromShares(delegation.GetShares())
-		}
-
 		expectedBondAmount := sdk.ZeroDec()
 		for _, asset := range assets {
 			// Ignores assets that were recently added to prevent a small set of stakers from owning too much of the
@@ -125,35 +176,49 @@ func (k Keeper) RebalanceBondTokenWeights(ctx sdk.Context, assets []*types.Furya
 				expectedBondAmount = expectedBondAmount.Add(valShares.Quo(bondedValidatorShares).Mul(expectedBondAmountForAsset))
 			}
 		}
-		if expectedBondAmount.GT(currentBondedAmount) {
-			// delegate more tokens to increase the weight
-			bondAmount := expectedBondAmount.Sub(currentBondedAmount).TruncateInt()
-			err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, bondAmount)))
-			if err != nil {
-				return nil
-			}
-			_, err = k.stakingKeeper.Delegate(ctx, moduleAddr, bondAmount, stakingtypes.Unbonded, *validator.Validator, true)
-			if err != nil {
-				return err
-			}
-		} else if expectedBondAmount.LT(currentBondedAmount) {
-			// undelegate more tokens to reduce the weight
-			unbondAmount := currentBondedAmount.Sub(expectedBondAmount).TruncateInt()
-			sharesToUnbond, err := k.stakingKeeper.ValidateUnbondAmount(ctx, moduleAddr, validator.GetOperator(), unbondAmount)
-			if err != nil {
-				return err
-			}
-			tokensToBurn, err := k.stakingKeeper.Unbond(ctx, moduleAddr, validator.GetOperator(), sharesToUnbond)
-			if err != nil {
-				return err
-			}
-			err = k.bankKeeper.BurnCoins(ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, tokensToBurn)))
-			if err != nil {
-				return err
+
+		bondAmount := expectedBondAmount
+		if maxValidatorShare.IsPositive() {
+			// furya / (native + furya) <= share  <=>  furya <= native * share / (1 - share)
+			currentBondedAmount := k.getFuryaBondedAmountWithValidator(ctx, moduleAddr, validator)
+			validatorNativeAmount := sdk.NewDecFromInt(validator.Tokens).Sub(currentBondedAmount)
+			maxBondAmount := validatorNativeAmount.Mul(maxValidatorShare).Quo(sdk.OneDec().Sub(maxValidatorShare))
+			bondAmount = sdk.MinDec(bondAmount, maxBondAmount)
+		}
+		totalBondAmount = totalBondAmount.Add(bondAmount)
+
+		clamps = append(clamps, types.FuryaPowerClamp{
+			ValidatorAddress:   validator.GetOperator().String(),
+			ExpectedBondAmount: expectedBondAmount,
+			BondAmount:         bondAmount,
+		})
+	}
+
+	// Scale down all validators proportionally when the total furya power is over the global limit
+	maxShare := k.MaxFuryaPowerShare(ctx)
+	if maxShare.IsPositive() {
+		maxTotalBondAmount := sdk.NewDecFromInt(nativeBondAmount).Mul(maxShare).Quo(sdk.OneDec().Sub(maxShare))
+		if totalBondAmount.GT(maxTotalBondAmount) {
+			ratio := maxTotalBondAmount.Quo(totalBondAmount)
+			for i := range clamps {
+				clamps[i].BondAmount = clamps[i].BondAmount.Mul(ratio)
 			}
 		}
 	}
-	return nil
+
+	for i := range clamps {
+		clamps[i].ClampedAmount = clamps[i].ExpectedBondAmount.Sub(clamps[i].BondAmount)
+	}
+	return bondedValidators, clamps, nil
+}
+
+// getFuryaBondedAmountWithValidator returns the amount of stake that the furya module has bonded to a validator
+func (k Keeper) getFuryaBondedAmountWithValidator(ctx sdk.Context, moduleAddr sdk.AccAddress, validator types.FuryaValidator) sdk.Dec {
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, moduleAddr, validator.GetOperator())
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return validator.TokensFromShares(delegation.GetShares())
 }
 
 // SetAsset Does not check if the asset already exists and overwrites it
@@ -227,7 +292,7 @@ func (k Keeper) DeductAssetsHook(ctx sdk.Context, assets []*types.FuryaAsset) (s
 }
 
 // DeductAssetsWithTakeRate Deducts an furya asset using the take_rate
-// The deducted asset is distributed to the fee_collector module account to be redistributed to stakers
+// The deducted asset is split between the take rate destinations set in module params
 func (k Keeper) DeductAssetsWithTakeRate(ctx sdk.Context, lastClaim time.Time, assets []*types.FuryaAsset) (sdk.Coins, error) {
 	rewardClaimInterval := k.RewardClaimInterval(ctx)
 	durationSinceLastClaim := ctx.BlockTime().Sub(lastClaim)
@@ -251,19 +316,78 @@ func (k Keeper) DeductAssetsWithTakeRate(ctx sdk.Context, lastClaim time.Time, a
 	}
 
 	if !coins.Empty() && !coins.IsZero() {
-		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+		err := k.distributeTakeRate(ctx, coins)
 		if err != nil {
 			return nil, err
 		}
+		for _, c := range coins {
+			k.addTakeRateRevenue(ctx, c)
+		}
 		// Only update if there was a token transfer to prevent < 1 amounts to be ignored
 		k.SetLastRewardClaimTime(ctx, lastClaim.Add(rewardClaimInterval*time.Duration(intervalsSinceLastClaim)))
 	}
 	return coins, nil
 }
 
+// SetRewardWeightChangeSnapshot stores the reward weight and reward history of the validator before a reward weight change.
+// Snapshots that are no longer referenced by any delegation are garbage collected first and the new snapshot is merged
+// with the previous one when claiming rewards across both of them gives the same result.
 func (k Keeper) SetRewardWeightChangeSnapshot(ctx sdk.Context, asset types.FuryaAsset, val types.FuryaValidator) {
+	k.GarbageCollectWeightChangeSnapshots(ctx, asset.Denom, val.GetOperator())
+
 	snapshot := types.NewRewardWeightChangeSnapshot(asset, val)
-	k.setRewardWeightChangeSnapshot(ctx, asset.Denom, val.GetOperator(), uint64(ctx.BlockHeight()), snapshot)
+	height := uint64(ctx.BlockHeight())
+	lastHeight, lastSnapshot, found := k.getLatestWeightChangeSnapshot(ctx, asset.Denom, val.GetOperator())
+	if found && lastHeight < height {
+		// No rewards were distributed since the last snapshot so there is nothing to claim with the new snapshot
+		if types.NewRewardHistories(lastSnapshot.RewardHistories).Equal(snapshot.RewardHistories) {
+			return
+		}
+		// The weight did not change since the last snapshot so rewards of both periods can be claimed with the new snapshot
+		if lastSnapshot.PrevRewardWeight.Equal(snapshot.PrevRewardWeight) {
+			ctx.KVStore(k.storeKey).Delete(types.GetRewardWeightChangeSnapshotKey(asset.Denom, val.GetOperator(), lastHeight))
+		}
+	}
+	k.setRewardWeightChangeSnapshot(ctx, asset.Denom, val.GetOperator(), height, snapshot)
+}
+
+func (k Keeper) getLatestWeightChangeSnapshot(ctx sdk.Context, denom string, valAddr sdk.ValAddress) (height uint64, snapshot types.RewardWeightChangeSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.GetRewardWeightChangeSnapshotKey(denom, valAddr, 0)
+	end := types.GetRewardWeightChangeSnapshotKey(denom, valAddr, math.MaxUint64)
+	iter := store.ReverseIterator(start, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, snapshot, false
+	}
+	k.cdc.MustUnmarshal(iter.Value(), &snapshot)
+	_, _, height = types.ParseRewardWeightChangeSnapshotKey(iter.Key())
+	return height, snapshot, true
+}
+
+// GarbageCollectWeightChangeSnapshots deletes the reward weight change snapshots of a denom and validator below the
+// oldest last reward claim height of its delegations since they are not used to calculate rewards anymore.
+// Nothing is deleted until every delegation is indexed since the oldest claim height could be missed otherwise.
+func (k Keeper) GarbageCollectWeightChangeSnapshots(ctx sdk.Context, denom string, valAddr sdk.ValAddress) (pruned uint64) {
+	if !k.IsDelegationClaimHeightIndexed(ctx) {
+		return 0
+	}
+	endHeight := uint64(math.MaxUint64)
+	if height, found := k.GetOldestRewardClaimHeight(ctx, denom, valAddr); found {
+		endHeight = height
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := store.Iterator(types.GetRewardWeightChangeSnapshotKey(denom, valAddr, 0), types.GetRewardWeightChangeSnapshotKey(denom, valAddr, endHeight))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return uint64(len(keys))
 }
 
 func (k Keeper) setRewardWeightChangeSnapshot(ctx sdk.Context, denom string, valAddr sdk.ValAddress, height uint64, snapshot types.RewardWeightChangeSnapshot) {
@@ -296,10 +420,23 @@ func (k Keeper) IterateAllWeightChangeSnapshot(ctx sdk.Context, cb func(denom st
 
 func (k Keeper) RewardWeightChangeHook(ctx sdk.Context, assets []*types.FuryaAsset) {
 	for _, asset := range assets {
+		// Price pegged weights replace the reward change rate
+		if asset.PricePeg != nil {
+			k.pegRewardWeight(ctx, asset)
+			continue
+		}
+		// Reward weight schedules are applied by the RewardWeightScheduleHook
+		if asset.RewardWeightSchedule != nil {
+			continue
+		}
 		// If no reward changes are required, skip
 		if asset.RewardChangeInterval == 0 || asset.RewardChangeRate.Equal(sdk.OneDec()) {
 			continue
 		}
+		// If the reward weight already reached its bound, no more changes are scheduled
+		if asset.RewardWeightBoundReached() {
+			continue
+		}
 		// If it is not scheduled for change, skip
 		if asset.LastRewardChangeTime.Add(asset.RewardChangeInterval).After(ctx.BlockTime()) {
 			continue
@@ -309,9 +446,36 @@ func (k Keeper) RewardWeightChangeHook(ctx sdk.Context, assets []*types.FuryaAss
 
 		// Compound the weight changes
 		multiplier := asset.RewardChangeRate.Power(intervalsSinceLastClaim)
-		asset.RewardWeight = asset.RewardWeight.Mul(multiplier)
+		asset.RewardWeight = asset.ClampRewardWeight(asset.RewardWeight.Mul(multiplier))
 		asset.LastRewardChangeTime = asset.LastRewardChangeTime.Add(asset.RewardChangeInterval * time.Duration(intervalsSinceLastClaim))
 		k.QueueAssetRebalanceEvent(ctx)
 		k.UpdateFuryaAsset(ctx, *asset)
 	}
 }
+
+// ModuleAssetBalance returns the balance held by the module account of every denom it has to hold,
+// which includes the denoms of deleted assets that still have pending undelegations
+func (k Keeper) ModuleAssetBalance(ctx sdk.Context) sdk.Coins {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := sdk.NewCoins()
+	for _, coin := range k.TrackedModuleAssetBalance(ctx) {
+		balance = balance.Add(k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom))
+	}
+	return balance
+}
+
+// TrackedModuleAssetBalance returns the balance of the furya assets the module account has to hold,
+// which is the total tokens of the assets and the balances of the pending undelegations
+func (k Keeper) TrackedModuleAssetBalance(ctx sdk.Context) sdk.Coins {
+	tracked := sdk.NewCoins()
+	for _, asset := range k.GetAllAssets(ctx) {
+		tracked = tracked.Add(sdk.NewCoin(asset.Denom, asset.TotalTokens))
+	}
+	k.IterateUndelegations(ctx, func(undelegation types.QueuedUndelegation, completionTime time.Time) (stop bool) {
+		for _, entry := range undelegation.Entries {
+			tracked = tracked.Add(entry.Balance)
+		}
+		return false
+	})
+	return tracked
+}
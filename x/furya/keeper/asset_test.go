@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramsmodule "github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 	test_helpers "github.com/furya-official/furya/app"
 	"github.com/furya-official/furya/x/furya"
+	"github.com/furya-official/furya/x/furya/keeper"
 	"github.com/furya-official/furya/x/furya/types"
 	"testing"
 	"time"
@@ -771,6 +775,97 @@ func TestRewardWeightDecayOverTime(t *testing.T) {
 	require.True(t, decayRate.Power(intervals).Sub(asset.RewardWeight).LT(sdk.MustNewDecFromStr("0.0000000001")))
 }
 
+func TestRewardWeightDecayBounds(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Bounds must be positive and the floor cannot be above the ceiling
+	minRewardWeight := sdk.MustNewDecFromStr("0.3")
+	maxRewardWeight := sdk.NewDec(3)
+	proposal := types.NewMsgCreateFuryaProposal("", "", FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5"), time.Hour, nil, nil, nil, &maxRewardWeight, &minRewardWeight, nil, nil)
+	require.Error(t, proposal.ValidateBasic())
+
+	// Pass proposals to add a decaying asset with a floor and an inflating asset with a ceiling
+	decayInterval := time.Hour
+	err := app.FuryaKeeper.CreateFurya(ctx, &types.MsgCreateFuryaProposal{
+		Denom:                FURYA_TOKEN_DENOM,
+		RewardWeight:         sdk.NewDec(1),
+		TakeRate:             sdk.ZeroDec(),
+		RewardChangeRate:     sdk.MustNewDecFromStr("0.5"),
+		RewardChangeInterval: decayInterval,
+		MinRewardWeight:      &minRewardWeight,
+	})
+	require.NoError(t, err)
+	err = app.FuryaKeeper.CreateFurya(ctx, &types.MsgCreateFuryaProposal{
+		Denom:                FURYA_2_TOKEN_DENOM,
+		RewardWeight:         sdk.NewDec(1),
+		TakeRate:             sdk.ZeroDec(),
+		RewardChangeRate:     sdk.NewDec(2),
+		RewardChangeInterval: decayInterval,
+		MaxRewardWeight:      &maxRewardWeight,
+	})
+	require.NoError(t, err)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	rewardStartTime := asset.RewardStartTime
+
+	// The projected weight path stops at the bounds
+	res, err := queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectedRewardWeight{
+		{Time: rewardStartTime.Add(decayInterval), RewardWeight: sdk.MustNewDecFromStr("0.5")},
+		{Time: rewardStartTime.Add(decayInterval * 2), RewardWeight: minRewardWeight},
+	}, res.ProjectedRewardWeights)
+	res, err = queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_2_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProjectedRewardWeight{
+		{Time: rewardStartTime.Add(decayInterval), RewardWeight: sdk.NewDec(2)},
+		{Time: rewardStartTime.Add(decayInterval * 2), RewardWeight: maxRewardWeight},
+	}, res.ProjectedRewardWeights)
+
+	// Compounded changes are clamped to the bounds
+	ctx = ctx.WithBlockTime(rewardStartTime.Add(decayInterval * 5))
+	app.FuryaKeeper.RewardWeightChangeHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, minRewardWeight, asset.RewardWeight)
+	require.Equal(t, ctx.BlockTime(), asset.LastRewardChangeTime)
+	asset2, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+	require.Equal(t, maxRewardWeight, asset2.RewardWeight)
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// No more changes are scheduled once the bounds are reached
+	ctx = ctx.WithBlockTime(rewardStartTime.Add(decayInterval * 10))
+	app.FuryaKeeper.RewardWeightChangeHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, rewardStartTime.Add(decayInterval*5), asset.LastRewardChangeTime)
+	require.False(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+	res, err = queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Empty(t, res.ProjectedRewardWeights)
+
+	// Lowering the floor schedules changes again from the time of the update
+	lowerMinRewardWeight := sdk.MustNewDecFromStr("0.1")
+	err = app.FuryaKeeper.UpdateFurya(ctx, &types.MsgUpdateFuryaProposal{
+		Denom:                FURYA_TOKEN_DENOM,
+		RewardWeight:         asset.RewardWeight,
+		TakeRate:             asset.TakeRate,
+		RewardChangeRate:     asset.RewardChangeRate,
+		RewardChangeInterval: asset.RewardChangeInterval,
+		MinRewardWeight:      &lowerMinRewardWeight,
+	})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(decayInterval))
+	app.FuryaKeeper.RewardWeightChangeHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), asset.RewardWeight)
+	require.Equal(t, ctx.BlockTime(), asset.LastRewardChangeTime)
+}
+
 func TestClaimTakeRate(t *testing.T) {
 	app, ctx := createTestContext(t)
 	startTime := time.Now().UTC()
@@ -904,3 +999,189 @@ func TestClaimTakeRateToZero(t *testing.T) {
 	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
 	require.True(t, asset.TotalTokens.GTE(sdk.OneInt()))
 }
+
+func TestTakeRateDestinations(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime)
+	ctx = ctx.WithBlockHeight(1)
+	takeRateInterval := time.Minute * 5
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000_000)),
+	))
+	user1, user2 := addrs[0], addrs[1]
+
+	// Weights must add up to 1 and targets must match the destination type
+	params := types.DefaultParams()
+	params.TakeRateDestinations = []types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL, Weight: sdk.MustNewDecFromStr("0.5")},
+	}
+	require.Error(t, types.ValidateTakeRateDestinations(params.TakeRateDestinations))
+	require.Error(t, types.ValidateTakeRateDestinations([]types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS, Target: "invalid", Weight: sdk.OneDec()},
+	}))
+	require.Error(t, types.ValidateTakeRateDestinations([]types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_BURN, Target: types.ModuleName, Weight: sdk.OneDec()},
+	}))
+
+	// Split the take rate between the community pool, burning and an address
+	params.TakeRateClaimInterval = takeRateInterval
+	params.LastTakeRateClaimTime = startTime
+	params.TakeRateDestinations = []types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL, Weight: sdk.MustNewDecFromStr("0.5")},
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_BURN, Weight: sdk.MustNewDecFromStr("0.25")},
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS, Target: user2.String(), Weight: sdk.MustNewDecFromStr("0.25")},
+	}
+	require.NoError(t, types.ValidateTakeRateDestinations(params.TakeRateDestinations))
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.MustNewDecFromStr("0.5"), startTime),
+		},
+	})
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000_000)))
+	require.NoError(t, err)
+
+	supply := app.BankKeeper.GetSupply(ctx, FURYA_TOKEN_DENOM).Amount
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(FURYA_TOKEN_DENOM)
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval + time.Second)).WithBlockHeight(2)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	coins, err := app.FuryaKeeper.DeductAssetsHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000_000))), coins)
+
+	require.Equal(t, communityPool.Add(sdk.NewDec(250_000_000)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(FURYA_TOKEN_DENOM))
+	require.Equal(t, supply.Sub(sdk.NewInt(125_000_000)), app.BankKeeper.GetSupply(ctx, FURYA_TOKEN_DENOM).Amount)
+	require.Equal(t, sdk.NewInt(1125_000_000), app.BankKeeper.GetBalance(ctx, user2, FURYA_TOKEN_DENOM).Amount)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "furya.furya.EventFuryaTakeRate" {
+			emitted = true
+		}
+	}
+	require.True(t, emitted)
+
+	// Take rate revenue accumulates per asset
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval*2 + time.Second)).WithBlockHeight(3)
+	_, err = app.FuryaKeeper.DeductAssetsHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	res, err := queryServer.FuryaTakeRateRevenue(ctx, &types.QueryFuryaTakeRateRevenueRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(750_000_000))), res.Revenues)
+	require.Equal(t, res.Revenues, app.FuryaKeeper.ExportGenesis(ctx).TakeRateRevenues)
+
+	// Destinations that cannot receive coins are rejected when they are changed through governance
+	blockedDestinations := []types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS, Target: authtypes.NewModuleAddress(distrtypes.ModuleName).String(), Weight: sdk.MustNewDecFromStr("0.5")},
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT, Target: "unknown", Weight: sdk.MustNewDecFromStr("0.5")},
+	}
+	require.NoError(t, types.ValidateTakeRateDestinations(blockedDestinations))
+	require.Error(t, app.FuryaKeeper.ValidateTakeRateDestinations(ctx, blockedDestinations[:1]))
+	require.Error(t, app.FuryaKeeper.ValidateTakeRateDestinations(ctx, blockedDestinations[1:]))
+	value, err := app.LegacyAmino().MarshalJSON(blockedDestinations)
+	require.NoError(t, err)
+	handler := furya.NewParamChangeProposalHandler(app.FuryaKeeper, paramsmodule.NewParamChangeProposalHandler(app.ParamsKeeper))
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("", "", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.TakeRateDestinations), string(value)),
+	}))
+	require.Error(t, err)
+	require.Len(t, app.FuryaKeeper.TakeRateDestinations(ctx), 3)
+	value, err = app.LegacyAmino().MarshalJSON([]types.TakeRateDestination{
+		{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS, Target: user2.String(), Weight: sdk.OneDec()},
+	})
+	require.NoError(t, err)
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("", "", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.TakeRateDestinations), string(value)),
+	}))
+	require.NoError(t, err)
+	require.Len(t, app.FuryaKeeper.TakeRateDestinations(ctx), 1)
+
+	// Destinations that became unreachable fall back to the fee collector instead of halting the chain
+	params = app.FuryaKeeper.GetParams(ctx)
+	params.TakeRateDestinations = blockedDestinations
+	app.FuryaKeeper.SetParams(ctx, params)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := app.BankKeeper.GetBalance(ctx, feeCollector, FURYA_TOKEN_DENOM).Amount
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval*3 + time.Second)).WithBlockHeight(4)
+	coins, err = app.FuryaKeeper.DeductAssetsHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	require.Equal(t, feeCollectorBalance.Add(coins.AmountOf(FURYA_TOKEN_DENOM)), app.BankKeeper.GetBalance(ctx, feeCollector, FURYA_TOKEN_DENOM).Amount)
+}
+
+func TestRebalancingWithPowerShareLimits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.MaxValidatorFuryaPowerShare = sdk.MustNewDecFromStr("0.5")
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// Furya power of the validator is limited to its native power
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(2_000_000), app.StakingKeeper.TotalBondedTokens(ctx))
+
+	res, err := queryServer.FuryaPowerClamps(ctx, &types.QueryFuryaPowerClampsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.FuryaPowerClamp{
+		{
+			ValidatorAddress:   valAddr.String(),
+			ExpectedBondAmount: sdk.NewDec(2_000_000),
+			BondAmount:         sdk.NewDec(1_000_000),
+			ClampedAmount:      sdk.NewDec(1_000_000),
+		},
+	}, res.Clamps)
+
+	// Furya power of all validators is limited to 20% of the total power
+	app.GetSubspace(types.ModuleName).Set(ctx, types.MaxFuryaPowerShare, sdk.MustNewDecFromStr("0.2"))
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1_250_000), app.StakingKeeper.TotalBondedTokens(ctx))
+
+	res, err = queryServer.FuryaPowerClamps(ctx, &types.QueryFuryaPowerClampsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.FuryaPowerClamp{
+		{
+			ValidatorAddress:   valAddr.String(),
+			ExpectedBondAmount: sdk.NewDec(2_000_000),
+			BondAmount:         sdk.NewDec(250_000),
+			ClampedAmount:      sdk.NewDec(1_750_000),
+		},
+	}, res.Clamps)
+
+	// Removing the limits restores the full furya power
+	app.GetSubspace(types.ModuleName).Set(ctx, types.MaxFuryaPowerShare, sdk.ZeroDec())
+	app.GetSubspace(types.ModuleName).Set(ctx, types.MaxValidatorFuryaPowerShare, sdk.ZeroDec())
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3_000_000), app.StakingKeeper.TotalBondedTokens(ctx))
+}
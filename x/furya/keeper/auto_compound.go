@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound enables or disables auto-compounding of the rewards of a delegation
+// Only existing delegations can be enabled, disabling removes the entry
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAutoCompoundKey(delAddr, valAddr, denom)
+	if !enabled {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte{0x1})
+}
+
+// IsAutoCompoundEnabled returns true if the delegator opted in to compound the rewards of a delegation
+func (k Keeper) IsAutoCompoundEnabled(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAutoCompoundKey(delAddr, valAddr, denom))
+}
+
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr, denom := types.ParseAutoCompoundKey(iter.Key())
+		if cb(delAddr, valAddr, denom) {
+			return
+		}
+	}
+}
+
+// UpdateAutoCompound validates that the delegation exists before enabling auto-compounding for it
+func (k Keeper) UpdateAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, denom string, enabled bool) error {
+	if _, found := k.GetAssetByDenom(ctx, denom); !found {
+		return types.ErrUnknownAsset
+	}
+	if enabled {
+		if _, found := k.GetDelegation(ctx, delAddr, validator, denom); !found {
+			return stakingtypes.ErrNoDelegatorForAddress
+		}
+	}
+	k.SetAutoCompound(ctx, delAddr, validator.GetOperator(), denom, enabled)
+	return nil
+}
+
+// compoundRewards delegates reward coins that are whitelisted furya assets back to the validator
+// Returns the coins that were delegated, the remaining coins are left in the rewards pool.
+// Rewards that do not fit in the remaining capacity of their asset are not compounded.
+func (k Keeper) compoundRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coins sdk.Coins) (sdk.Coins, error) {
+	compounded := sdk.NewCoins()
+	for _, coin := range coins {
+		asset, found := k.GetAssetByDenom(ctx, coin.Denom)
+		if !found || !coin.IsPositive() || !k.IsAssetAllowed(ctx, valAddr, coin.Denom) {
+			continue
+		}
+
+		// Validator is queried again for every coin since compounding updates its shares
+		validator, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		// Each coin is compounded in its own cache context so that a coin over the capacity is paid out instead
+		cacheCtx, write := ctx.CacheContext()
+		err = k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.RewardsPoolName, types.ModuleName, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+		_, err = k.delegate(cacheCtx, delAddr, validator, coin, asset)
+		if errors.Is(err, types.ErrAssetCapacityExceeded) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		compounded = compounded.Add(coin)
+	}
+	if !compounded.IsZero() {
+		k.QueueAssetRebalanceEvent(ctx)
+	}
+	return compounded, nil
+}
+
+// autoCompoundsPerBlock limits the delegations compounded by the auto-compound hook in a single block
+const autoCompoundsPerBlock = 100
+
+// AutoCompoundHook compounds at most autoCompoundsPerBlock delegations with auto-compounding enabled per block
+func (k Keeper) AutoCompoundHook(ctx sdk.Context) {
+	k.AutoCompound(ctx, autoCompoundsPerBlock)
+}
+
+// AutoCompound claims and compounds the rewards of up to limit delegations with auto-compounding enabled.
+// A sweep over all delegations starts once every AutoCompoundInterval and continues over the following blocks.
+// An interval of zero disables new sweeps.
+func (k Keeper) AutoCompound(ctx sdk.Context, limit uint64) (compounded uint64) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.AutoCompoundCursorKey)
+	if cursor == nil {
+		interval := k.AutoCompoundInterval(ctx)
+		if interval == 0 {
+			return 0
+		}
+		next := k.LastAutoCompoundTime(ctx).Add(interval)
+		if !ctx.BlockTime().After(next) {
+			return 0
+		}
+		k.SetLastAutoCompoundTime(ctx, ctx.BlockTime())
+		cursor = types.AutoCompoundKey
+	}
+
+	type autoCompound struct {
+		delAddr sdk.AccAddress
+		valAddr sdk.ValAddress
+		denom   string
+	}
+	// Entries are collected first since compounding writes to the store
+	var autoCompounds []autoCompound
+	iter := store.Iterator(cursor, sdk.PrefixEndBytes(types.AutoCompoundKey))
+	for ; iter.Valid() && uint64(len(autoCompounds)) < limit; iter.Next() {
+		delAddr, valAddr, denom := types.ParseAutoCompoundKey(iter.Key())
+		autoCompounds = append(autoCompounds, autoCompound{delAddr, valAddr, denom})
+	}
+	var nextKey []byte
+	if iter.Valid() {
+		nextKey = iter.Key()
+	}
+	iter.Close()
+	if nextKey != nil {
+		store.Set(types.AutoCompoundCursorKey, nextKey)
+	} else {
+		store.Delete(types.AutoCompoundCursorKey)
+	}
+
+	for _, ac := range autoCompounds {
+		// Each delegation is compounded in its own cache context so that a single failure does not halt the sweep
+		cacheCtx, write := ctx.CacheContext()
+		validator, err := k.GetFuryaValidator(cacheCtx, ac.valAddr)
+		if err == nil {
+			_, err = k.ClaimDelegationRewards(cacheCtx, ac.delAddr, validator, ac.denom)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto-compound furya rewards",
+				"delegator", ac.delAddr.String(), "validator", ac.valAddr.String(), "denom", ac.denom, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		compounded++
+	}
+	return compounded
+}
@@ -17,25 +17,85 @@ import (
 // at the end of the block. This improves performance since rebalancing only needs to happen once regardless of how many
 // delegations are made in a single block
 func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*sdk.Dec, error) {
+	newShares, err := k.delegateFromAccount(ctx, delAddr, validator, coin)
+	if err != nil {
+		return nil, err
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+	return newShares, nil
+}
+
+// MultiDelegate delegates to many validators at once. An error from any entry fails the whole transaction.
+// Voting power is rebalanced once at the end of the block for all entries.
+func (k Keeper) MultiDelegate(ctx sdk.Context, delAddr sdk.AccAddress, entries []types.MultiDelegationEntry) ([]sdk.Dec, error) {
+	newShares := make([]sdk.Dec, 0, len(entries))
+	for _, entry := range entries {
+		// Validator is queried for every entry since the same validator can appear more than once
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		validator, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		shares, err := k.delegateFromAccount(ctx, delAddr, validator, entry.Amount)
+		if err != nil {
+			return nil, err
+		}
+		newShares = append(newShares, *shares)
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+	return newShares, nil
+}
+
+// delegateFromAccount moves tokens from the delegator account into the furya module and delegates them
+func (k Keeper) delegateFromAccount(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*sdk.Dec, error) {
 	// Check if asset is whitelisted as an furya asset
 	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "asset with denom: %s does not exist in furya whitelist", coin.Denom)
 	}
 
+	if !k.IsAssetAllowed(ctx, validator.GetOperator(), coin.Denom) {
+		return nil, types.ErrAssetNotAllowed.Wrapf("validator %s does not accept %s", validator.GetOperator(), coin.Denom)
+	}
+	if err := asset.ValidateMinDelegationAmount(coin.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check and send delegated tokens into the furya module address
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(coin))
 	if err != nil {
 		return nil, err
 	}
 
+	return k.delegate(ctx, delAddr, validator, coin, asset)
+}
+
+// delegate adds tokens that are already held by the furya module account to a delegation
+// Callers are responsible for queueing an asset rebalance event
+func (k Keeper) delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin, asset types.FuryaAsset) (*sdk.Dec, error) {
 	// Claim rewards before adding more to a previous delegation
-	_, found = k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	_, found := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
 	if found {
-		_, err = k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
+		_, err := k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
 		if err != nil {
 			return nil, err
 		}
+		// Validator and asset are queried again since claiming rewards might have compounded into them
+		if k.IsAutoCompoundEnabled(ctx, delAddr, validator.GetOperator(), coin.Denom) {
+			validator, err = k.GetFuryaValidator(ctx, validator.GetOperator())
+			if err != nil {
+				return nil, err
+			}
+			asset, _ = k.GetAssetByDenom(ctx, coin.Denom)
+		}
+	}
+
+	// Every path that adds tokens to the asset is limited by its capacity
+	if err := asset.ValidateCapacity(coin.Amount); err != nil {
+		return nil, err
 	}
 
 	// Create or update a delegation
@@ -51,7 +111,6 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator type
 		sdk.NewDecCoins(sdk.NewDecCoinFromDec(coin.Denom, newValidatorShares)),
 		true,
 	)
-	k.QueueAssetRebalanceEvent(ctx)
 	return &newValidatorShares, nil
 }
 
@@ -66,6 +125,14 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
 	}
 
+	if !k.IsAssetAllowed(ctx, dstVal.GetOperator(), coin.Denom) {
+		return nil, types.ErrAssetNotAllowed.Wrapf("validator %s does not accept %s", dstVal.GetOperator(), coin.Denom)
+	}
+	// Redelegations do not change the total tokens of the asset so only the minimum amount applies
+	if err := asset.ValidateMinDelegationAmount(coin.Amount); err != nil {
+		return nil, err
+	}
+
 	_, found = k.GetDelegation(ctx, delAddr, srcVal, coin.Denom)
 	if !found {
 		return nil, stakingtypes.ErrNoDelegatorForAddress
@@ -85,6 +152,21 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 		}
 	}
 
+	// Validators and asset are queried again since claiming rewards might have compounded into them
+	if k.IsAutoCompoundEnabled(ctx, delAddr, srcVal.GetOperator(), coin.Denom) {
+		srcVal, err = k.GetFuryaValidator(ctx, srcVal.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if k.IsAutoCompoundEnabled(ctx, delAddr, dstVal.GetOperator(), coin.Denom) {
+		dstVal, err = k.GetFuryaValidator(ctx, dstVal.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+	}
+	asset, _ = k.GetAssetByDenom(ctx, coin.Denom)
+
 	delegationSharesToRemove, err := k.ValidateDelegatedAmount(srcDelegation, coin, srcVal, asset)
 	if err != nil {
 		return nil, err
@@ -130,6 +212,39 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 // Undelegate from a validator
 // Staked tokens are only distributed to the delegator after the unbonding period
 func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*time.Time, error) {
+	completionTime, err := k.undelegate(ctx, delAddr, validator, coin)
+	if err != nil {
+		return nil, err
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+	return completionTime, nil
+}
+
+// MultiUndelegate undelegates from many validators at once. An error from any entry fails the whole transaction.
+// Voting power is rebalanced once at the end of the block for all entries.
+func (k Keeper) MultiUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, entries []types.MultiDelegationEntry) ([]time.Time, error) {
+	completionTimes := make([]time.Time, 0, len(entries))
+	for _, entry := range entries {
+		// Validator is queried for every entry since the same validator can appear more than once
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		validator, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		completionTime, err := k.undelegate(ctx, delAddr, validator, entry.Amount)
+		if err != nil {
+			return nil, err
+		}
+		completionTimes = append(completionTimes, *completionTime)
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+	return completionTimes, nil
+}
+
+func (k Keeper) undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*time.Time, error) {
 	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
@@ -147,6 +262,14 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator ty
 
 	// Delegation is queried again since it might have been modified when claiming delegation rewards
 	delegation, _ := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	// Validator and asset are queried again since claiming rewards might have compounded into them
+	if k.IsAutoCompoundEnabled(ctx, delAddr, validator.GetOperator(), coin.Denom) {
+		validator, err = k.GetFuryaValidator(ctx, validator.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+		asset, _ = k.GetAssetByDenom(ctx, coin.Denom)
+	}
 
 	// Calculate how much delegation shares to be undelegated
 	delegationSharesToUndelegate, err := k.ValidateDelegatedAmount(delegation, coin, validator, asset)
@@ -174,10 +297,164 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator ty
 
 	// Queue undelegation messages to distribute tokens after undelegation completes in the future
 	completionTime := k.queueUndelegation(ctx, delAddr, validator.GetOperator(), coin)
-	k.QueueAssetRebalanceEvent(ctx)
 	return &completionTime, nil
 }
 
+// CancelUndelegation removes tokens from a queued undelegation and delegates them back to the validator
+// The queued undelegation is identified by its completion time
+func (k Keeper) CancelUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin, completionTime time.Time) (*sdk.Dec, error) {
+	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
+	}
+
+	// Undelegations that are mature will be completed in the end blocker and can no longer be cancelled
+	if !completionTime.After(ctx.BlockTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Undelegation with completion time %s has already completed", completionTime)
+	}
+
+	// Undelegations forced by the validator blocking the asset cannot be cancelled
+	if !k.IsAssetAllowed(ctx, validator.GetOperator(), coin.Denom) {
+		return nil, types.ErrAssetNotAllowed.Wrapf("validator %s does not accept %s", validator.GetOperator(), coin.Denom)
+	}
+	// Cancelled undelegations are delegated back so they are limited like new delegations
+	if err := asset.ValidateMinDelegationAmount(coin.Amount); err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	queueKey := types.GetUndelegationQueueKey(completionTime, delAddr)
+	b := store.Get(queueKey)
+	if b == nil {
+		return nil, stakingtypes.ErrNoUnbondingDelegation
+	}
+	var queue types.QueuedUndelegation
+	k.cdc.MustUnmarshal(b, &queue)
+
+	// Sum up all entries for the validator and denom since undelegations in the same block share a completion time
+	valAddr := validator.GetOperator()
+	undelegatedAmount := sdk.ZeroInt()
+	for _, entry := range queue.Entries {
+		if entry.ValidatorAddress == valAddr.String() && entry.Balance.Denom == coin.Denom {
+			undelegatedAmount = undelegatedAmount.Add(entry.Balance.Amount)
+		}
+	}
+	if undelegatedAmount.IsZero() {
+		return nil, stakingtypes.ErrNoUnbondingDelegation
+	}
+	if undelegatedAmount.LT(coin.Amount) {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount to cancel %s is greater than the undelegated amount %s", coin.Amount, undelegatedAmount)
+	}
+
+	// Remove the cancelled amount from the queued entries and drop entries that are emptied
+	remainingToCancel := coin.Amount
+	var entries []*types.Undelegation
+	for _, entry := range queue.Entries {
+		if remainingToCancel.IsPositive() && entry.ValidatorAddress == valAddr.String() && entry.Balance.Denom == coin.Denom {
+			amountToCancel := sdk.MinInt(remainingToCancel, entry.Balance.Amount)
+			remainingToCancel = remainingToCancel.Sub(amountToCancel)
+			entry.Balance = entry.Balance.SubAmount(amountToCancel)
+			if entry.Balance.IsZero() {
+				continue
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		store.Delete(queueKey)
+	} else {
+		queue.Entries = entries
+		k.setQueuedUndelegations(ctx, completionTime, delAddr, queue)
+	}
+
+	// The index is shared by all entries with the same validator and denom so it is only removed with the last entry
+	if undelegatedAmount.Equal(coin.Amount) {
+		store.Delete(types.GetUnbondingIndexKey(valAddr, completionTime, coin.Denom, delAddr))
+	}
+
+	// Undelegated tokens are still held by the furya module account so they can be delegated back directly
+	newShares, err := k.delegate(ctx, delAddr, validator, coin, asset)
+	if err != nil {
+		return nil, err
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+	return newShares, nil
+}
+
+// TransferDelegation moves delegation shares from one account to another for the same validator and denom
+// Tokens stay bonded so validator shares and voting power are not changed
+func (k Keeper) TransferDelegation(ctx sdk.Context, delAddr sdk.AccAddress, recipientAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*sdk.Dec, error) {
+	if delAddr.Equals(recipientAddr) {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot transfer a delegation to the same account")
+	}
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not allowed to receive furya delegations", recipientAddr)
+	}
+
+	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
+	}
+	if !k.IsAssetAllowed(ctx, validator.GetOperator(), coin.Denom) {
+		return nil, types.ErrAssetNotAllowed.Wrapf("validator %s does not accept %s", validator.GetOperator(), coin.Denom)
+	}
+
+	_, found = k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	if !found {
+		return nil, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	// Redelegated tokens can still be slashed from the source validator so they cannot change owner until they mature
+	if k.HasRedelegation(ctx, delAddr, validator.GetOperator(), coin.Denom) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot transfer a delegation with immature redelegations")
+	}
+
+	// Settle rewards of both sides so that the transferred shares do not carry any pending rewards
+	_, err := k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+	_, found = k.GetDelegation(ctx, recipientAddr, validator, coin.Denom)
+	if found {
+		_, err = k.ClaimDelegationRewards(ctx, recipientAddr, validator, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Delegation, validator and asset are queried again since they might have been modified when claiming delegation rewards
+	delegation, _ := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	validator, err = k.GetFuryaValidator(ctx, validator.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+	asset, _ = k.GetAssetByDenom(ctx, coin.Denom)
+
+	sharesToTransfer, err := k.ValidateDelegatedAmount(delegation, coin, validator, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	k.moveDelegationShares(ctx, delAddr, recipientAddr, validator, coin, sharesToTransfer, delegation)
+
+	return &sharesToTransfer, nil
+}
+
+// moveDelegationShares moves shares from one delegation to another delegation of the same validator and denom
+// Rewards of both delegations must be claimed before calling this method
+func (k Keeper) moveDelegationShares(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin, shares sdk.Dec, fromDelegation types.Delegation) {
+	k.reduceDelegationShares(ctx, fromAddr, validator, coin, shares, fromDelegation)
+
+	toDelegation, found := k.GetDelegation(ctx, toAddr, validator, coin.Denom)
+	if !found {
+		toDelegation = types.NewDelegation(ctx, toAddr, validator.GetOperator(), coin.Denom, shares, validator.GlobalRewardHistory)
+		toDelegation.IncentiveRewardHistory = validator.IncentiveRewardHistory(coin.Denom)
+	} else {
+		toDelegation.Shares = toDelegation.Shares.Add(shares)
+	}
+	k.SetDelegation(ctx, toAddr, validator.GetOperator(), coin.Denom, toDelegation)
+}
+
 // CompleteRedelegations Go through the re-delegations queue and remove all that have passed the completion time
 func (k Keeper) CompleteRedelegations(ctx sdk.Context) int {
 	store := ctx.KVStore(k.storeKey)
@@ -250,10 +527,69 @@ func (k Keeper) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator
 	return d, true
 }
 
+// SetDelegation stores the delegation and indexes its last reward claim height so that reward weight change snapshots
+// that are no longer referenced can be garbage collected
 func (k Keeper) SetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, del types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
 	key := types.GetDelegationKey(delAddr, valAddr, denom)
+	if b := store.Get(key); b != nil {
+		var oldDel types.Delegation
+		k.cdc.MustUnmarshal(b, &oldDel)
+		store.Delete(types.GetDelegationClaimHeightIndexKey(denom, valAddr, oldDel.LastRewardClaimHeight, delAddr))
+	}
+	store.Set(types.GetDelegationClaimHeightIndexKey(denom, valAddr, del.LastRewardClaimHeight, delAddr), []byte{})
 	b := k.cdc.MustMarshal(&del)
-	ctx.KVStore(k.storeKey).Set(key, b)
+	store.Set(key, b)
+}
+
+func (k Keeper) deleteDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDelegationKey(delAddr, valAddr, denom)
+	b := store.Get(key)
+	if b == nil {
+		return
+	}
+	var del types.Delegation
+	k.cdc.MustUnmarshal(b, &del)
+	store.Delete(types.GetDelegationClaimHeightIndexKey(denom, valAddr, del.LastRewardClaimHeight, delAddr))
+	store.Delete(key)
+}
+
+// GetOldestRewardClaimHeight returns the lowest last reward claim height of all delegations of a denom to a validator
+func (k Keeper) GetOldestRewardClaimHeight(ctx sdk.Context, denom string, valAddr sdk.ValAddress) (height uint64, found bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetDelegationClaimHeightIndexPrefix(denom, valAddr))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	_, _, height, _ = types.ParseDelegationClaimHeightIndexKey(iter.Key())
+	return height, true
+}
+
+// IsDelegationClaimHeightIndexed returns true once every delegation is indexed by its last reward claim height.
+// Delegations stored before the index existed are indexed by a store migration.
+func (k Keeper) IsDelegationClaimHeightIndexed(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DelegationClaimHeightIndexedKey)
+}
+
+// IndexDelegationClaimHeights indexes the last reward claim height of every delegation
+func (k Keeper) IndexDelegationClaimHeights(ctx sdk.Context) {
+	var keys [][]byte
+	k.IterateDelegations(ctx, func(d types.Delegation) (stop bool) {
+		delAddr, _ := sdk.AccAddressFromBech32(d.DelegatorAddress)
+		valAddr, _ := sdk.ValAddressFromBech32(d.ValidatorAddress)
+		keys = append(keys, types.GetDelegationClaimHeightIndexKey(d.Denom, valAddr, d.LastRewardClaimHeight, delAddr))
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+	k.setDelegationClaimHeightIndexed(ctx)
+}
+
+func (k Keeper) setDelegationClaimHeightIndexed(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Set(types.DelegationClaimHeightIndexedKey, []byte{})
 }
 
 func (k Keeper) DeleteRedelegation(ctx sdk.Context, redel types.Redelegation, completion time.Time) {
@@ -289,6 +625,20 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, cb func(d types.Delegation)
 	}
 }
 
+// IterateDelegationsByDelegator iterates all delegations of a delegator across all validators and denoms
+func (k Keeper) IterateDelegationsByDelegator(ctx sdk.Context, delAddr sdk.AccAddress, cb func(d types.Delegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegationsKey(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iter.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
 func (k Keeper) HasRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, dstVal sdk.ValAddress, denom string) bool {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetRedelegationsKey(delAddr, denom, dstVal)
@@ -487,6 +837,7 @@ func (k Keeper) upsertDelegationWithNewTokens(ctx sdk.Context, delAddr sdk.AccAd
 	delegation, found := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
 	if !found {
 		delegation = types.NewDelegation(ctx, delAddr, validator.GetOperator(), coin.Denom, newShares, validator.GlobalRewardHistory)
+		delegation.IncentiveRewardHistory = validator.IncentiveRewardHistory(coin.Denom)
 	} else {
 		delegation.Shares = delegation.Shares.Add(newShares)
 	}
@@ -499,9 +850,8 @@ func (k Keeper) upsertDelegationWithNewTokens(ctx sdk.Context, delAddr sdk.AccAd
 func (k Keeper) reduceDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin, shares sdk.Dec, delegation types.Delegation) {
 	delegation.Shares = delegation.Shares.Sub(shares)
 	if delegation.Shares.IsZero() {
-		store := ctx.KVStore(k.storeKey)
-		key := types.GetDelegationKey(delAddr, validator.GetOperator(), coin.Denom)
-		store.Delete(key)
+		k.deleteDelegation(ctx, delAddr, validator.GetOperator(), coin.Denom)
+		k.SetAutoCompound(ctx, delAddr, validator.GetOperator(), coin.Denom, false)
 	} else {
 		k.SetDelegation(ctx, delAddr, validator.GetOperator(), coin.Denom, delegation)
 	}
@@ -643,6 +643,258 @@ func TestSuccessfulUndelegation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCancelUndelegation(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	require.Len(t, delegations, 1)
+
+	// All the addresses needed
+	delAddr, err := sdk.AccAddressFromBech32(delegations[0].DelegatorAddress)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, _ := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+
+	// Mint furya tokens
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delAddr, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Delegate to a validator then undelegate twice in the same block
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Undelegate(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+	completionTime, err := app.FuryaKeeper.Undelegate(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+
+	// Cancelling more than what was undelegated fails
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000)), *completionTime)
+	require.Error(t, err)
+
+	// Cancelling with a wrong completion time fails
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)), completionTime.Add(time.Second))
+	require.Error(t, err)
+
+	// Cancel part of the undelegation spanning both entries
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(300_000)), *completionTime)
+	require.NoError(t, err)
+
+	iter := app.FuryaKeeper.IterateUndelegationsByCompletionTime(ctx, completionTime.Add(time.Second))
+	require.True(t, iter.Valid())
+	var queuedUndelegations types.QueuedUndelegation
+	app.AppCodec().MustUnmarshal(iter.Value(), &queuedUndelegations)
+	iter.Close()
+	require.Equal(t, types.QueuedUndelegation{Entries: []*types.Undelegation{
+		{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: val.GetOperator().String(),
+			Balance:          sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)),
+		},
+	}}, queuedUndelegations)
+
+	// Tokens are delegated back to the validator
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	delegation, found := app.FuryaKeeper.GetDelegation(ctx, delAddr, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(800_000), types.GetDelegationTokens(delegation, val, asset).Amount)
+	require.Equal(t, sdk.NewInt(800_000), asset.TotalTokens)
+
+	// Cancel the rest of the undelegation which removes the queue and index entries
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)), *completionTime)
+	require.NoError(t, err)
+	iter = app.FuryaKeeper.IterateUndelegationsByCompletionTime(ctx, completionTime.Add(time.Second))
+	require.False(t, iter.Valid())
+	iter.Close()
+	iter = app.FuryaKeeper.IterateUndelegationsBySrcValidator(ctx, valAddr)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	// Completing undelegations after the unbonding period does not return any tokens
+	ctx = ctx.WithBlockTime(completionTime.Add(time.Minute))
+	err = app.FuryaKeeper.CompleteUndelegations(ctx)
+	require.NoError(t, err)
+	coin := app.BankKeeper.GetBalance(ctx, delAddr, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(0)), coin)
+}
+
+func TestMultiDelegateAndUndelegate(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(10), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Get all the addresses needed for the test
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr2 := sdk.ValAddress(addrs[0])
+	_val2 := teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	delAddr := addrs[1]
+	app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx)
+
+	// A failing entry fails the whole message so nothing is delegated once the transaction is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.FuryaKeeper.MultiDelegate(cacheCtx, delAddr, []types.MultiDelegationEntry{
+		{ValidatorAddress: valAddr1.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000))},
+		{ValidatorAddress: valAddr2.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000))},
+	})
+	require.Error(t, err)
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, delAddr, FURYA_TOKEN_DENOM).Amount)
+	_, found := app.FuryaKeeper.GetDelegation(ctx, delAddr, types.FuryaValidator{Validator: &stakingtypes.Validator{OperatorAddress: valAddr1.String()}}, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	require.False(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// Delegate to both validators, including the same validator twice
+	newShares, err := app.FuryaKeeper.MultiDelegate(ctx, delAddr, []types.MultiDelegationEntry{
+		{ValidatorAddress: valAddr1.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000))},
+		{ValidatorAddress: valAddr2.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(400_000))},
+		{ValidatorAddress: valAddr1.String(), Amount: sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(100_000))},
+	})
+	require.NoError(t, err)
+	require.Len(t, newShares, 3)
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+	require.Equal(t, sdk.ZeroInt(), app.BankKeeper.GetBalance(ctx, delAddr, FURYA_TOKEN_DENOM).Amount)
+
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1000_000), asset.TotalTokens)
+	delegation, found := app.FuryaKeeper.GetDelegation(ctx, delAddr, val1, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000)), types.GetDelegationTokens(delegation, val1, asset))
+
+	// Undelegating more than what was delegated fails for every entry
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = app.FuryaKeeper.MultiUndelegate(cacheCtx, delAddr, []types.MultiDelegationEntry{
+		{ValidatorAddress: valAddr1.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000))},
+		{ValidatorAddress: valAddr2.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000))},
+	})
+	require.Error(t, err)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, delAddr, val1, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.False(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// Undelegate from both validators
+	completionTimes, err := app.FuryaKeeper.MultiUndelegate(ctx, delAddr, []types.MultiDelegationEntry{
+		{ValidatorAddress: valAddr1.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(600_000))},
+		{ValidatorAddress: valAddr2.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(400_000))},
+	})
+	require.NoError(t, err)
+	require.Len(t, completionTimes, 2)
+	unbondingTime := app.StakingKeeper.UnbondingTime(ctx)
+	for _, completionTime := range completionTimes {
+		require.Equal(t, ctx.BlockTime().Add(unbondingTime), completionTime)
+	}
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.ZeroInt(), asset.TotalTokens)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, delAddr, val1, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+
+	// Transfers require an existing delegation
+	_, err = app.FuryaKeeper.TransferDelegation(ctx, user1, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegatorForAddress)
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(750_000)))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	// Transfer to reward pool
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Transferring more than what was delegated fails
+	_, err = app.FuryaKeeper.TransferDelegation(ctx, user1, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(800_000)))
+	require.Error(t, err)
+
+	// Pending rewards of both sides are settled before the transfer
+	totalDelegatorShares := val.TotalDelegatorShares
+	_, err = app.FuryaKeeper.TransferDelegation(ctx, user1, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(750_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(250_000)), app.BankKeeper.GetBalance(ctx, user2, "stake"))
+
+	// Validator shares are not changed
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, totalDelegatorShares, val.TotalDelegatorShares)
+
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	delegation1, found := app.FuryaKeeper.GetDelegation(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)), types.GetDelegationTokens(delegation1, val, asset))
+	delegation2, found := app.FuryaKeeper.GetDelegation(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)), types.GetDelegationTokens(delegation2, val, asset))
+
+	// Transferring everything removes the delegation of the sender
+	_, err = app.FuryaKeeper.TransferDelegation(ctx, user1, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	delegation2, found = app.FuryaKeeper.GetDelegation(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)), types.GetDelegationTokens(delegation2, val, asset))
+	require.Equal(t, totalDelegatorShares, val.TotalDelegatorShares)
+}
+
 func TestUndelegationWithoutDelegation(t *testing.T) {
 	app, ctx := createTestContext(t)
 	ctx = ctx.WithBlockTime(time.Now())
@@ -923,3 +1175,148 @@ func TestDelegationWithNativeStakingChanges(t *testing.T) {
 	// Check total bonded tokens
 	require.Equal(t, sdk.NewInt(26_000_000), app.StakingKeeper.TotalBondedTokens(ctx))
 }
+
+func TestDelegationLimits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	minDelegationAmount := sdk.NewInt(100_000)
+	maxTotalTokens := sdk.NewInt(1000_000)
+	asset := types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime())
+	asset.MinDelegationAmount = &minDelegationAmount
+	asset.MaxTotalTokens = &maxTotalTokens
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{asset},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr2 := sdk.ValAddress(addrs[0])
+	_val2 := teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	val2, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	delAddr1 := addrs[1]
+	delAddr2 := addrs[2]
+
+	// Delegations below the minimum amount are rejected
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(99_999)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(800_000)))
+	require.NoError(t, err)
+
+	res, err := queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200_000), *res.RemainingCapacity)
+
+	// Delegations over the remaining capacity are rejected
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr2, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_001)))
+	require.ErrorIs(t, err, types.ErrAssetCapacityExceeded)
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr2, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)))
+	require.NoError(t, err)
+
+	res, err = queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.True(t, res.RemainingCapacity.IsZero())
+
+	// Redelegations below the minimum amount are rejected
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Redelegate(ctx, delAddr1, val1, val2, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(50_000)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	// Redelegations are not limited by the capacity of the asset
+	_, err = app.FuryaKeeper.Redelegate(ctx, delAddr1, val1, val2, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+
+	// Removing the limits through governance lifts the cap
+	err = app.FuryaKeeper.UpdateFurya(ctx, &types.MsgUpdateFuryaProposal{
+		Denom:            FURYA_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(2),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	require.NoError(t, err)
+	res, err = queryServer.Furya(ctx, &types.QueryFuryaRequest{Denom: FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Nil(t, res.RemainingCapacity)
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr2, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(50_000)))
+	require.NoError(t, err)
+}
+
+func TestDelegationLimitsOnCancelAndCompound(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	minDelegationAmount := sdk.NewInt(100_000)
+	maxTotalTokens := sdk.NewInt(1000_000)
+	asset := types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime())
+	asset.MinDelegationAmount = &minDelegationAmount
+	asset.MaxTotalTokens = &maxTotalTokens
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{asset},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	delAddr1 := addrs[0]
+	delAddr2 := addrs[1]
+
+	// Fill the asset up to its capacity
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	err = app.FuryaKeeper.UpdateAutoCompound(ctx, delAddr1, val, FURYA_TOKEN_DENOM, true)
+	require.NoError(t, err)
+
+	// Rewards that would take a full asset over its capacity are paid out instead of compounded
+	rewards := sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards)
+	require.NoError(t, err)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, rewards)
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, delAddr1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.True(t, coins.AmountOf(FURYA_TOKEN_DENOM).IsPositive())
+	require.Equal(t, coins.AmountOf(FURYA_TOKEN_DENOM), app.BankKeeper.GetBalance(ctx, delAddr1, FURYA_TOKEN_DENOM).Amount)
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, maxTotalTokens, asset.TotalTokens)
+
+	// Undelegated tokens cannot be delegated back once the freed capacity has been taken
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	completionTime, err := app.FuryaKeeper.Undelegate(ctx, delAddr1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)))
+	require.NoError(t, err)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	_, err = app.FuryaKeeper.Delegate(ctx, delAddr2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)))
+	require.NoError(t, err)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(200_000)), *completionTime)
+	require.ErrorIs(t, err, types.ErrAssetCapacityExceeded)
+
+	// Cancelled undelegations below the minimum amount are rejected
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, delAddr1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(50_000)), *completionTime)
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, maxTotalTokens, asset.TotalTokens)
+}
@@ -11,6 +11,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, g *types.GenesisState) []abci.Valid
 	k.SetParams(ctx, g.Params)
 	for _, asset := range g.Assets {
 		k.SetAsset(ctx, asset)
+		// Schedule updates are not exported, the schedules are evaluated again on the first block
+		k.QueueRewardWeightSchedule(ctx, asset)
 	}
 
 	for _, val := range g.ValidatorInfos {
@@ -23,6 +25,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, g *types.GenesisState) []abci.Valid
 		valAddr, _ := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
 		k.SetDelegation(ctx, delAddr, valAddr, delegation.Denom, delegation)
 	}
+	k.setDelegationClaimHeightIndexed(ctx)
 
 	for _, redelegationState := range g.Redelegations {
 		delAddr, _ := sdk.AccAddressFromBech32(redelegationState.Redelegation.DelegatorAddress)
@@ -50,6 +53,79 @@ func (k Keeper) InitGenesis(ctx sdk.Context, g *types.GenesisState) []abci.Valid
 		k.setRewardWeightChangeSnapshot(ctx, rewardWeightSnapshot.Denom, valAddr, rewardWeightSnapshot.Height, rewardWeightSnapshot.Snapshot)
 	}
 
+	for _, withdrawAddressState := range g.WithdrawAddresses {
+		delAddr, _ := sdk.AccAddressFromBech32(withdrawAddressState.DelegatorAddress)
+		withdrawAddr, _ := sdk.AccAddressFromBech32(withdrawAddressState.WithdrawAddress)
+		if err := k.SetWithdrawAddress(ctx, delAddr, withdrawAddr); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, autoCompoundState := range g.AutoCompounds {
+		delAddr, _ := sdk.AccAddressFromBech32(autoCompoundState.DelegatorAddress)
+		valAddr, _ := sdk.ValAddressFromBech32(autoCompoundState.ValidatorAddress)
+		k.SetAutoCompound(ctx, delAddr, valAddr, autoCompoundState.Denom, true)
+	}
+
+	for _, tokenized := range g.TokenizedDelegations {
+		k.SetTokenizedDelegation(ctx, tokenized)
+	}
+
+	for _, history := range g.TokenHolderRewardHistories {
+		holder, _ := sdk.AccAddressFromBech32(history.HolderAddress)
+		k.SetTokenHolderRewardHistory(ctx, holder, history)
+	}
+
+	for _, preferences := range g.ValidatorPreferences {
+		valAddr, _ := sdk.ValAddressFromBech32(preferences.ValidatorAddress)
+		k.setValidatorPreferences(ctx, valAddr, preferences)
+	}
+
+	for _, forceUndelegation := range g.ForceUndelegations {
+		valAddr, _ := sdk.ValAddressFromBech32(forceUndelegation.ValidatorAddress)
+		k.setForceUndelegation(ctx, valAddr, forceUndelegation.Denom, forceUndelegation.NextKey)
+	}
+
+	for _, commission := range g.ValidatorCommissions {
+		valAddr, _ := sdk.ValAddressFromBech32(commission.ValidatorAddress)
+		k.setValidatorCommission(ctx, valAddr, commission)
+	}
+
+	for _, price := range g.Prices {
+		k.SetPrice(ctx, price)
+	}
+
+	// The next incentive id is derived from the highest id of all incentives
+	var lastIncentiveID uint64
+	for _, incentive := range g.Incentives {
+		k.SetIncentive(ctx, incentive)
+		if incentive.Id > lastIncentiveID {
+			lastIncentiveID = incentive.Id
+		}
+	}
+	for _, incentive := range g.FinishedIncentives {
+		k.setFinishedIncentive(ctx, incentive)
+		if incentive.Id > lastIncentiveID {
+			lastIncentiveID = incentive.Id
+		}
+	}
+	k.setNextIncentiveID(ctx, lastIncentiveID+1)
+
+	for _, revenue := range g.TakeRateRevenues {
+		k.SetTakeRateRevenue(ctx, revenue)
+	}
+
+	k.SetRewardDust(ctx, g.RewardDust)
+
+	var lastSlashID uint64
+	for _, slash := range g.Slashes {
+		k.SetSlash(ctx, slash)
+		if slash.Id > lastSlashID {
+			lastSlashID = slash.Id
+		}
+	}
+	k.setNextSlashID(ctx, lastSlashID+1)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -99,11 +175,80 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	state.Params = types.Params{
-		RewardDelayTime:       k.RewardDelayTime(ctx),
-		TakeRateClaimInterval: k.RewardClaimInterval(ctx),
-		LastTakeRateClaimTime: k.LastRewardClaimTime(ctx),
-	}
+	k.IterateWithdrawAddresses(ctx, func(delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) (stop bool) {
+		state.WithdrawAddresses = append(state.WithdrawAddresses, types.WithdrawAddressState{
+			DelegatorAddress: delAddr.String(),
+			WithdrawAddress:  withdrawAddr.String(),
+		})
+		return false
+	})
+
+	k.IterateAutoCompounds(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (stop bool) {
+		state.AutoCompounds = append(state.AutoCompounds, types.AutoCompoundState{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			Denom:            denom,
+		})
+		return false
+	})
+
+	k.IterateTokenizedDelegations(ctx, func(tokenized types.TokenizedDelegation) (stop bool) {
+		state.TokenizedDelegations = append(state.TokenizedDelegations, tokenized)
+		return false
+	})
+
+	k.IterateTokenHolderRewardHistories(ctx, func(history types.TokenHolderRewardHistory) (stop bool) {
+		state.TokenHolderRewardHistories = append(state.TokenHolderRewardHistories, history)
+		return false
+	})
+
+	k.IterateValidatorPreferences(ctx, func(preferences types.ValidatorFuryaPreferences) (stop bool) {
+		state.ValidatorPreferences = append(state.ValidatorPreferences, preferences)
+		return false
+	})
+
+	k.IterateForceUndelegations(ctx, func(valAddr sdk.ValAddress, denom string, nextKey []byte) (stop bool) {
+		state.ForceUndelegations = append(state.ForceUndelegations, types.ForceUndelegationState{
+			ValidatorAddress: valAddr.String(),
+			Denom:            denom,
+			NextKey:          nextKey,
+		})
+		return false
+	})
+
+	k.IterateValidatorCommissions(ctx, func(commission types.ValidatorFuryaCommission) (stop bool) {
+		state.ValidatorCommissions = append(state.ValidatorCommissions, commission)
+		return false
+	})
+
+	k.IteratePrices(ctx, func(price types.FuryaPrice) (stop bool) {
+		state.Prices = append(state.Prices, price)
+		return false
+	})
+
+	k.IterateIncentives(ctx, func(incentive types.FuryaIncentive) (stop bool) {
+		state.Incentives = append(state.Incentives, incentive)
+		return false
+	})
+
+	k.IterateFinishedIncentives(ctx, func(incentive types.FuryaIncentive) (stop bool) {
+		state.FinishedIncentives = append(state.FinishedIncentives, incentive)
+		return false
+	})
+
+	k.IterateTakeRateRevenues(ctx, func(revenue sdk.Coin) (stop bool) {
+		state.TakeRateRevenues = append(state.TakeRateRevenues, revenue)
+		return false
+	})
+
+	state.RewardDust = k.GetRewardDust(ctx)
+
+	k.IterateSlashes(ctx, func(slash types.FuryaSlash) (stop bool) {
+		state.Slashes = append(state.Slashes, slash)
+		return false
+	})
+
+	state.Params = k.GetParams(ctx)
 
 	return &state
 }
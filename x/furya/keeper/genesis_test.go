@@ -108,11 +108,23 @@ func TestExportAndImportGenesis(t *testing.T) {
 	_, err = app.FuryaKeeper.Undelegate(ctx, delAddr, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000_000)))
 	require.NoError(t, err)
 
+	// Set withdraw address
+	err = app.FuryaKeeper.SetWithdrawAddress(ctx, delAddr, addrs[1])
+	require.NoError(t, err)
+
+	// Enable auto-compounding
+	err = app.FuryaKeeper.UpdateAutoCompound(ctx, delAddr, val2, FURYA_TOKEN_DENOM, true)
+	require.NoError(t, err)
+
 	// Trigger update asset
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 25)).WithBlockHeight(ctx.BlockHeight() + 1)
 	err = app.FuryaKeeper.UpdateFuryaAsset(ctx, types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), ctx.BlockTime()))
 	require.NoError(t, err)
 
+	// Block the asset on the second validator
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr2, nil, []string{FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+
 	genesisState := app.FuryaKeeper.ExportGenesis(ctx)
 	require.NotNil(t, genesisState.Params)
 	require.Greater(t, len(genesisState.Assets), 0)
@@ -121,6 +133,9 @@ func TestExportAndImportGenesis(t *testing.T) {
 	require.Greater(t, len(genesisState.Undelegations), 0)
 	require.Greater(t, len(genesisState.Redelegations), 0)
 	require.Greater(t, len(genesisState.RewardWeightChangeSnaphots), 0)
+	require.Greater(t, len(genesisState.WithdrawAddresses), 0)
+	require.Greater(t, len(genesisState.AutoCompounds), 0)
+	require.Greater(t, len(genesisState.ForceUndelegations), 0)
 
 	store := ctx.KVStore(app.FuryaKeeper.StoreKey())
 	iter := store.Iterator(nil, nil)
@@ -140,6 +140,9 @@ func (k QueryServer) AllFuryaValidators(c context.Context, req *types.QueryAllFu
 
 var _ types.QueryServer = QueryServer{}
 
+// projectedRewardWeightsLimit is the number of upcoming reward changes returned by the furya query
+const projectedRewardWeightsLimit = 10
+
 func (k QueryServer) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	// Define a variable that will store the params
 	var params types.Params
@@ -210,7 +213,9 @@ func (k QueryServer) Furya(c context.Context, req *types.QueryFuryaRequest) (*ty
 
 	// Return parsed asset, true since the asset exists
 	return &types.QueryFuryaResponse{
-		Furya: &asset,
+		Furya:                  &asset,
+		RemainingCapacity:      asset.RemainingCapacity(),
+		ProjectedRewardWeights: asset.ProjectRewardWeights(projectedRewardWeightsLimit),
 	}, nil
 }
 
@@ -446,3 +451,299 @@ func (k QueryServer) IBCFuryaDelegation(c context.Context, request *types.QueryI
 func NewQueryServerImpl(keeper Keeper) types.QueryServer {
 	return &QueryServer{Keeper: keeper}
 }
+
+func (k QueryServer) FuryaWithdrawAddress(c context.Context, req *types.QueryFuryaWithdrawAddressRequest) (*types.QueryFuryaWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFuryaWithdrawAddressResponse{
+		WithdrawAddress: k.GetWithdrawAddress(ctx, delAddr).String(),
+	}, nil
+}
+
+func (k QueryServer) FuryaPowerClamps(c context.Context, req *types.QueryFuryaPowerClampsRequest) (*types.QueryFuryaPowerClampsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, clamps, err := k.CalculateFuryaBondAmounts(ctx, k.GetAllAssets(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFuryaPowerClampsResponse{
+		Clamps: clamps,
+	}, nil
+}
+
+func (k QueryServer) FuryaSnapshotCounts(c context.Context, req *types.QueryFuryaSnapshotCountsRequest) (*types.QueryFuryaSnapshotCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var counts []types.FuryaSnapshotCount
+	var total uint64
+	k.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, height uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+		// Snapshots are sorted by denom and validator so the counts of a pair are consecutive
+		last := len(counts) - 1
+		if last < 0 || counts[last].Denom != denom || counts[last].ValidatorAddress != valAddr.String() {
+			oldestClaimHeight, _ := k.GetOldestRewardClaimHeight(ctx, denom, valAddr)
+			counts = append(counts, types.FuryaSnapshotCount{
+				Denom:             denom,
+				ValidatorAddress:  valAddr.String(),
+				OldestClaimHeight: oldestClaimHeight,
+			})
+			last++
+		}
+		counts[last].Count++
+		total++
+		return false
+	})
+
+	return &types.QueryFuryaSnapshotCountsResponse{
+		Counts: counts,
+		Total:  total,
+	}, nil
+}
+
+func (k QueryServer) FuryaAPR(c context.Context, req *types.QueryFuryaAPRRequest) (*types.QueryFuryaAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	asset, found := k.GetAssetByDenom(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrUnknownAsset
+	}
+
+	var valAddrs []sdk.ValAddress
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	} else {
+		k.IterateFuryaValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.FuryaValidatorInfo) bool {
+			valAddrs = append(valAddrs, valAddr)
+			return false
+		})
+	}
+
+	// Rewards accrue on the principal that is left after the take rate was deducted for a year
+	takeRateAPR := k.AnnualTakeRate(ctx, asset)
+	netShare := sdk.OneDec().Sub(takeRateAPR)
+	totalTokens := sdk.NewDecFromInt(asset.TotalTokens)
+	weightedAPR := sdk.NewDecCoins()
+	var validators []types.FuryaValidatorAPR
+	for _, valAddr := range valAddrs {
+		validator, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		apr, sampleDuration := k.EstimateValidatorAPR(ctx, asset, valAddr)
+		apr = apr.MulDecTruncate(netShare)
+		commissionRate := sdk.ZeroDec()
+		if commission, found := k.GetValidatorCommission(ctx, valAddr); found {
+			commissionRate = commission.Commission.Rate
+		}
+		validators = append(validators, types.FuryaValidatorAPR{
+			ValidatorAddress: valAddr.String(),
+			RewardsApr:       apr,
+			CommissionRate:   commissionRate,
+			SampleDuration:   sampleDuration,
+		})
+
+		if req.ValidatorAddr != "" {
+			weightedAPR = apr
+		} else if totalTokens.IsPositive() {
+			// Validators are weighted by their share of the asset's total tokens
+			weight := validator.TotalDecTokensWithAsset(asset).Quo(totalTokens)
+			weightedAPR = weightedAPR.Add(apr.MulDecTruncate(weight)...)
+		}
+	}
+
+	return &types.QueryFuryaAPRResponse{
+		RewardsApr:  weightedAPR,
+		TakeRateApr: takeRateAPR,
+		Validators:  validators,
+	}, nil
+}
+
+func (k QueryServer) FuryaPrices(c context.Context, req *types.QueryFuryaPricesRequest) (*types.QueryFuryaPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var prices []types.FuryaPrice
+	k.IteratePrices(ctx, func(price types.FuryaPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+
+	return &types.QueryFuryaPricesResponse{
+		Prices: prices,
+	}, nil
+}
+
+func (k QueryServer) FuryaActiveIncentives(c context.Context, req *types.QueryFuryaIncentivesRequest) (*types.QueryFuryaIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.paginateIncentives(ctx, types.FuryaIncentiveKey, req.Pagination)
+}
+
+func (k QueryServer) FuryaFinishedIncentives(c context.Context, req *types.QueryFuryaIncentivesRequest) (*types.QueryFuryaIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.paginateIncentives(ctx, types.FinishedFuryaIncentiveKey, req.Pagination)
+}
+
+func (k QueryServer) paginateIncentives(ctx sdk.Context, keyPrefix []byte, pagination *query.PageRequest) (*types.QueryFuryaIncentivesResponse, error) {
+	res := &types.QueryFuryaIncentivesResponse{}
+	incentiveStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(incentiveStore, pagination, func(key []byte, value []byte) error {
+		var incentive types.FuryaIncentive
+		k.cdc.MustUnmarshal(value, &incentive)
+		res.Incentives = append(res.Incentives, incentive)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
+
+func (k QueryServer) FuryaTakeRateRevenue(c context.Context, req *types.QueryFuryaTakeRateRevenueRequest) (*types.QueryFuryaTakeRateRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	revenues := sdk.NewCoins()
+	k.IterateTakeRateRevenues(ctx, func(revenue sdk.Coin) (stop bool) {
+		revenues = revenues.Add(revenue)
+		return false
+	})
+
+	return &types.QueryFuryaTakeRateRevenueResponse{
+		Revenues: revenues,
+	}, nil
+}
+
+func (k QueryServer) FuryaUnallocatedRewards(c context.Context, req *types.QueryFuryaUnallocatedRewardsRequest) (*types.QueryFuryaUnallocatedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var unallocatedRewards []types.ValidatorUnallocatedRewards
+	k.IterateFuryaValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.FuryaValidatorInfo) (stop bool) {
+		if !info.UnallocatedRewards.IsZero() {
+			unallocatedRewards = append(unallocatedRewards, types.ValidatorUnallocatedRewards{
+				ValidatorAddress: valAddr.String(),
+				Rewards:          info.UnallocatedRewards,
+			})
+		}
+		return false
+	})
+
+	return &types.QueryFuryaUnallocatedRewardsResponse{
+		UnallocatedRewards: unallocatedRewards,
+	}, nil
+}
+
+func (k QueryServer) FuryaRewardDust(c context.Context, req *types.QueryFuryaRewardDustRequest) (*types.QueryFuryaRewardDustResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	excess, err := k.RewardsPoolExcess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFuryaRewardDustResponse{
+		RewardDust: k.GetRewardDust(ctx),
+		Excess:     excess,
+	}, nil
+}
+
+func (k QueryServer) FuryaSlashes(c context.Context, req *types.QueryFuryaSlashesRequest) (*types.QueryFuryaSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	keyPrefix := types.FuryaSlashKey
+	if req.ValidatorAddr != "" {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+		keyPrefix = types.GetFuryaSlashesKey(valAddr)
+	}
+	var delAddr sdk.AccAddress
+	if req.DelegatorAddr != "" {
+		var err error
+		delAddr, err = sdk.AccAddressFromBech32(req.DelegatorAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	res := &types.QueryFuryaSlashesResponse{}
+	slashStore := prefix.NewStore(store, keyPrefix)
+	pageRes, err := query.FilteredPaginate(slashStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var slash types.FuryaSlash
+		k.cdc.MustUnmarshal(value, &slash)
+
+		denoms := slash.Denoms()
+		if req.Denom != "" && !denoms[req.Denom] {
+			return false, nil
+		}
+		if delAddr != nil && !slash.HasDelegatorEntries(delAddr, req.Denom) {
+			// Delegations to the slashed validator were slashed through its validator shares
+			valAddr, err := sdk.ValAddressFromBech32(slash.ValidatorAddress)
+			if err != nil {
+				return false, err
+			}
+			delegated := false
+			for denom := range denoms {
+				if (req.Denom == "" || req.Denom == denom) && store.Has(types.GetDelegationKey(delAddr, valAddr, denom)) {
+					delegated = true
+					break
+				}
+			}
+			if !delegated {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			res.Slashes = append(res.Slashes, slash)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Pagination = pageRes
+	return res, nil
+}
@@ -859,3 +859,26 @@ func TestQueryValidators(t *testing.T) {
 		Pagination: queryVal2.Pagination,
 	}, queryVal2)
 }
+
+func TestQueryWithdrawAddress(t *testing.T) {
+	// GIVEN: THE BLOCKCHAIN WITH A WITHDRAW ADDRESS SET
+	app, ctx := createTestContext(t)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins())
+	err := app.FuryaKeeper.SetWithdrawAddress(ctx, addrs[0], addrs[1])
+	require.NoError(t, err)
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// WHEN: QUERYING THE WITHDRAW ADDRESSES
+	res1, err1 := queryServer.FuryaWithdrawAddress(ctx, &types.QueryFuryaWithdrawAddressRequest{
+		DelegatorAddr: addrs[0].String(),
+	})
+	res2, err2 := queryServer.FuryaWithdrawAddress(ctx, &types.QueryFuryaWithdrawAddressRequest{
+		DelegatorAddr: addrs[2].String(),
+	})
+
+	// THEN: VALIDATE THAT THE SET ADDRESS IS RETURNED AND THE DELEGATOR IS THE DEFAULT
+	require.NoError(t, err1)
+	require.Equal(t, addrs[1].String(), res1.WithdrawAddress)
+	require.NoError(t, err2)
+	require.Equal(t, addrs[2].String(), res2.WithdrawAddress)
+}
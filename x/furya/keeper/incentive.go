@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateIncentive escrows the rewards of an incentive program in the rewards pool and pays the creation fee to the
+// community pool. Programs that would start in the past start at the current block time instead.
+// Active programs and their reward denoms are limited since every active program is distributed in the end blocker.
+func (k Keeper) CreateIncentive(ctx sdk.Context, funder sdk.AccAddress, denom string, valAddr sdk.ValAddress, rewards sdk.Coins, startTime time.Time, endTime time.Time) (types.FuryaIncentive, error) {
+	if _, found := k.GetAssetByDenom(ctx, denom); !found {
+		return types.FuryaIncentive{}, types.ErrUnknownAsset
+	}
+	if !valAddr.Empty() {
+		if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+			return types.FuryaIncentive{}, stakingtypes.ErrNoValidatorFound
+		}
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return types.FuryaIncentive{}, status.Errorf(codes.InvalidArgument, "Furya incentive must end after the current block time")
+	}
+	if startTime.Before(ctx.BlockTime()) {
+		startTime = ctx.BlockTime()
+	}
+	if maxDenoms := k.MaxIncentiveRewardDenoms(ctx); len(rewards) > int(maxDenoms) {
+		return types.FuryaIncentive{}, status.Errorf(codes.InvalidArgument, "Furya incentive cannot have more than %d reward denoms", maxDenoms)
+	}
+	if maxIncentives := k.MaxActiveIncentives(ctx); k.countIncentives(ctx, maxIncentives) >= maxIncentives {
+		return types.FuryaIncentive{}, status.Errorf(codes.ResourceExhausted, "Furya incentives are limited to %d active programs", maxIncentives)
+	}
+
+	if fee := k.IncentiveCreationFee(ctx); !fee.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, fee, funder); err != nil {
+			return types.FuryaIncentive{}, err
+		}
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.RewardsPoolName, rewards)
+	if err != nil {
+		return types.FuryaIncentive{}, err
+	}
+
+	incentive := types.FuryaIncentive{
+		Id:                   k.nextIncentiveID(ctx),
+		Funder:               funder.String(),
+		Denom:                denom,
+		TotalRewards:         rewards,
+		DistributedRewards:   sdk.NewCoins(),
+		StartTime:            startTime,
+		EndTime:              endTime,
+		LastDistributionTime: startTime,
+	}
+	if !valAddr.Empty() {
+		incentive.ValidatorAddress = valAddr.String()
+	}
+	k.SetIncentive(ctx, incentive)
+	return incentive, nil
+}
+
+func (k Keeper) nextIncentiveID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if b := store.Get(types.NextFuryaIncentiveIDKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	store.Set(types.NextFuryaIncentiveIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+func (k Keeper) setNextIncentiveID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextFuryaIncentiveIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) SetIncentive(ctx sdk.Context, incentive types.FuryaIncentive) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFuryaIncentiveKey(incentive.Id), k.cdc.MustMarshal(&incentive))
+}
+
+func (k Keeper) setFinishedIncentive(ctx sdk.Context, incentive types.FuryaIncentive) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFinishedFuryaIncentiveKey(incentive.Id), k.cdc.MustMarshal(&incentive))
+}
+
+func (k Keeper) IterateIncentives(ctx sdk.Context, cb func(incentive types.FuryaIncentive) (stop bool)) {
+	k.iterateIncentives(ctx, types.FuryaIncentiveKey, cb)
+}
+
+func (k Keeper) IterateFinishedIncentives(ctx sdk.Context, cb func(incentive types.FuryaIncentive) (stop bool)) {
+	k.iterateIncentives(ctx, types.FinishedFuryaIncentiveKey, cb)
+}
+
+// countIncentives counts the active incentives, stopping once limit is reached
+func (k Keeper) countIncentives(ctx sdk.Context, limit uint32) (count uint32) {
+	k.IterateIncentives(ctx, func(incentive types.FuryaIncentive) (stop bool) {
+		count++
+		return count >= limit
+	})
+	return count
+}
+
+func (k Keeper) iterateIncentives(ctx sdk.Context, prefix []byte, cb func(incentive types.FuryaIncentive) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var incentive types.FuryaIncentive
+		k.cdc.MustUnmarshal(iter.Value(), &incentive)
+		if cb(incentive) {
+			return
+		}
+	}
+}
+
+// IncentiveHook streams the escrowed rewards of the active incentives to the delegators of their assets.
+// Incentives are collected first since distributing writes to the store.
+func (k Keeper) IncentiveHook(ctx sdk.Context) {
+	var incentives []types.FuryaIncentive
+	k.IterateIncentives(ctx, func(incentive types.FuryaIncentive) (stop bool) {
+		if ctx.BlockTime().After(incentive.LastDistributionTime) {
+			incentives = append(incentives, incentive)
+		}
+		return false
+	})
+
+	for _, incentive := range incentives {
+		// Each incentive is distributed in its own cache context so that a single failure does not halt the others
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.distributeIncentive(cacheCtx, incentive); err != nil {
+			k.Logger(ctx).Error("failed to distribute furya incentive", "id", incentive.Id, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// distributeIncentive distributes the share of the remaining rewards of an incentive that accrued since its last
+// distribution. Spreading the remaining rewards over the remaining time means that periods without delegations do not
+// lose rewards. Rewards that could not be distributed by the end of the incentive are refunded to the funder.
+func (k Keeper) distributeIncentive(ctx sdk.Context, incentive types.FuryaIncentive) error {
+	distributionTime := ctx.BlockTime()
+	if distributionTime.After(incentive.EndTime) {
+		distributionTime = incentive.EndTime
+	}
+	elapsed := distributionTime.Sub(incentive.LastDistributionTime)
+	remainingDuration := incentive.EndTime.Sub(incentive.LastDistributionTime)
+
+	rewards := sdk.NewCoins()
+	for _, c := range incentive.TotalRewards.Sub(incentive.DistributedRewards...) {
+		amount := c.Amount.MulRaw(int64(elapsed)).QuoRaw(int64(remainingDuration))
+		rewards = rewards.Add(sdk.NewCoin(c.Denom, amount))
+	}
+	if asset, found := k.GetAssetByDenom(ctx, incentive.Denom); found && !rewards.IsZero() {
+		distributed, err := k.addIncentivesToRewardPool(ctx, incentive, asset, rewards)
+		if err != nil {
+			return err
+		}
+		incentive.DistributedRewards = incentive.DistributedRewards.Add(distributed...)
+	}
+	incentive.LastDistributionTime = distributionTime
+	if distributionTime.Before(incentive.EndTime) {
+		k.SetIncentive(ctx, incentive)
+		return nil
+	}
+
+	refund := incentive.TotalRewards.Sub(incentive.DistributedRewards...)
+	if !refund.IsZero() {
+		funder, err := sdk.AccAddressFromBech32(incentive.Funder)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, funder, refund); err != nil {
+			return err
+		}
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetFuryaIncentiveKey(incentive.Id))
+	k.setFinishedIncentive(ctx, incentive)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFinishIncentive,
+		sdk.NewAttribute(types.AttributeKeyIncentiveID, sdk.NewIntFromUint64(incentive.Id).String()),
+		sdk.NewAttribute(types.AttributeKeyDenom, incentive.Denom),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, incentive.DistributedRewards.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+	return nil
+}
+
+// addIncentivesToRewardPool splits rewards that are already held by the rewards pool between the validators of an
+// incentive by their tokens of the incentive asset and increments the validators' incentive reward indices of the asset.
+// It is the asset scoped counterpart of AddAssetsToRewardPool, indices store the rewards per token without reward weight.
+func (k Keeper) addIncentivesToRewardPool(ctx sdk.Context, incentive types.FuryaIncentive, asset types.FuryaAsset, rewards sdk.Coins) (sdk.Coins, error) {
+	var valAddrs []sdk.ValAddress
+	if incentive.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(incentive.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	} else {
+		k.IterateFuryaValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.FuryaValidatorInfo) (stop bool) {
+			if sdk.DecCoins(info.ValidatorShares).AmountOf(asset.Denom).IsPositive() {
+				valAddrs = append(valAddrs, valAddr)
+			}
+			return false
+		})
+	}
+
+	var validators []types.FuryaValidator
+	totalTokens := sdk.ZeroDec()
+	for _, valAddr := range valAddrs {
+		// Validators that no longer exist do not receive incentives
+		val, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			continue
+		}
+		validators = append(validators, val)
+		totalTokens = totalTokens.Add(val.TotalDecTokensWithAsset(asset))
+	}
+
+	distributed := sdk.NewCoins()
+	if !totalTokens.IsPositive() {
+		return distributed, nil
+	}
+	for _, val := range validators {
+		valTokens := val.TotalDecTokensWithAsset(asset)
+		if !valTokens.IsPositive() {
+			continue
+		}
+		rewardsPerToken := sdk.NewDecCoins()
+		for _, c := range rewards {
+			amount := sdk.NewDecFromInt(c.Amount).Mul(valTokens).Quo(totalTokens).TruncateInt()
+			if amount.IsZero() {
+				continue
+			}
+			rewardsPerToken = rewardsPerToken.Add(sdk.NewDecCoinFromDec(c.Denom, sdk.NewDecFromInt(amount).Quo(valTokens)))
+			distributed = distributed.Add(sdk.NewCoin(c.Denom, amount))
+		}
+		if rewardsPerToken.IsZero() {
+			continue
+		}
+		val.AddIncentiveRewards(asset.Denom, rewardsPerToken)
+		k.SetValidator(ctx, val)
+	}
+	return distributed, nil
+}
+
+// CalculateDelegationIncentives calculates the incentive rewards that can be claimed for a delegation and the dust left by truncating them
+func (k Keeper) CalculateDelegationIncentives(delegation types.Delegation, val types.FuryaValidator, asset types.FuryaAsset) (sdk.Coins, sdk.DecCoins, types.RewardHistories) {
+	currentRewardHistory := val.IncentiveRewardHistory(asset.Denom)
+	rewards, _, dust := accumulateRewards(currentRewardHistory, types.NewRewardHistories(delegation.IncentiveRewardHistory), asset, sdk.OneDec(), delegation, val)
+	return rewards, dust, currentRewardHistory
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuryaIncentive(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+			types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin("uincentive", sdk.NewInt(1000_000)),
+	))
+	funder, user1, user2 := addrs[0], addrs[1], addrs[2]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+
+	// Incentives must target a furya asset and end in the future
+	rewards := sdk.NewCoins(sdk.NewCoin("uincentive", sdk.NewInt(1000)))
+	_, err = app.FuryaKeeper.CreateIncentive(ctx, funder, "unknown", nil, rewards, startTime, startTime.Add(time.Hour))
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+	_, err = app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_TOKEN_DENOM, nil, rewards, startTime, startTime)
+	require.Error(t, err)
+
+	// Stream 1000 uincentive to the delegators of the first asset over 10 hours
+	incentive, err := app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_TOKEN_DENOM, nil, rewards, startTime, startTime.Add(time.Hour*10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), incentive.Id)
+	require.Equal(t, sdk.NewInt(999_000), app.BankKeeper.GetBalance(ctx, funder, "uincentive").Amount)
+
+	// Nothing is delegated during the first hour so its rewards are spread over the remaining time
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour)).WithBlockHeight(2)
+	app.FuryaKeeper.IncentiveHook(ctx)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// Half of the remaining rewards are distributed after half of the remaining time
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 11 / 2)).WithBlockHeight(3)
+	app.FuryaKeeper.IncentiveHook(ctx)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), coins.AmountOf("uincentive"))
+
+	// Delegators of other assets do not receive the incentive
+	coins, err = app.FuryaKeeper.ClaimDelegationRewards(ctx, user2, val, FURYA_2_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.True(t, coins.AmountOf("uincentive").IsZero())
+
+	// Active incentives are queried
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	res, err := queryServer.FuryaActiveIncentives(ctx, &types.QueryFuryaIncentivesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Incentives, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uincentive", sdk.NewInt(500))), res.Incentives[0].DistributedRewards)
+
+	// The incentive finishes at its end time even if blocks were skipped
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 20)).WithBlockHeight(4)
+	app.FuryaKeeper.IncentiveHook(ctx)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err = app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), coins.AmountOf("uincentive"))
+
+	res, err = queryServer.FuryaActiveIncentives(ctx, &types.QueryFuryaIncentivesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Incentives, 0)
+	res, err = queryServer.FuryaFinishedIncentives(ctx, &types.QueryFuryaIncentivesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Incentives, 1)
+	require.Equal(t, rewards, res.Incentives[0].DistributedRewards)
+
+	// Validator scoped incentives without any delegation are refunded once they end
+	incentive, err = app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_2_TOKEN_DENOM, valAddr, rewards, startTime, startTime.Add(time.Hour*21))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), incentive.Id)
+	require.Equal(t, startTime.Add(time.Hour*20), incentive.StartTime)
+	_, err = app.FuryaKeeper.Undelegate(ctx, user2, val, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 22)).WithBlockHeight(5)
+	app.FuryaKeeper.IncentiveHook(ctx)
+	require.Equal(t, sdk.NewInt(999_000), app.BankKeeper.GetBalance(ctx, funder, "uincentive").Amount)
+
+	// Incentives are exported
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.Incentives, 0)
+	require.Len(t, genesis.FinishedIncentives, 2)
+}
+
+func TestFuryaIncentiveLimits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.IncentiveCreationFee = sdk.NewCoins(sdk.NewCoin("uincentive", sdk.NewInt(100)))
+	params.MaxActiveIncentives = 1
+	params.MaxIncentiveRewardDenoms = 1
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin("uincentive", sdk.NewInt(1000_000)),
+	))
+	funder := addrs[0]
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("uincentive")
+
+	// Incentives are limited in the number of reward denoms
+	rewards := sdk.NewCoins(sdk.NewCoin("uincentive", sdk.NewInt(1000)))
+	_, err := app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_TOKEN_DENOM, nil, rewards.Add(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000))), startTime, startTime.Add(time.Hour))
+	require.Error(t, err)
+
+	// Creating an incentive pays the fee to the community pool
+	_, err = app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_TOKEN_DENOM, nil, rewards, startTime, startTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(998_900), app.BankKeeper.GetBalance(ctx, funder, "uincentive").Amount)
+	require.Equal(t, communityPool.Add(sdk.NewDec(100)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("uincentive"))
+
+	// Active incentives are limited
+	_, err = app.FuryaKeeper.CreateIncentive(ctx, funder, FURYA_TOKEN_DENOM, nil, rewards, startTime, startTime.Add(time.Hour))
+	require.Error(t, err)
+	require.Equal(t, sdk.NewInt(998_900), app.BankKeeper.GetBalance(ctx, funder, "uincentive").Amount)
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles in-place store migrations of the furya module
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{keeper: k}
+}
+
+// paramsAddedInV4 are the keys of the params that do not exist in the param store of consensus version 3
+var paramsAddedInV4 = [][]byte{
+	types.AutoCompoundInterval,
+	types.LastAutoCompoundTime,
+	types.MaxValidatorFuryaPowerShare,
+	types.MaxFuryaPowerShare,
+	types.RewardIndexSampleInterval,
+	types.RewardIndexSampleSize,
+	types.PriceFeeders,
+	types.MaxPriceAge,
+	types.IncentiveCreationFee,
+	types.MaxActiveIncentives,
+	types.MaxIncentiveRewardDenoms,
+	types.TakeRateDestinations,
+	types.SweepUnallocatedRewards,
+	types.RewardDustSweepInterval,
+	types.LastRewardDustSweepTime,
+	types.SlashDestinationKey,
+}
+
+// Migrate3to4 sets the params added since consensus version 3 to their defaults since reading a missing param panics,
+// and indexes the last reward claim height of the delegations stored before the index was added
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.setMissingParams(ctx, paramsAddedInV4)
+	m.keeper.IndexDelegationClaimHeights(ctx)
+	return nil
+}
+
+// setMissingParams sets the params of the given keys to their default value if they are not in the param store yet
+func (k Keeper) setMissingParams(ctx sdk.Context, keys [][]byte) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if k.paramstore.Has(ctx, pair.Key) {
+			continue
+		}
+		for _, key := range keys {
+			if bytes.Equal(key, pair.Key) {
+				k.paramstore.Set(ctx, pair.Key, pair.Value)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/furya-official/furya/x/furya"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.RewardDelayTime = time.Hour
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), ctx.BlockTime()),
+		},
+	})
+
+	expectedParams := app.FuryaKeeper.GetParams(ctx)
+
+	// Only the params of consensus version 3 are in the param store
+	v3Keys := [][]byte{types.RewardDelayTime, types.TakeRateClaimInterval, types.LastTakeRateClaimTime}
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, pair := range params.ParamSetPairs() {
+		isV3Key := false
+		for _, key := range v3Keys {
+			isV3Key = isV3Key || string(key) == string(pair.Key)
+		}
+		if !isV3Key {
+			paramStore.Delete(pair.Key)
+		}
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	require.Panics(t, func() { furya.EndBlocker(cacheCtx, app.FuryaKeeper) })
+
+	// Params added since version 3 are set to their defaults and the others are kept
+	err := keeper.NewMigrator(app.FuryaKeeper).Migrate3to4(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedParams, app.FuryaKeeper.GetParams(ctx))
+
+	require.NotPanics(t, func() { furya.EndBlocker(ctx, app.FuryaKeeper) })
+}
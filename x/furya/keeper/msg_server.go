@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"github.com/furya-official/furya/x/furya/types"
+	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type MsgServer struct {
@@ -170,6 +173,497 @@ func (m MsgServer) ClaimDelegationRewards(ctx context.Context, msg *types.MsgCla
 	return &types.MsgClaimDelegationRewardsResponse{}, err
 }
 
+func (m MsgServer) ClaimAllDelegationRewards(ctx context.Context, msg *types.MsgClaimAllDelegationRewards) (*types.MsgClaimAllDelegationRewardsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	coins, err := m.Keeper.ClaimAllDelegationRewards(sdkCtx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimDelegationRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+	return &types.MsgClaimAllDelegationRewardsResponse{}, nil
+}
+
+func (m MsgServer) CancelUndelegation(ctx context.Context, msg *types.MsgCancelUndelegation) (*types.MsgCancelUndelegationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetFuryaValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares, err := m.Keeper.CancelUndelegation(sdkCtx, delAddr, validator, msg.Amount, msg.CompletionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUndelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, msg.CompletionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	})
+	return &types.MsgCancelUndelegationResponse{}, nil
+}
+
+func (m MsgServer) SetFuryaWithdrawAddress(ctx context.Context, msg *types.MsgSetFuryaWithdrawAddress) (*types.MsgSetFuryaWithdrawAddressResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	withdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetWithdrawAddress(sdkCtx, delAddr, withdrawAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, msg.WithdrawAddress),
+		),
+	})
+	return &types.MsgSetFuryaWithdrawAddressResponse{}, nil
+}
+
+func (m MsgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetFuryaValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.UpdateAutoCompound(sdkCtx, delAddr, validator, msg.Denom, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	})
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (m MsgServer) MultiDelegate(ctx context.Context, msg *types.MsgMultiDelegate) (*types.MsgMultiDelegateResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares, err := m.Keeper.MultiDelegate(sdkCtx, delAddr, msg.Delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(sdk.Events, 0, len(msg.Delegations))
+	for i, entry := range msg.Delegations {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, entry.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares[i].String()),
+		))
+	}
+	sdkCtx.EventManager().EmitEvents(events)
+
+	return &types.MsgMultiDelegateResponse{NewShares: newShares}, nil
+}
+
+func (m MsgServer) MultiUndelegate(ctx context.Context, msg *types.MsgMultiUndelegate) (*types.MsgMultiUndelegateResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTimes, err := m.Keeper.MultiUndelegate(sdkCtx, delAddr, msg.Undelegations)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(sdk.Events, 0, len(msg.Undelegations))
+	for i, entry := range msg.Undelegations {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, entry.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTimes[i].Format(time.RFC3339)),
+		))
+	}
+	sdkCtx.EventManager().EmitEvents(events)
+
+	return &types.MsgMultiUndelegateResponse{CompletionTimes: completionTimes}, nil
+}
+
+func (m MsgServer) TransferDelegation(ctx context.Context, msg *types.MsgTransferDelegation) (*types.MsgTransferDelegationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.RecipientAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetFuryaValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := m.Keeper.TransferDelegation(sdkCtx, delAddr, recipientAddr, validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	})
+	return &types.MsgTransferDelegationResponse{}, nil
+}
+
+func (m MsgServer) TokenizeFuryaDelegation(ctx context.Context, msg *types.MsgTokenizeFuryaDelegation) (*types.MsgTokenizeFuryaDelegationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetFuryaValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := m.Keeper.TokenizeDelegation(sdkCtx, delAddr, validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyToken, token.String()),
+		),
+	})
+	return &types.MsgTokenizeFuryaDelegationResponse{Token: *token}, nil
+}
+
+func (m MsgServer) RedeemFuryaTokens(ctx context.Context, msg *types.MsgRedeemFuryaTokens) (*types.MsgRedeemFuryaTokensResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := m.Keeper.RedeemTokens(sdkCtx, delAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemTokens,
+			sdk.NewAttribute(types.AttributeKeyToken, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	})
+	return &types.MsgRedeemFuryaTokensResponse{}, nil
+}
+
+func (m MsgServer) ClaimFuryaTokenRewards(ctx context.Context, msg *types.MsgClaimFuryaTokenRewards) (*types.MsgClaimFuryaTokenRewardsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	holderAddr, err := sdk.AccAddressFromBech32(msg.HolderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := m.Keeper.ClaimTokenizedRewards(sdkCtx, holderAddr, msg.TokenDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimTokenRewards,
+			sdk.NewAttribute(types.AttributeKeyToken, msg.TokenDenom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+	return &types.MsgClaimFuryaTokenRewardsResponse{}, nil
+}
+
+func (m MsgServer) SetValidatorFuryaPreferences(ctx context.Context, msg *types.MsgSetValidatorFuryaPreferences) (*types.MsgSetValidatorFuryaPreferencesResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetValidatorPreferences(sdkCtx, valAddr, msg.AllowedDenoms, msg.BlockedDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetValidatorPreferences,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAllowedDenoms, strings.Join(msg.AllowedDenoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyBlockedDenoms, strings.Join(msg.BlockedDenoms, ",")),
+		),
+	})
+	return &types.MsgSetValidatorFuryaPreferencesResponse{}, nil
+}
+
+func (m MsgServer) SetValidatorFuryaCommission(ctx context.Context, msg *types.MsgSetValidatorFuryaCommission) (*types.MsgSetValidatorFuryaCommissionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetValidatorCommission(sdkCtx, valAddr, stakingtypes.NewCommissionRates(msg.Rate, msg.MaxRate, msg.MaxChangeRate))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetValidatorCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+		),
+	})
+	return &types.MsgSetValidatorFuryaCommissionResponse{}, nil
+}
+
+func (m MsgServer) WithdrawValidatorFuryaCommission(ctx context.Context, msg *types.MsgWithdrawValidatorFuryaCommission) (*types.MsgWithdrawValidatorFuryaCommissionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := m.Keeper.WithdrawValidatorCommission(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawValidatorCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+	return &types.MsgWithdrawValidatorFuryaCommissionResponse{Amount: coins}, nil
+}
+
+func (m MsgServer) SettleFuryaRewards(ctx context.Context, msg *types.MsgSettleFuryaRewards) (*types.MsgSettleFuryaRewardsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	settled, pruned := m.Keeper.SettleDelegationRewards(sdkCtx, msg.Limit)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSettleRewards,
+			sdk.NewAttribute(types.AttributeKeySettled, strconv.FormatUint(settled, 10)),
+			sdk.NewAttribute(types.AttributeKeyPrunedSnapshots, strconv.FormatUint(pruned, 10)),
+		),
+	})
+	return &types.MsgSettleFuryaRewardsResponse{Settled: settled, PrunedSnapshots: pruned}, nil
+}
+
+func (m MsgServer) PostFuryaPrices(ctx context.Context, msg *types.MsgPostFuryaPrices) (*types.MsgPostFuryaPricesResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	feeder, err := sdk.AccAddressFromBech32(msg.FeederAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.PostPrices(sdkCtx, feeder, msg.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePostPrices,
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.FeederAddress),
+			sdk.NewAttribute(types.AttributeKeyPrices, msg.Prices.String()),
+		),
+	})
+	return &types.MsgPostFuryaPricesResponse{}, nil
+}
+
+func (m MsgServer) CreateFuryaIncentive(ctx context.Context, msg *types.MsgCreateFuryaIncentive) (*types.MsgCreateFuryaIncentiveResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	var valAddr sdk.ValAddress
+	if msg.ValidatorAddress != "" {
+		valAddr, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	incentive, err := m.Keeper.CreateIncentive(sdkCtx, funder, msg.Denom, valAddr, msg.Rewards, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateIncentive,
+			sdk.NewAttribute(types.AttributeKeyIncentiveID, sdk.NewIntFromUint64(incentive.Id).String()),
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, incentive.StartTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, incentive.EndTime.Format(time.RFC3339)),
+		),
+	})
+	return &types.MsgCreateFuryaIncentiveResponse{Id: incentive.Id}, nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -25,3 +25,96 @@ func (k Keeper) LastRewardClaimTime(ctx sdk.Context) (res time.Time) {
 func (k Keeper) SetLastRewardClaimTime(ctx sdk.Context, lastTime time.Time) {
 	k.paramstore.Set(ctx, types.LastTakeRateClaimTime, &lastTime)
 }
+
+func (k Keeper) AutoCompoundInterval(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.AutoCompoundInterval, &res)
+	return
+}
+
+func (k Keeper) LastAutoCompoundTime(ctx sdk.Context) (res time.Time) {
+	k.paramstore.Get(ctx, types.LastAutoCompoundTime, &res)
+	return
+}
+
+func (k Keeper) SetLastAutoCompoundTime(ctx sdk.Context, lastTime time.Time) {
+	k.paramstore.Set(ctx, types.LastAutoCompoundTime, &lastTime)
+}
+
+func (k Keeper) MaxValidatorFuryaPowerShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.MaxValidatorFuryaPowerShare, &res)
+	return
+}
+
+func (k Keeper) MaxFuryaPowerShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.MaxFuryaPowerShare, &res)
+	return
+}
+
+func (k Keeper) RewardIndexSampleInterval(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.RewardIndexSampleInterval, &res)
+	return
+}
+
+func (k Keeper) RewardIndexSampleSize(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.RewardIndexSampleSize, &res)
+	return
+}
+
+func (k Keeper) PriceFeeders(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.PriceFeeders, &res)
+	return
+}
+
+func (k Keeper) MaxPriceAge(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.MaxPriceAge, &res)
+	return
+}
+
+func (k Keeper) IncentiveCreationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.IncentiveCreationFee, &res)
+	return
+}
+
+func (k Keeper) MaxActiveIncentives(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.MaxActiveIncentives, &res)
+	return
+}
+
+func (k Keeper) MaxIncentiveRewardDenoms(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.MaxIncentiveRewardDenoms, &res)
+	return
+}
+
+func (k Keeper) TakeRateDestinations(ctx sdk.Context) (res []types.TakeRateDestination) {
+	k.paramstore.Get(ctx, types.TakeRateDestinations, &res)
+	return
+}
+
+func (k Keeper) SweepUnallocatedRewards(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.SweepUnallocatedRewards, &res)
+	return
+}
+
+func (k Keeper) RewardDustSweepInterval(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.RewardDustSweepInterval, &res)
+	return
+}
+
+func (k Keeper) LastRewardDustSweepTime(ctx sdk.Context) (res time.Time) {
+	k.paramstore.Get(ctx, types.LastRewardDustSweepTime, &res)
+	return
+}
+
+func (k Keeper) SetLastRewardDustSweepTime(ctx sdk.Context, lastTime time.Time) {
+	k.paramstore.Set(ctx, types.LastRewardDustSweepTime, &lastTime)
+}
+
+func (k Keeper) SlashDestination(ctx sdk.Context) (res types.SlashDestination) {
+	k.paramstore.Get(ctx, types.SlashDestinationKey, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
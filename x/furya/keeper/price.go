@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
+)
+
+// PostPrices stores the prices posted by a price feeder. The latest posted price of a denom is used regardless of
+// which feeder posted it.
+func (k Keeper) PostPrices(ctx sdk.Context, feeder sdk.AccAddress, prices sdk.DecCoins) error {
+	if !slices.Contains(k.PriceFeeders(ctx), feeder.String()) {
+		return types.ErrUnauthorizedPriceFeeder
+	}
+	for _, price := range prices {
+		k.SetPrice(ctx, types.FuryaPrice{
+			Denom:         price.Denom,
+			Price:         price.Amount,
+			FeederAddress: feeder.String(),
+			UpdateTime:    ctx.BlockTime(),
+		})
+	}
+	return nil
+}
+
+func (k Keeper) SetPrice(ctx sdk.Context, price types.FuryaPrice) {
+	ctx.KVStore(k.storeKey).Set(types.GetFuryaPriceKey(price.Denom), k.cdc.MustMarshal(&price))
+}
+
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (price types.FuryaPrice, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetFuryaPriceKey(denom))
+	if b == nil {
+		return price, false
+	}
+	k.cdc.MustUnmarshal(b, &price)
+	return price, true
+}
+
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(price types.FuryaPrice) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FuryaPriceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var price types.FuryaPrice
+		k.cdc.MustUnmarshal(iter.Value(), &price)
+		if cb(price) {
+			return
+		}
+	}
+}
+
+// getFreshPrice returns the price of a denom if it is not older than MaxPriceAge
+func (k Keeper) getFreshPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	price, found := k.GetPrice(ctx, denom)
+	if !found || !price.Price.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	maxAge := k.MaxPriceAge(ctx)
+	if maxAge > 0 && price.UpdateTime.Add(maxAge).Before(ctx.BlockTime()) {
+		return sdk.Dec{}, false
+	}
+	return price.Price, true
+}
+
+// PeggedRewardWeight returns the reward weight of a price pegged asset. It is not found if the asset is not pegged or
+// if the prices of the asset or the native denom are missing or stale.
+func (k Keeper) PeggedRewardWeight(ctx sdk.Context, asset types.FuryaAsset) (sdk.Dec, bool) {
+	if asset.PricePeg == nil {
+		return sdk.Dec{}, false
+	}
+	assetPrice, found := k.getFreshPrice(ctx, asset.Denom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	nativePrice, found := k.getFreshPrice(ctx, k.stakingKeeper.BondDenom(ctx))
+	if !found {
+		return sdk.Dec{}, false
+	}
+	return asset.PricePeg.RewardWeight(assetPrice, nativePrice), true
+}
+
+// pegRewardWeight updates the reward weight of a price pegged asset at most once per reward change interval.
+// The weight change is snapshotted like any other reward weight change.
+func (k Keeper) pegRewardWeight(ctx sdk.Context, asset *types.FuryaAsset) {
+	if asset.RewardChangeInterval > 0 && asset.LastRewardChangeTime.Add(asset.RewardChangeInterval).After(ctx.BlockTime()) {
+		return
+	}
+	weight, found := k.PeggedRewardWeight(ctx, *asset)
+	if !found {
+		return
+	}
+	weight = asset.ClampRewardWeight(weight)
+	if weight.Equal(asset.RewardWeight) {
+		return
+	}
+	asset.RewardWeight = weight
+	asset.LastRewardChangeTime = ctx.BlockTime()
+	k.QueueAssetRebalanceEvent(ctx)
+	if err := k.UpdateFuryaAsset(ctx, *asset); err != nil {
+		k.Logger(ctx).Error("failed to peg furya reward weight", "denom", asset.Denom, "error", err)
+	}
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricePeggedRewardWeight(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	feeder := addrs[0]
+	params := types.DefaultParams()
+	params.PriceFeeders = []string{feeder.String()}
+	params.MaxPriceAge = time.Hour
+	asset := types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	asset.PricePeg = &types.RewardWeightPricePeg{
+		TargetValueRatio: sdk.NewDec(1),
+		MinWeight:        sdk.MustNewDecFromStr("0.5"),
+		MaxWeight:        sdk.NewDec(3),
+	}
+	maxRewardWeight := sdk.MustNewDecFromStr("2.5")
+	asset.MaxRewardWeight = &maxRewardWeight
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{asset},
+	})
+	msgServer := keeper.NewMsgServerImpl(app.FuryaKeeper)
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, addrs[1], val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	rewardWeight := func() sdk.Dec {
+		app.FuryaKeeper.RewardWeightChangeHook(ctx, app.FuryaKeeper.GetAllAssets(ctx))
+		asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+		return asset.RewardWeight
+	}
+
+	// Only price feeders can post prices
+	_, err = msgServer.PostFuryaPrices(ctx, &types.MsgPostFuryaPrices{
+		FeederAddress: addrs[1].String(),
+		Prices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec(FURYA_TOKEN_DENOM, sdk.NewDec(4))),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedPriceFeeder)
+
+	// The weight is not changed without the native price
+	_, err = msgServer.PostFuryaPrices(ctx, &types.MsgPostFuryaPrices{
+		FeederAddress: feeder.String(),
+		Prices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec(FURYA_TOKEN_DENOM, sdk.NewDec(4))),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1), rewardWeight())
+
+	// The weight follows the value of the asset relative to the native token
+	_, err = msgServer.PostFuryaPrices(ctx, &types.MsgPostFuryaPrices{
+		FeederAddress: feeder.String(),
+		Prices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, sdk.NewDec(2))),
+	})
+	require.NoError(t, err)
+	app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx)
+	require.Equal(t, sdk.NewDec(2), rewardWeight())
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+	var snapshots int
+	app.FuryaKeeper.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, lastClaimHeight uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+		require.Equal(t, sdk.NewDec(1), snapshot.PrevRewardWeight)
+		snapshots++
+		return false
+	})
+	require.Equal(t, 1, snapshots)
+
+	// The weight is clamped to the bounds of the peg and to the reward weight bounds of the asset
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = app.FuryaKeeper.PostPrices(ctx, feeder, sdk.NewDecCoins(sdk.NewDecCoinFromDec(FURYA_TOKEN_DENOM, sdk.NewDec(100))))
+	require.NoError(t, err)
+	require.Equal(t, maxRewardWeight, rewardWeight())
+	err = app.FuryaKeeper.PostPrices(ctx, feeder, sdk.NewDecCoins(sdk.NewDecCoinFromDec(FURYA_TOKEN_DENOM, sdk.MustNewDecFromStr("0.1"))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), rewardWeight())
+
+	// Stale prices are not used
+	err = app.FuryaKeeper.PostPrices(ctx, feeder, sdk.NewDecCoins(sdk.NewDecCoinFromDec(FURYA_TOKEN_DENOM, sdk.NewDec(2))))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 2))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), rewardWeight())
+
+	res, err := queryServer.FuryaPrices(ctx, &types.QueryFuryaPricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Prices, 2)
+
+	// Prices are exported
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.Prices, 2)
+}
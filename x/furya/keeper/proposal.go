@@ -28,8 +28,16 @@ func (k Keeper) CreateFurya(ctx context.Context, req *types.MsgCreateFuryaPropos
 		RewardChangeRate:     req.RewardChangeRate,
 		RewardChangeInterval: req.RewardChangeInterval,
 		LastRewardChangeTime: rewardStartTime,
+		MinDelegationAmount:  req.MinDelegationAmount,
+		MaxTotalTokens:       req.MaxTotalTokens,
+		PricePeg:             req.PricePeg,
+		MinRewardWeight:      req.MinRewardWeight,
+		MaxRewardWeight:      req.MaxRewardWeight,
+		RewardWeightSchedule: req.RewardWeightSchedule,
+		SlashMultiplier:      req.SlashMultiplier,
 	}
 	k.SetAsset(sdkCtx, asset)
+	k.QueueRewardWeightSchedule(sdkCtx, asset)
 	return nil
 }
 
@@ -41,15 +49,28 @@ func (k Keeper) UpdateFurya(ctx context.Context, req *types.MsgUpdateFuryaPropos
 		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", req.Denom)
 	}
 
+	// Reward changes stop once a bound is reached so they are scheduled again from now on
+	if asset.RewardWeightBoundReached() {
+		asset.LastRewardChangeTime = sdkCtx.BlockTime()
+	}
+
 	asset.RewardWeight = req.RewardWeight
 	asset.TakeRate = req.TakeRate
 	asset.RewardChangeRate = req.RewardChangeRate
 	asset.RewardChangeInterval = req.RewardChangeInterval
+	asset.MinDelegationAmount = req.MinDelegationAmount
+	asset.MaxTotalTokens = req.MaxTotalTokens
+	asset.PricePeg = req.PricePeg
+	asset.MinRewardWeight = req.MinRewardWeight
+	asset.MaxRewardWeight = req.MaxRewardWeight
+	asset.RewardWeightSchedule = req.RewardWeightSchedule
+	asset.SlashMultiplier = req.SlashMultiplier
 
 	err := k.UpdateFuryaAsset(sdkCtx, asset)
 	if err != nil {
 		return err
 	}
+	k.QueueRewardWeightSchedule(sdkCtx, asset)
 
 	return nil
 }
@@ -67,6 +88,7 @@ func (k Keeper) DeleteFurya(ctx context.Context, req *types.MsgDeleteFuryaPropos
 	}
 
 	k.DeleteAsset(sdkCtx, req.Denom)
+	k.DequeueRewardWeightSchedule(sdkCtx, req.Denom)
 
 	return nil
 }
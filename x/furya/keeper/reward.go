@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/furya-official/furya/x/furya/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RewardsKeeper interface {
@@ -51,16 +53,85 @@ func (k Keeper) ClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
 		return nil, err
 	}
 
-	coins, newIndices, err := k.CalculateDelegationRewards(ctx, delegation, val, asset)
+	return k.claimDelegationRewards(ctx, delAddr, delegation, val, asset)
+}
+
+// ClaimAllDelegationRewards claims the rewards of every delegation owned by a delegator
+// Validator rewards are only claimed once per validator regardless of how many denoms are delegated to it
+func (k Keeper) ClaimAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	var delegations []types.Delegation
+	k.IterateDelegationsByDelegator(ctx, delAddr, func(d types.Delegation) bool {
+		delegations = append(delegations, d)
+		return false
+	})
+	if len(delegations) == 0 {
+		return nil, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	// Only used as a lookup so that validator rewards are claimed once. Iteration order follows the delegations.
+	claimedValidators := map[string]bool{}
+	totalCoins := sdk.NewCoins()
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		// Validator, asset and delegation are always queried since compounding a previous reward might have modified them
+		val, err := k.GetFuryaValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		if !claimedValidators[delegation.ValidatorAddress] {
+			_, err = k.ClaimValidatorRewards(ctx, val)
+			if err != nil {
+				return nil, err
+			}
+			claimedValidators[delegation.ValidatorAddress] = true
+		}
+
+		asset, found := k.GetAssetByDenom(ctx, delegation.Denom)
+		if !found {
+			return nil, types.ErrUnknownAsset
+		}
+		delegation, found = k.GetDelegation(ctx, delAddr, val, delegation.Denom)
+		if !found {
+			return nil, stakingtypes.ErrNoDelegatorForAddress
+		}
+
+		coins, err := k.claimDelegationRewards(ctx, delAddr, delegation, val, asset)
+		if err != nil {
+			return nil, err
+		}
+		totalCoins = totalCoins.Add(coins...)
+	}
+	return totalCoins, nil
+}
+
+// claimDelegationRewards calculates the rewards of a delegation, updates its reward history and transfers the rewards
+// together with the pending rewards settled by the crank to the delegator. If auto-compounding is enabled, rewards in
+// whitelisted denoms are delegated back to the validator instead. Validator rewards must be claimed before calling this method.
+func (k Keeper) claimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, delegation types.Delegation, val types.FuryaValidator, asset types.FuryaAsset) (sdk.Coins, error) {
+	coins, err := k.accrueDelegationRewards(ctx, &delegation, val, asset)
 	if err != nil {
 		return nil, err
 	}
+	if !delegation.PendingRewards.Empty() {
+		coins = coins.Add(delegation.PendingRewards...)
+	}
 
-	delegation.RewardHistory = newIndices
-	delegation.LastRewardClaimHeight = uint64(ctx.BlockHeight())
-	k.SetDelegation(ctx, delAddr, val.GetOperator(), denom, delegation)
+	delegation.PendingRewards = nil
+	k.SetDelegation(ctx, delAddr, val.GetOperator(), asset.Denom, delegation)
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, delAddr, coins)
+	payout := coins
+	if k.IsAutoCompoundEnabled(ctx, delAddr, val.GetOperator(), asset.Denom) {
+		compounded, err := k.compoundRewards(ctx, delAddr, val.GetOperator(), coins)
+		if err != nil {
+			return nil, err
+		}
+		payout = coins.Sub(compounded...)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, k.GetWithdrawAddress(ctx, delAddr), payout)
 	if err != nil {
 		return nil, err
 	}
@@ -68,10 +139,65 @@ func (k Keeper) ClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
 	return coins, nil
 }
 
-// CalculateDelegationRewards calculates the rewards that can be claimed for a delegation
+// accrueDelegationRewards calculates the rewards and asset incentives of a delegation and moves the delegation's
+// reward indices to the current ones. The caller is responsible for storing the delegation and paying out the rewards.
+func (k Keeper) accrueDelegationRewards(ctx sdk.Context, delegation *types.Delegation, val types.FuryaValidator, asset types.FuryaAsset) (sdk.Coins, error) {
+	coins, dust, newIndices, err := k.CalculateDelegationRewards(ctx, *delegation, val, asset)
+	if err != nil {
+		return nil, err
+	}
+	incentives, incentiveDust, newIncentiveIndices := k.CalculateDelegationIncentives(*delegation, val, asset)
+	k.addRewardDust(ctx, dust.Add(incentiveDust...))
+
+	delegation.RewardHistory = newIndices
+	delegation.IncentiveRewardHistory = newIncentiveIndices
+	delegation.LastRewardClaimHeight = uint64(ctx.BlockHeight())
+	return coins.Add(incentives...), nil
+}
+
+// SetWithdrawAddress sets the address that receives all furya rewards of a delegator
+// Setting the withdraw address to the delegator address removes the entry
+func (k Keeper) SetWithdrawAddress(ctx sdk.Context, delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return status.Errorf(codes.InvalidArgument, "%s is not allowed to receive furya rewards", withdrawAddr)
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetWithdrawAddressKey(delAddr)
+	if delAddr.Equals(withdrawAddr) {
+		store.Delete(key)
+		return nil
+	}
+	store.Set(key, withdrawAddr)
+	return nil
+}
+
+// GetWithdrawAddress returns the address that receives the furya rewards of a delegator
+// Defaults to the delegator address if no withdraw address was set
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
+	b := ctx.KVStore(k.storeKey).Get(types.GetWithdrawAddressKey(delAddr))
+	if b == nil {
+		return delAddr
+	}
+	return b
+}
+
+func (k Keeper) IterateWithdrawAddresses(ctx sdk.Context, cb func(delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WithdrawAddressKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr := types.ParseWithdrawAddressKey(iter.Key())
+		if cb(delAddr, iter.Value()) {
+			return
+		}
+	}
+}
+
+// CalculateDelegationRewards calculates the rewards that can be claimed for a delegation and the dust left by truncating them
 // It takes past reward_rate changes into account by using the RewardRateChangeSnapshot entry
-func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delegation types.Delegation, val types.FuryaValidator, asset types.FuryaAsset) (sdk.Coins, types.RewardHistories, error) {
+func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delegation types.Delegation, val types.FuryaValidator, asset types.FuryaAsset) (sdk.Coins, sdk.DecCoins, types.RewardHistories, error) {
 	var totalRewards sdk.Coins
+	var totalDust sdk.DecCoins
 	currentRewardHistory := types.NewRewardHistories(val.GlobalRewardHistory)
 	delegationRewardHistories := types.NewRewardHistories(delegation.RewardHistory)
 	// If there are reward rate changes between last and current claim, sequentially claim with the help of the snapshots
@@ -81,19 +207,24 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delegation types.Del
 		b := snapshotIter.Value()
 		k.cdc.MustUnmarshal(b, &snapshot)
 		var rewards sdk.Coins
-		rewards, delegationRewardHistories = accumulateRewards(types.NewRewardHistories(snapshot.RewardHistories), delegationRewardHistories, asset, snapshot.PrevRewardWeight, delegation, val)
+		var dust sdk.DecCoins
+		rewards, delegationRewardHistories, dust = accumulateRewards(types.NewRewardHistories(snapshot.RewardHistories), delegationRewardHistories, asset, snapshot.PrevRewardWeight, delegation, val)
 		totalRewards = totalRewards.Add(rewards...)
+		totalDust = totalDust.Add(dust...)
 	}
-	rewards, _ := accumulateRewards(currentRewardHistory, delegationRewardHistories, asset, asset.RewardWeight, delegation, val)
+	rewards, _, dust := accumulateRewards(currentRewardHistory, delegationRewardHistories, asset, asset.RewardWeight, delegation, val)
 	totalRewards = totalRewards.Add(rewards...)
-	return totalRewards, currentRewardHistory, nil
+	totalDust = totalDust.Add(dust...)
+	return totalRewards, totalDust, currentRewardHistory, nil
 }
 
 // accumulateRewards compares the latest reward history with the delegation's reward history
-// It takes the difference and calculates how much can be claimed
-func accumulateRewards(latestRewardHistories types.RewardHistories, rewardHistories types.RewardHistories, asset types.FuryaAsset, rewardWeight sdk.Dec, delegation types.Delegation, validator types.FuryaValidator) (sdk.Coins, types.RewardHistories) {
+// It takes the difference and calculates how much can be claimed. The fractions lost by truncating the claimable
+// rewards are returned as dust.
+func accumulateRewards(latestRewardHistories types.RewardHistories, rewardHistories types.RewardHistories, asset types.FuryaAsset, rewardWeight sdk.Dec, delegation types.Delegation, validator types.FuryaValidator) (sdk.Coins, types.RewardHistories, sdk.DecCoins) {
 	// Go through each reward denom and accumulate rewards
 	var rewards sdk.Coins
+	var dust sdk.DecCoins
 	for _, history := range latestRewardHistories {
 		rewardHistory, found := rewardHistories.GetIndexByDenom(history.Denom)
 		if !found {
@@ -108,25 +239,32 @@ func accumulateRewards(latestRewardHistories types.RewardHistories, rewardHistor
 		claimWeight := delegationTokens.Mul(rewardWeight)
 		totalClaimable := (history.Index.Sub(rewardHistory.Index)).Mul(claimWeight)
 		rewardHistory.Index = history.Index
-		rewards = rewards.Add(sdk.NewCoin(history.Denom, totalClaimable.TruncateInt()))
+		claimed := totalClaimable.TruncateInt()
+		rewards = rewards.Add(sdk.NewCoin(history.Denom, claimed))
+		dust = dust.Add(sdk.NewDecCoinFromDec(history.Denom, totalClaimable.Sub(sdk.NewDecFromInt(claimed))))
 		if !found {
 			rewardHistories = append(rewardHistories, *rewardHistory)
 		}
 	}
-	return rewards, rewardHistories
+	return rewards, rewardHistories, dust
 }
 
 // AddAssetsToRewardPool increments a reward history array. A reward history stores the average reward per token/reward_weight.
 // To calculate the number of rewards claimable, take reward_history * furya_token_amount * reward_weight
+// The furya commission of the validator is kept in the rewards pool until it is withdrawn by the operator
 func (k Keeper) AddAssetsToRewardPool(ctx sdk.Context, from sdk.AccAddress, val types.FuryaValidator, coins sdk.Coins) error {
 	rewardHistories := types.NewRewardHistories(val.GlobalRewardHistory)
 	totalAssetWeight := k.totalAssetWeight(ctx, val)
 	// We need some delegations before we can split rewards. Else rewards belong to no one
 	if totalAssetWeight.IsZero() {
-		return types.ErrZeroDelegations
+		return k.addUnallocatedRewards(ctx, from, val, coins)
 	}
 
-	for _, c := range coins {
+	// Rewards that could not be allocated before are already held by the rewards pool and are indexed with the new ones.
+	// The validator commission is taken before indexing so that delegators only accrue the remaining rewards
+	rewards := k.takeValidatorCommission(ctx, val.GetOperator(), coins.Add(val.UnallocatedRewards...))
+	val.UnallocatedRewards = nil
+	for _, c := range rewards {
 		rewardHistory, found := rewardHistories.GetIndexByDenom(c.Denom)
 		if !found {
 			rewardHistories = append(rewardHistories, types.RewardHistory{
@@ -140,6 +278,7 @@ func (k Keeper) AddAssetsToRewardPool(ctx sdk.Context, from sdk.AccAddress, val
 
 	val.GlobalRewardHistory = rewardHistories
 	k.SetValidator(ctx, val)
+	k.sampleRewardIndices(ctx, val.GetOperator(), rewardHistories)
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.RewardsPoolName, coins)
 	if err != nil {
 		return err
@@ -148,6 +287,41 @@ func (k Keeper) AddAssetsToRewardPool(ctx sdk.Context, from sdk.AccAddress, val
 	return nil
 }
 
+// addUnallocatedRewards keeps rewards of a validator without furya delegations in the rewards pool until the validator
+// has delegations again. If unallocated rewards are swept, they are sent to the community pool together with the
+// rewards that were kept so far.
+func (k Keeper) addUnallocatedRewards(ctx sdk.Context, from sdk.AccAddress, val types.FuryaValidator, coins sdk.Coins) error {
+	if !k.SweepUnallocatedRewards(ctx) {
+		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.RewardsPoolName, coins)
+		if err != nil {
+			return err
+		}
+		val.UnallocatedRewards = val.UnallocatedRewards.Add(coins...)
+		k.SetValidator(ctx, val)
+		return nil
+	}
+
+	if err := k.distributionKeeper.FundCommunityPool(ctx, coins, from); err != nil {
+		return err
+	}
+	swept := coins
+	if !val.UnallocatedRewards.IsZero() {
+		rewardsPoolAddr := k.accountKeeper.GetModuleAddress(types.RewardsPoolName)
+		if err := k.distributionKeeper.FundCommunityPool(ctx, val.UnallocatedRewards, rewardsPoolAddr); err != nil {
+			return err
+		}
+		swept = swept.Add(val.UnallocatedRewards...)
+		val.UnallocatedRewards = nil
+		k.SetValidator(ctx, val)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSweepUnallocatedRewards,
+		sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swept.String()),
+	))
+	return nil
+}
+
 func (k Keeper) totalAssetWeight(ctx sdk.Context, val types.FuryaValidator) sdk.Dec {
 	total := sdk.ZeroDec()
 	for _, token := range val.TotalDelegatorShares {
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetRewardDust(ctx sdk.Context) (dust types.RewardDust) {
+	b := ctx.KVStore(k.storeKey).Get(types.RewardDustKey)
+	if b == nil {
+		return dust
+	}
+	k.cdc.MustUnmarshal(b, &dust)
+	return dust
+}
+
+func (k Keeper) SetRewardDust(ctx sdk.Context, dust types.RewardDust) {
+	ctx.KVStore(k.storeKey).Set(types.RewardDustKey, k.cdc.MustMarshal(&dust))
+}
+
+func (k Keeper) addRewardDust(ctx sdk.Context, dust sdk.DecCoins) {
+	if dust.IsZero() {
+		return
+	}
+	rewardDust := k.GetRewardDust(ctx)
+	rewardDust.Dust = rewardDust.Dust.Add(dust...)
+	k.SetRewardDust(ctx, rewardDust)
+}
+
+// OutstandingRewards returns the rewards that the rewards pool owes to delegators, token holders,
+// validators and incentive funders. Claimable rewards are truncated the same way they are when claimed.
+// Rewards that cannot be calculated return an error since skipping them would count them as excess.
+func (k Keeper) OutstandingRewards(ctx sdk.Context) (sdk.Coins, error) {
+	outstanding := sdk.NewCoins()
+
+	// Delegations are collected first since calculating rewards iterates over the snapshots
+	var delegations []types.Delegation
+	k.IterateDelegations(ctx, func(delegation types.Delegation) (stop bool) {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	validators := make(map[string]types.FuryaValidator)
+	for _, delegation := range delegations {
+		outstanding = outstanding.Add(delegation.PendingRewards...)
+		val, found := validators[delegation.ValidatorAddress]
+		if !found {
+			valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+			val, err = k.GetFuryaValidator(ctx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+			validators[delegation.ValidatorAddress] = val
+		}
+		asset, found := k.GetAssetByDenom(ctx, delegation.Denom)
+		if !found {
+			return nil, types.ErrUnknownAsset.Wrapf("delegation of %s to %s", delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+		rewards, _, _, err := k.CalculateDelegationRewards(ctx, delegation, val, asset)
+		if err != nil {
+			return nil, err
+		}
+		incentives, _, _ := k.CalculateDelegationIncentives(delegation, val, asset)
+		outstanding = outstanding.Add(rewards...).Add(incentives...)
+	}
+
+	var holderHistories []types.TokenHolderRewardHistory
+	k.IterateTokenHolderRewardHistories(ctx, func(history types.TokenHolderRewardHistory) (stop bool) {
+		holderHistories = append(holderHistories, history)
+		return false
+	})
+	for _, history := range holderHistories {
+		tokenized, found := k.GetTokenizedDelegation(ctx, history.TokenDenom)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "Denom %s is not a tokenized furya delegation", history.TokenDenom)
+		}
+		holder, err := sdk.AccAddressFromBech32(history.HolderAddress)
+		if err != nil {
+			return nil, err
+		}
+		balance := k.bankKeeper.GetBalance(ctx, holder, history.TokenDenom).Amount
+		outstanding = outstanding.Add(calculateTokenHolderRewards(tokenized, history, balance)...)
+	}
+
+	k.IterateValidatorCommissions(ctx, func(commission types.ValidatorFuryaCommission) (stop bool) {
+		outstanding = outstanding.Add(commission.Accrued...)
+		return false
+	})
+	k.IterateFuryaValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.FuryaValidatorInfo) (stop bool) {
+		outstanding = outstanding.Add(info.UnallocatedRewards...)
+		return false
+	})
+	k.IterateIncentives(ctx, func(incentive types.FuryaIncentive) (stop bool) {
+		outstanding = outstanding.Add(incentive.TotalRewards.Sub(incentive.DistributedRewards...)...)
+		return false
+	})
+	return outstanding, nil
+}
+
+// RewardsPoolBalance returns the balance of the rewards pool
+func (k Keeper) RewardsPoolBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.RewardsPoolName))
+}
+
+// RewardsPoolExcess returns the balance of the rewards pool that is not owed to anyone
+func (k Keeper) RewardsPoolExcess(ctx sdk.Context) (sdk.Coins, error) {
+	balance := k.RewardsPoolBalance(ctx)
+	outstanding, err := k.OutstandingRewards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	excess := sdk.NewCoins()
+	for _, c := range balance {
+		if amount := c.Amount.Sub(outstanding.AmountOf(c.Denom)); amount.IsPositive() {
+			excess = excess.Add(sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	return excess, nil
+}
+
+// SweepRewardDust sends the whole coins of the truncation dust that has not been swept yet to the community pool.
+// The amount swept is capped by the balance of the rewards pool that is not owed to anyone so that a mistake in
+// the tracked dust cannot sweep rewards that are still claimable. The remainders of reward indices are not tracked
+// and stay in the rewards pool, they are included in RewardsPoolExcess.
+func (k Keeper) SweepRewardDust(ctx sdk.Context) (sdk.Coins, error) {
+	rewardDust := k.GetRewardDust(ctx)
+	dust, _ := rewardDust.Dust.TruncateDecimal()
+	excess, err := k.RewardsPoolExcess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	swept := sdk.NewCoins()
+	for _, c := range dust {
+		amount := sdk.MinInt(c.Amount.Sub(rewardDust.Swept.AmountOf(c.Denom)), excess.AmountOf(c.Denom))
+		if amount.IsPositive() {
+			swept = swept.Add(sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	if swept.IsZero() {
+		return swept, nil
+	}
+
+	rewardsPoolAddr := k.accountKeeper.GetModuleAddress(types.RewardsPoolName)
+	if err := k.distributionKeeper.FundCommunityPool(ctx, swept, rewardsPoolAddr); err != nil {
+		return nil, err
+	}
+	rewardDust.Swept = rewardDust.Swept.Add(swept...)
+	k.SetRewardDust(ctx, rewardDust)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSweepRewardDust,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, swept.String()),
+	))
+	return swept, nil
+}
+
+// RewardDustSweepHook sweeps the reward truncation dust once every RewardDustSweepInterval.
+// An interval of zero disables the sweep.
+func (k Keeper) RewardDustSweepHook(ctx sdk.Context) {
+	interval := k.RewardDustSweepInterval(ctx)
+	if interval == 0 {
+		return
+	}
+	next := k.LastRewardDustSweepTime(ctx).Add(interval)
+	if !ctx.BlockTime().After(next) {
+		return
+	}
+
+	// Sweeping in a cache context so that a failure does not halt the chain
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.SweepRewardDust(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to sweep furya reward dust", "error", err)
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	k.SetLastRewardDustSweepTime(ctx, ctx.BlockTime())
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardDust(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.RewardDustSweepInterval = time.Hour
+	params.LastRewardDustSweepTime = startTime
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+	rewardsPoolAddr := app.AccountKeeper.GetModuleAddress(types.RewardsPoolName)
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	for _, addr := range addrs {
+		_, err = app.FuryaKeeper.Delegate(ctx, addr, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+		require.NoError(t, err)
+	}
+
+	// Rewards that cannot be split evenly leave dust once claimed
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_002))))
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_001))))
+	require.NoError(t, err)
+	outstanding, err := app.FuryaKeeper.OutstandingRewards(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(999_999))), outstanding)
+	for _, addr := range addrs {
+		coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, addr, val, FURYA_TOKEN_DENOM)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(333_333), coins.AmountOf("stake"))
+	}
+	_, broken := furya.RewardsPoolInvariant(app.FuryaKeeper)(ctx)
+	require.False(t, broken)
+
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	res, err := queryServer.FuryaRewardDust(ctx, &types.QueryFuryaRewardDustRequest{})
+	require.NoError(t, err)
+	require.True(t, res.RewardDust.Dust.AmountOf("stake").IsPositive())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2))), res.Excess)
+
+	// The dust is not swept before the sweep interval passed
+	app.FuryaKeeper.RewardDustSweepHook(ctx)
+	require.Equal(t, sdk.NewInt(2), app.BankKeeper.GetBalance(ctx, rewardsPoolAddr, "stake").Amount)
+
+	// Dust accumulates over claims until it adds up to whole coins
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_001))))
+	require.NoError(t, err)
+	for _, addr := range addrs {
+		_, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, addr, val, FURYA_TOKEN_DENOM)
+		require.NoError(t, err)
+	}
+	rewardDust := app.FuryaKeeper.GetRewardDust(ctx)
+	dust, _ := rewardDust.Dust.TruncateDecimal()
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1))), dust)
+
+	// The whole coins of the tracked dust are swept to the community pool
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 2)).WithBlockHeight(2)
+	app.FuryaKeeper.RewardDustSweepHook(ctx)
+	require.Equal(t, startTime.Add(time.Hour*2), app.FuryaKeeper.LastRewardDustSweepTime(ctx))
+	rewardDust = app.FuryaKeeper.GetRewardDust(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1))), rewardDust.Swept)
+	require.Equal(t, sdk.NewInt(3), app.BankKeeper.GetBalance(ctx, rewardsPoolAddr, "stake").Amount)
+	require.Equal(t, communityPool.Add(sdk.NewDec(1)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+	_, broken = furya.RewardsPoolInvariant(app.FuryaKeeper)(ctx)
+	require.False(t, broken)
+
+	// Dust is only swept once
+	coins, err := app.FuryaKeeper.SweepRewardDust(ctx)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+
+	// Reward dust is exported
+	require.Equal(t, rewardDust.Swept, app.FuryaKeeper.ExportGenesis(ctx).RewardDust.Swept)
+
+	// Tracked dust is only swept up to the balance of the rewards pool that is not owed to anyone
+	excess, err := app.FuryaKeeper.RewardsPoolExcess(ctx)
+	require.NoError(t, err)
+	rewardDust.Dust = rewardDust.Dust.Add(sdk.NewDecCoin("stake", sdk.NewInt(100)))
+	app.FuryaKeeper.SetRewardDust(ctx, rewardDust)
+	coins, err = app.FuryaKeeper.SweepRewardDust(ctx)
+	require.NoError(t, err)
+	require.Equal(t, excess.AmountOf("stake"), coins.AmountOf("stake"))
+	_, broken = furya.RewardsPoolInvariant(app.FuryaKeeper)(ctx)
+	require.False(t, broken)
+
+	// Rewards that cannot be calculated are reported instead of being counted as excess
+	app.FuryaKeeper.DeleteAsset(ctx, FURYA_TOKEN_DENOM)
+	_, err = app.FuryaKeeper.OutstandingRewards(ctx)
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+	_, err = queryServer.FuryaRewardDust(ctx, &types.QueryFuryaRewardDustRequest{})
+	require.Error(t, err)
+	_, broken = furya.RewardsPoolInvariant(app.FuryaKeeper)(ctx)
+	require.True(t, broken)
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/keeper"
 	"github.com/furya-official/furya/x/furya/types"
 	"testing"
 	"time"
@@ -51,16 +52,18 @@ func TestRewardPoolAndGlobalIndex(t *testing.T) {
 	user2 := addrs[1]
 
 	// Mint tokens
-	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(4000_000))))
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(6000_000))))
 	require.NoError(t, err)
 	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake2", sdk.NewInt(4000_000))))
 	require.NoError(t, err)
 	coin := app.BankKeeper.GetBalance(ctx, mintPoolAddr, "stake")
-	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(4000_000)), coin)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(6000_000)), coin)
 
-	// Transfer to reward pool without delegations will fail
+	// Transfer to reward pool without delegations is kept as unallocated rewards
 	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
-	require.Error(t, err)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), val1.UnallocatedRewards)
+	require.Len(t, val1.GlobalRewardHistory, 0)
 
 	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
 	require.NoError(t, err)
@@ -74,15 +77,16 @@ func TestRewardPoolAndGlobalIndex(t *testing.T) {
 
 	// Expect rewards pool to have something
 	balance := app.BankKeeper.GetBalance(ctx, rewardsPoolAddr, "stake")
-	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(2000_000)), balance)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(4000_000)), balance)
 
-	// Expect validator global index to be updated
+	// Expect validator global index to be updated with the unallocated rewards
 	require.NoError(t, err)
+	require.True(t, val1.UnallocatedRewards.IsZero())
 	globalIndices := types.NewRewardHistories(val1.GlobalRewardHistory)
 	require.Equal(t, types.RewardHistories{
 		types.RewardHistory{
 			Denom: "stake",
-			Index: sdk.NewDec(1),
+			Index: sdk.NewDec(2),
 		},
 	}, globalIndices)
 
@@ -101,7 +105,7 @@ func TestRewardPoolAndGlobalIndex(t *testing.T) {
 	require.Equal(t, types.RewardHistories{
 		types.RewardHistory{
 			Denom: "stake",
-			Index: sdk.NewDec(14).Quo(sdk.NewDec(12)),
+			Index: sdk.NewDec(26).Quo(sdk.NewDec(12)),
 		},
 	}, globalIndices)
 
@@ -110,12 +114,12 @@ func TestRewardPoolAndGlobalIndex(t *testing.T) {
 	require.NoError(t, err)
 
 	// Expect global index to be updated
-	// 14/12 + 4/12 = 18/12
+	// 26/12 + 4/12 = 30/12
 	globalIndices = types.NewRewardHistories(val1.GlobalRewardHistory)
 	require.Equal(t, types.RewardHistories{
 		types.RewardHistory{
 			Denom: "stake",
-			Index: sdk.NewDec(14).Quo(sdk.NewDec(12)),
+			Index: sdk.NewDec(26).Quo(sdk.NewDec(12)),
 		},
 		types.RewardHistory{
 			Denom: "stake2",
@@ -252,6 +256,242 @@ func TestClaimRewards(t *testing.T) {
 	require.Equal(t, indices, types.NewRewardHistories(delegation.RewardHistory))
 }
 
+func TestClaimAllRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(10), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	rewardsPoolAddr := app.AccountKeeper.GetModuleAddress(types.RewardsPoolName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+
+	// Mint tokens
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1200_000))))
+	require.NoError(t, err)
+
+	// Claiming without any delegations fails
+	_, err = app.FuryaKeeper.ClaimAllDelegationRewards(ctx, user2)
+	require.Error(t, err)
+
+	// User 1 delegates both assets to the same validator
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	// Transfer to reward pool
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1200_000))))
+	require.NoError(t, err)
+
+	// User 1 claims rewards for both delegations at once
+	// Total power is 1 * 2 + 1 * 10 = 12 so the index is 0.1 stake per power
+	coins, err := app.FuryaKeeper.ClaimAllDelegationRewards(ctx, user1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1200_000))), coins)
+
+	// All rewards have been distributed
+	coins = app.BankKeeper.GetAllBalances(ctx, rewardsPoolAddr)
+	require.True(t, coins.IsZero())
+
+	// Check that all delegations have updated local indices
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	indices := types.NewRewardHistories(val1.GlobalRewardHistory)
+	delegation, found := app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, indices, types.NewRewardHistories(delegation.RewardHistory))
+	delegation, found = app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_2_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, indices, types.NewRewardHistories(delegation.RewardHistory))
+}
+
+func TestClaimRewardsToWithdrawAddress(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	withdrawAddr := addrs[1]
+
+	// Withdraw address defaults to the delegator
+	require.Equal(t, user1, app.FuryaKeeper.GetWithdrawAddress(ctx, user1))
+
+	// Module accounts cannot receive rewards
+	err = app.FuryaKeeper.SetWithdrawAddress(ctx, user1, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	require.Error(t, err)
+
+	err = app.FuryaKeeper.SetWithdrawAddress(ctx, user1, withdrawAddr)
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr, app.FuryaKeeper.GetWithdrawAddress(ctx, user1))
+
+	// Mint tokens
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+
+	// New delegation from user 1
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	// Transfer to reward pool
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Explicit claims are sent to the withdraw address
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), coins)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(1000_000)), app.BankKeeper.GetBalance(ctx, withdrawAddr, "stake"))
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(0)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+
+	// Transfer to reward pool
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Rewards that are claimed when delegating again are also sent to the withdraw address
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(2000_000)), app.BankKeeper.GetBalance(ctx, withdrawAddr, "stake"))
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(0)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+
+	// Setting the withdraw address back to the delegator resets it
+	err = app.FuryaKeeper.SetWithdrawAddress(ctx, user1, user1)
+	require.NoError(t, err)
+	require.Equal(t, user1, app.FuryaKeeper.GetWithdrawAddress(ctx, user1))
+}
+
+func TestAutoCompoundRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.LastAutoCompoundTime = startTime
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), startTime),
+			types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), startTime),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+
+	// Auto-compounding can only be enabled for existing delegations
+	err = app.FuryaKeeper.UpdateAutoCompound(ctx, user1, val1, FURYA_TOKEN_DENOM, true)
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegatorForAddress)
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	err = app.FuryaKeeper.UpdateAutoCompound(ctx, user1, val1, FURYA_TOKEN_DENOM, true)
+	require.NoError(t, err)
+	require.True(t, app.FuryaKeeper.IsAutoCompoundEnabled(ctx, user1, valAddr1, FURYA_TOKEN_DENOM))
+
+	// Mint tokens and transfer to reward pool
+	rewards := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000)), sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rewards.Add(rewards...))
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, rewards)
+	require.NoError(t, err)
+
+	// Whitelisted rewards are delegated back to the validator while other rewards are paid out
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, rewards, coins)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(1000_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+	require.Equal(t, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(0)), app.BankKeeper.GetBalance(ctx, user1, FURYA_2_TOKEN_DENOM))
+
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	asset2, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1000_000), asset2.TotalTokens)
+	compounded, found := app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_2_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)), types.GetDelegationTokens(compounded, val1, asset2))
+
+	// The end blocker sweep does nothing before the interval has passed
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, rewards)
+	require.NoError(t, err)
+	app.FuryaKeeper.AutoCompoundHook(ctx)
+	require.Equal(t, startTime, app.FuryaKeeper.LastAutoCompoundTime(ctx))
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(1000_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+
+	// The end blocker sweep claims rewards once the interval has passed
+	ctx = ctx.WithBlockTime(startTime.Add(params.AutoCompoundInterval).Add(time.Second))
+	app.FuryaKeeper.AutoCompoundHook(ctx)
+	require.Equal(t, ctx.BlockTime(), app.FuryaKeeper.LastAutoCompoundTime(ctx))
+	require.True(t, app.BankKeeper.GetBalance(ctx, user1, "stake").Amount.GT(sdk.NewInt(1000_000)))
+	asset2, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+	require.True(t, asset2.TotalTokens.GT(sdk.NewInt(1000_000)))
+
+	// A sweep is spread over the following blocks when it has more delegations than its limit
+	err = app.FuryaKeeper.UpdateAutoCompound(ctx, user1, val1, FURYA_2_TOKEN_DENOM, true)
+	require.NoError(t, err)
+	sweepTime := ctx.BlockTime().Add(params.AutoCompoundInterval).Add(time.Second)
+	ctx = ctx.WithBlockTime(sweepTime)
+	require.Equal(t, uint64(1), app.FuryaKeeper.AutoCompound(ctx, 1))
+	require.Equal(t, sweepTime, app.FuryaKeeper.LastAutoCompoundTime(ctx))
+	ctx = ctx.WithBlockTime(sweepTime.Add(time.Second))
+	require.Equal(t, uint64(1), app.FuryaKeeper.AutoCompound(ctx, 1))
+	require.Equal(t, uint64(0), app.FuryaKeeper.AutoCompound(ctx, 1))
+	require.Equal(t, sweepTime, app.FuryaKeeper.LastAutoCompoundTime(ctx))
+
+	// Undelegating everything removes the auto-compound entry
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Undelegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	require.False(t, app.FuryaKeeper.IsAutoCompoundEnabled(ctx, user1, valAddr1, FURYA_TOKEN_DENOM))
+}
+
 func TestClaimRewardsWithMultipleValidators(t *testing.T) {
 	var err error
 	app, ctx := createTestContext(t)
@@ -659,3 +899,49 @@ func TestRewardClaimingAfterRatesDecay(t *testing.T) {
 	// Expect total claimed rewards to be whatever that was added
 	require.Equal(t, sdk.NewInt(2000_000), coins.Add(coins2...).AmountOf(bondDenom))
 }
+
+func TestUnallocatedRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), ctx.BlockTime()),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	rewardsPoolAddr := app.AccountKeeper.GetModuleAddress(types.RewardsPoolName)
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(3000_000))))
+	require.NoError(t, err)
+
+	// Rewards of a validator without furya delegations are kept in the rewards pool
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, rewardsPoolAddr, "stake").Amount)
+	res, err := queryServer.FuryaUnallocatedRewards(ctx, &types.QueryFuryaUnallocatedRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorUnallocatedRewards{
+		{
+			ValidatorAddress: valAddr.String(),
+			Rewards:          sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))),
+		},
+	}, res.UnallocatedRewards)
+
+	// Once swept, both the kept and the new rewards go to the community pool
+	params := app.FuryaKeeper.GetParams(ctx)
+	params.SweepUnallocatedRewards = true
+	app.FuryaKeeper.SetParams(ctx, params)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, rewardsPoolAddr, "stake").IsZero())
+	require.Equal(t, communityPool.Add(sdk.NewDec(2000_000)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+	res, err = queryServer.FuryaUnallocatedRewards(ctx, &types.QueryFuryaUnallocatedRewardsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.UnallocatedRewards, 0)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueueRewardWeightSchedule replaces the queued update of an asset's reward weight schedule
+// so that the schedule is applied again from the current block on
+func (k Keeper) QueueRewardWeightSchedule(ctx sdk.Context, asset types.FuryaAsset) {
+	k.DequeueRewardWeightSchedule(ctx, asset.Denom)
+	if asset.RewardWeightSchedule == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardWeightDecayQueueKey(ctx.BlockTime(), asset.Denom), []byte{})
+}
+
+// DequeueRewardWeightSchedule removes the queued updates of an asset's reward weight schedule
+func (k Keeper) DequeueRewardWeightSchedule(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.RewardWeightDecayQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		if _, queuedDenom := types.ParseRewardWeightDecayQueueKeyForDenom(iter.Key()); queuedDenom == denom {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RewardWeightScheduleHook sets the scheduled reward weight of the assets whose schedule update is due
+// and queues their next update. Reward weight changes go through UpdateFuryaAsset so that they are snapshotted.
+func (k Keeper) RewardWeightScheduleHook(ctx sdk.Context, assets []*types.FuryaAsset) {
+	store := ctx.KVStore(k.storeKey)
+	due := make(map[string]bool)
+	var keys [][]byte
+	iter := store.Iterator(types.RewardWeightDecayQueueKey, sdk.PrefixEndBytes(types.GetRewardWeightDecayQueueByTimestampKey(ctx.BlockTime())))
+	for ; iter.Valid(); iter.Next() {
+		_, denom := types.ParseRewardWeightDecayQueueKeyForDenom(iter.Key())
+		due[denom] = true
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	if len(keys) == 0 {
+		return
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, asset := range assets {
+		if !due[asset.Denom] || asset.RewardWeightSchedule == nil {
+			continue
+		}
+		schedule := *asset.RewardWeightSchedule
+		if weight, found := schedule.RewardWeightAt(ctx.BlockTime()); found {
+			weight = asset.ClampRewardWeight(weight)
+			if !weight.Equal(asset.RewardWeight) {
+				asset.RewardWeight = weight
+				k.QueueAssetRebalanceEvent(ctx)
+				if err := k.UpdateFuryaAsset(ctx, *asset); err != nil {
+					k.Logger(ctx).Error("failed to apply the reward weight schedule", "denom", asset.Denom, "error", err)
+				}
+			}
+		}
+		if nextTime, found := schedule.NextUpdateTime(ctx.BlockTime()); found {
+			store.Set(types.GetRewardWeightDecayQueueKey(nextTime, asset.Denom), []byte{})
+		}
+	}
+}
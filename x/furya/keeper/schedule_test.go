@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardWeightSchedule(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{},
+	})
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+
+	// Schedules must be valid
+	invalidSchedule := &types.RewardWeightSchedule{
+		Breakpoints: []types.RewardWeightBreakpoint{
+			{Time: startTime.Add(time.Hour * 2), RewardWeight: sdk.NewDec(1)},
+			{Time: startTime.Add(time.Hour), RewardWeight: sdk.NewDec(1)},
+		},
+		Interpolation: types.RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_STEP,
+	}
+	proposal := types.NewMsgCreateFuryaProposal("", "", FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), sdk.OneDec(), 0, nil, nil, nil, nil, nil, invalidSchedule, nil)
+	require.Error(t, proposal.ValidateBasic())
+
+	// Pass proposals to add an asset that decays linearly after a breakpoint and an asset that steps up
+	updateInterval := time.Hour
+	err := app.FuryaKeeper.CreateFurya(ctx, &types.MsgCreateFuryaProposal{
+		Denom:            FURYA_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		RewardWeightSchedule: &types.RewardWeightSchedule{
+			Breakpoints: []types.RewardWeightBreakpoint{
+				{Time: startTime.Add(time.Hour), RewardWeight: sdk.MustNewDecFromStr("0.5")},
+				{Time: startTime.Add(time.Hour * 5), RewardWeight: sdk.MustNewDecFromStr("0.1")},
+			},
+			Interpolation:  types.RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR,
+			UpdateInterval: updateInterval,
+		},
+	})
+	require.NoError(t, err)
+	maxRewardWeight := sdk.MustNewDecFromStr("2.5")
+	err = app.FuryaKeeper.CreateFurya(ctx, &types.MsgCreateFuryaProposal{
+		Denom:            FURYA_2_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		MaxRewardWeight:  &maxRewardWeight,
+		RewardWeightSchedule: &types.RewardWeightSchedule{
+			Breakpoints: []types.RewardWeightBreakpoint{
+				{Time: startTime.Add(time.Hour * 2), RewardWeight: sdk.NewDec(3)},
+			},
+			Interpolation: types.RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_STEP,
+		},
+	})
+	require.NoError(t, err)
+
+	// Delegate so that reward weight changes are snapshotted
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	rewardWeights := func(blockTime time.Time) (sdk.Dec, sdk.Dec) {
+		ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(ctx.BlockHeight() + 1)
+		assets := app.FuryaKeeper.GetAllAssets(ctx)
+		app.FuryaKeeper.RewardWeightScheduleHook(ctx, assets)
+		app.FuryaKeeper.RewardWeightChangeHook(ctx, assets)
+		asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+		asset2, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+		return asset.RewardWeight, asset2.RewardWeight
+	}
+
+	// Nothing changes before the first breakpoints
+	weight, weight2 := rewardWeights(startTime.Add(time.Minute * 30))
+	require.Equal(t, sdk.NewDec(1), weight)
+	require.Equal(t, sdk.NewDec(1), weight2)
+	require.False(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// The reward weight is set at the breakpoint and snapshotted
+	weight, weight2 = rewardWeights(startTime.Add(time.Hour))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), weight)
+	require.Equal(t, sdk.NewDec(1), weight2)
+	require.True(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+	var snapshots int
+	app.FuryaKeeper.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, lastClaimHeight uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+		require.Equal(t, FURYA_TOKEN_DENOM, denom)
+		snapshots++
+		return false
+	})
+	require.Equal(t, 1, snapshots)
+
+	// Linear schedules are interpolated once per update interval
+	weight, _ = rewardWeights(startTime.Add(time.Hour + time.Minute*30))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), weight)
+	weight, _ = rewardWeights(startTime.Add(time.Hour * 2))
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), weight)
+
+	// Step schedules jump to the breakpoint, clamped to the reward weight bounds of the asset
+	_, weight2 = rewardWeights(startTime.Add(time.Hour * 2))
+	require.Equal(t, maxRewardWeight, weight2)
+
+	// The last breakpoint is reached even if blocks were skipped
+	weight, _ = rewardWeights(startTime.Add(time.Hour * 10))
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), weight)
+
+	// Removing the schedule stops the updates
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+	err = app.FuryaKeeper.UpdateFurya(ctx, &types.MsgUpdateFuryaProposal{
+		Denom:                FURYA_2_TOKEN_DENOM,
+		RewardWeight:         sdk.NewDec(2),
+		TakeRate:             asset.TakeRate,
+		RewardChangeRate:     asset.RewardChangeRate,
+		RewardChangeInterval: asset.RewardChangeInterval,
+	})
+	require.NoError(t, err)
+	_, weight2 = rewardWeights(startTime.Add(time.Hour * 20))
+	require.Equal(t, sdk.NewDec(2), weight2)
+}
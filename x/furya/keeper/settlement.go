@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SettleDelegationRewards moves the accrued rewards of up to limit delegations into their pending rewards so that
+// delegations do not depend on old reward weight change snapshots anymore. Snapshots that are no longer referenced
+// after settling are garbage collected.
+// Delegations are visited in sweeps over all delegations, delegations settled since the sweep started are skipped.
+func (k Keeper) SettleDelegationRewards(ctx sdk.Context, limit uint64) (settled uint64, pruned uint64) {
+	store := ctx.KVStore(k.storeKey)
+	cursor, found := k.GetRewardSettlementCursor(ctx)
+	if !found {
+		cursor = types.RewardSettlementCursor{
+			NextKey:          types.DelegationKey,
+			SweepStartHeight: uint64(ctx.BlockHeight()),
+		}
+	}
+
+	// Delegations are collected first since settling them writes to the store
+	var delegations []types.Delegation
+	iter := store.Iterator(cursor.NextKey, sdk.PrefixEndBytes(types.DelegationKey))
+	for ; iter.Valid() && uint64(len(delegations)) < limit; iter.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iter.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	var nextKey []byte
+	if iter.Valid() {
+		nextKey = iter.Key()
+	}
+	iter.Close()
+
+	for _, d := range delegations {
+		if d.LastRewardClaimHeight >= cursor.SweepStartHeight {
+			continue
+		}
+		// Each delegation is settled in its own cache context so that a single failure does not halt the others
+		cacheCtx, write := ctx.CacheContext()
+		err := k.settleDelegationRewards(cacheCtx, d)
+		if err != nil {
+			k.Logger(ctx).Error("failed to settle furya delegation rewards",
+				"delegator", d.DelegatorAddress, "validator", d.ValidatorAddress, "denom", d.Denom, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		settled++
+
+		valAddr, _ := sdk.ValAddressFromBech32(d.ValidatorAddress)
+		pruned += k.GarbageCollectWeightChangeSnapshots(ctx, d.Denom, valAddr)
+	}
+
+	if nextKey != nil {
+		cursor.NextKey = nextKey
+		k.SetRewardSettlementCursor(ctx, cursor)
+	} else {
+		store.Delete(types.RewardSettlementCursorKey)
+	}
+	return settled, pruned
+}
+
+// settleDelegationRewards updates the reward history of a delegation and adds the accrued rewards to its pending rewards.
+// Delegations owned by the module back tokenized delegations, their rewards are accrued to the token holders instead.
+func (k Keeper) settleDelegationRewards(ctx sdk.Context, delegation types.Delegation) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if delAddr.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		tokenDenom := types.GetTokenizedDenom(valAddr, delegation.Denom)
+		tokenized, found := k.GetTokenizedDelegation(ctx, tokenDenom)
+		if !found {
+			return status.Errorf(codes.NotFound, "Tokenized delegation %s does not exist", tokenDenom)
+		}
+		tokenized, err = k.accrueTokenizedRewards(ctx, tokenized)
+		if err != nil {
+			return err
+		}
+		k.SetTokenizedDelegation(ctx, tokenized)
+		return nil
+	}
+
+	asset, found := k.GetAssetByDenom(ctx, delegation.Denom)
+	if !found {
+		return types.ErrUnknownAsset
+	}
+	validator, err := k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	_, err = k.ClaimValidatorRewards(ctx, validator)
+	if err != nil {
+		return err
+	}
+	// Validator is queried again since claiming validator rewards updates its reward history
+	validator, err = k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	coins, err := k.accrueDelegationRewards(ctx, &delegation, validator, asset)
+	if err != nil {
+		return err
+	}
+	delegation.PendingRewards = delegation.PendingRewards.Add(coins...)
+	k.SetDelegation(ctx, delAddr, valAddr, delegation.Denom, delegation)
+	return nil
+}
+
+func (k Keeper) GetRewardSettlementCursor(ctx sdk.Context) (cursor types.RewardSettlementCursor, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.RewardSettlementCursorKey)
+	if b == nil {
+		return cursor, false
+	}
+	k.cdc.MustUnmarshal(b, &cursor)
+	return cursor, true
+}
+
+func (k Keeper) SetRewardSettlementCursor(ctx sdk.Context, cursor types.RewardSettlementCursor) {
+	ctx.KVStore(k.storeKey).Set(types.RewardSettlementCursorKey, k.cdc.MustMarshal(&cursor))
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettleDelegationRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+
+	for _, user := range addrs {
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		_, err = app.FuryaKeeper.Delegate(ctx, user, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+		require.NoError(t, err)
+	}
+
+	// Transfer to reward pool and take a reward weight change snapshot
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	app.FuryaKeeper.SetRewardWeightChangeSnapshot(ctx, asset, val)
+
+	// The first batch only settles a single delegation and keeps the snapshot
+	ctx = ctx.WithBlockHeight(3)
+	settled, pruned := app.FuryaKeeper.SettleDelegationRewards(ctx, 1)
+	require.Equal(t, uint64(1), settled)
+	require.Equal(t, uint64(0), pruned)
+	_, found := app.FuryaKeeper.GetRewardSettlementCursor(ctx)
+	require.True(t, found)
+
+	// The second batch completes the sweep and prunes the snapshot
+	settled, pruned = app.FuryaKeeper.SettleDelegationRewards(ctx, 1)
+	require.Equal(t, uint64(1), settled)
+	require.Equal(t, uint64(1), pruned)
+	_, found = app.FuryaKeeper.GetRewardSettlementCursor(ctx)
+	require.False(t, found)
+	var snapshots int
+	app.FuryaKeeper.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, lastClaimHeight uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+		snapshots++
+		return false
+	})
+	require.Equal(t, 0, snapshots)
+
+	// Accrued rewards are moved to the pending rewards
+	for _, user := range addrs {
+		delegation, found := app.FuryaKeeper.GetDelegation(ctx, user, val, FURYA_TOKEN_DENOM)
+		require.True(t, found)
+		require.Equal(t, uint64(3), delegation.LastRewardClaimHeight)
+		require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(500_000))), delegation.PendingRewards)
+	}
+
+	// Delegations settled in the current sweep are skipped
+	settled, _ = app.FuryaKeeper.SettleDelegationRewards(ctx, 10)
+	require.Equal(t, uint64(0), settled)
+
+	// Pending rewards are paid out when claiming
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(500_000))), coins)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(500_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+	delegation, _ := app.FuryaKeeper.GetDelegation(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.True(t, delegation.PendingRewards.Empty())
+	delegation, _ = app.FuryaKeeper.GetDelegation(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(500_000))), delegation.PendingRewards)
+}
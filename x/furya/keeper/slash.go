@@ -1,75 +1,140 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/furya-official/furya/x/furya/types"
 )
 
+// SlashValidator slashes the furya tokens delegated to a validator, its immature redelegations and its immature
+// undelegations by the fraction multiplied by the slash multiplier of each asset, sends the slashed tokens to the slash
+// destination and records the slash
 func (k Keeper) SlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
 	val, err := k.GetFuryaValidator(ctx, valAddr)
 	if err != nil {
 		return err
 	}
 	slashedValidatorShares := sdk.NewDecCoins()
+	slashedTokens := sdk.NewCoins()
 	for _, share := range val.ValidatorShares {
-		sharesToSlash := share.Amount.Mul(fraction)
-		slashedValidatorShares = slashedValidatorShares.Add(sdk.NewDecCoinFromDec(share.Denom, share.Amount.Sub(sharesToSlash)))
 		asset, found := k.GetAssetByDenom(ctx, share.Denom)
 		if !found {
 			return types.ErrUnknownAsset
 		}
+		// Tokens are removed from the asset together with the validator shares backing them
+		// so that only the delegators of the slashed validator lose tokens
+		tokensToSlash := asset.SlashFraction(fraction).Mul(val.TotalDecTokensWithAsset(asset)).TruncateInt()
+		if tokensToSlash.IsZero() {
+			continue
+		}
+		sharesToSlash := types.GetValidatorShares(asset, tokensToSlash)
+		if sharesToSlash.GT(share.Amount) {
+			sharesToSlash = share.Amount
+		}
+		asset.TotalTokens = asset.TotalTokens.Sub(tokensToSlash)
 		asset.TotalValidatorShares = asset.TotalValidatorShares.Sub(sharesToSlash)
 		k.SetAsset(ctx, asset)
+		slashedValidatorShares = slashedValidatorShares.Add(sdk.NewDecCoinFromDec(share.Denom, sharesToSlash))
+		slashedTokens = slashedTokens.Add(sdk.NewCoin(share.Denom, tokensToSlash))
+	}
+	k.updateValidatorShares(ctx, val, sdk.NewDecCoins(), slashedValidatorShares, false)
+	if err = k.sendSlashedTokens(ctx, slashedTokens); err != nil {
+		return err
 	}
-	val.ValidatorShares = slashedValidatorShares
-	k.SetValidator(ctx, val)
 
-	err = k.SlashRedelegations(ctx, valAddr, fraction)
+	redelegationSlashes, err := k.SlashRedelegations(ctx, valAddr, fraction)
 	if err != nil {
 		return err
 	}
 
-	err = k.SlashUndelegations(ctx, valAddr, fraction)
+	undelegationSlashes, err := k.SlashUndelegations(ctx, valAddr, fraction)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	// Slashes of validators without furya delegations are not recorded
+	if slashedValidatorShares.IsZero() && len(redelegationSlashes) == 0 && len(undelegationSlashes) == 0 {
+		return nil
+	}
+	slash := types.FuryaSlash{
+		Id:                     k.nextSlashID(ctx),
+		ValidatorAddress:       valAddr.String(),
+		Height:                 ctx.BlockHeight(),
+		Time:                   ctx.BlockTime(),
+		Fraction:               fraction,
+		SlashedValidatorShares: slashedValidatorShares,
+		Redelegations:          redelegationSlashes,
+		Undelegations:          undelegationSlashes,
+		SlashedTokens:          slashedTokens,
+	}
+	denoms := slash.Denoms()
+	sortedDenoms := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sortedDenoms = append(sortedDenoms, denom)
+	}
+	sort.Strings(sortedDenoms)
+	for _, denom := range sortedDenoms {
+		slash.EffectiveFractions = append(slash.EffectiveFractions, types.AssetSlashFraction{
+			Denom:    denom,
+			Fraction: k.slashFraction(ctx, denom, fraction),
+		})
+	}
+	k.SetSlash(ctx, slash)
+	return ctx.EventManager().EmitTypedEvent(&types.EventFuryaSlash{Slash: slash})
 }
 
-func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) ([]types.RedelegationSlash, error) {
 	store := ctx.KVStore(k.storeKey)
-	// Slash all immature re-delegations
+	// Redelegations are collected first since slashing them writes to the store
+	var redelegationKeys [][]byte
 	redelegationIterator := k.IterateRedelegationsBySrcValidator(ctx, valAddr)
 	for ; redelegationIterator.Valid(); redelegationIterator.Next() {
 		redelegationKey, completion, err := types.ParseRedelegationIndexForRedelegationKey(redelegationIterator.Key())
 		if err != nil {
-			return err
+			redelegationIterator.Close()
+			return nil, err
 		}
 		// Skip if redelegation is already mature
 		if completion.Before(ctx.BlockTime()) {
 			continue
 		}
+		redelegationKeys = append(redelegationKeys, redelegationKey)
+	}
+	redelegationIterator.Close()
+
+	// Slash all immature re-delegations
+	var slashes []types.RedelegationSlash
+	slashedTokens := sdk.NewCoins()
+	for _, redelegationKey := range redelegationKeys {
 		b := store.Get(redelegationKey)
 		var redelegation types.Redelegation
 		k.cdc.MustUnmarshal(b, &redelegation)
 
 		delAddr, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		dstValAddr, err := sdk.ValAddressFromBech32(redelegation.DstValidatorAddress)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		dstVal, err := k.GetFuryaValidator(ctx, dstValAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		_, err = k.ClaimDelegationRewards(ctx, delAddr, dstVal, redelegation.Balance.Denom)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		// Validator is queried again since claiming rewards might have compounded into it
+		if k.IsAutoCompoundEnabled(ctx, delAddr, dstValAddr, redelegation.Balance.Denom) {
+			dstVal, err = k.GetFuryaValidator(ctx, dstValAddr)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		delegation, found := k.GetDelegation(ctx, delAddr, dstVal, redelegation.Balance.Denom)
@@ -82,50 +147,162 @@ func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, frac
 			continue
 		}
 
-		// Slash delegation shares
-		tokensToSlash := fraction.MulInt(redelegation.Balance.Amount).TruncateInt()
-		sharesToSlash, err := k.ValidateDelegatedAmount(delegation, sdk.NewCoin(redelegation.Balance.Denom, tokensToSlash), dstVal, asset)
+		// Slash at most the tokens left in the delegation since they might have been undelegated or redelegated again
+		tokensToSlash := asset.SlashFraction(fraction).MulInt(redelegation.Balance.Amount).TruncateInt()
+		if balance := types.GetDelegationTokens(delegation, dstVal, asset).Amount; tokensToSlash.GT(balance) {
+			tokensToSlash = balance
+		}
+		if tokensToSlash.IsZero() {
+			continue
+		}
+		coinToSlash := sdk.NewCoin(asset.Denom, tokensToSlash)
+		delegationSharesToSlash, err := k.ValidateDelegatedAmount(delegation, coinToSlash, dstVal, asset)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		dstVal.TotalDelegatorShares = sdk.NewDecCoins(dstVal.TotalDelegatorShares...).Sub(sdk.NewDecCoins(sdk.NewDecCoinFromDec(asset.Denom, sharesToSlash)))
-		k.SetValidator(ctx, dstVal)
+		validatorSharesToSlash := types.GetValidatorShares(asset, tokensToSlash)
 
-		delegation.Shares = delegation.Shares.Sub(sharesToSlash)
-		k.SetDelegation(ctx, delAddr, dstVal.GetOperator(), asset.Denom, delegation)
+		// Remove tokens and shares from the furya asset
+		asset.TotalTokens = asset.TotalTokens.Sub(tokensToSlash)
+		asset.TotalValidatorShares = asset.TotalValidatorShares.Sub(validatorSharesToSlash)
+		k.SetAsset(ctx, asset)
+
+		// Remove shares from the delegation and the dst validator
+		k.reduceDelegationShares(ctx, delAddr, dstVal, coinToSlash, delegationSharesToSlash, delegation)
+		k.updateValidatorShares(
+			ctx,
+			dstVal,
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(asset.Denom, delegationSharesToSlash)),
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec(asset.Denom, validatorSharesToSlash)),
+			false,
+		)
+		slashedTokens = slashedTokens.Add(coinToSlash)
+
+		slashes = append(slashes, types.RedelegationSlash{
+			DelegatorAddress:    redelegation.DelegatorAddress,
+			DstValidatorAddress: redelegation.DstValidatorAddress,
+			Amount:              coinToSlash,
+			Shares:              delegationSharesToSlash,
+		})
+	}
+	if err := k.sendSlashedTokens(ctx, slashedTokens); err != nil {
+		return nil, err
 	}
-	return nil
+	return slashes, nil
 }
 
-func (k Keeper) SlashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+func (k Keeper) SlashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) ([]types.UndelegationSlash, error) {
 	store := ctx.KVStore(k.storeKey)
-	// Slash all immature re-delegations
+	// Undelegations are collected first since slashing them writes to the store. Undelegations of a delegator
+	// that complete at the same time share a key so each key is only slashed once.
+	var undelegationKeys [][]byte
+	seen := make(map[string]bool)
 	undelegationIterator := k.IterateUndelegationsBySrcValidator(ctx, valAddr)
 	for ; undelegationIterator.Valid(); undelegationIterator.Next() {
 		undelegationKey, completion, err := types.ParseUnbondingIndexKeyToUndelegationKey(undelegationIterator.Key())
 		if err != nil {
-			return err
+			undelegationIterator.Close()
+			return nil, err
 		}
 		// Skip if undelegation is already mature
-		if completion.Before(ctx.BlockTime()) {
+		if completion.Before(ctx.BlockTime()) || seen[string(undelegationKey)] {
 			continue
 		}
+		seen[string(undelegationKey)] = true
+		undelegationKeys = append(undelegationKeys, undelegationKey)
+	}
+	undelegationIterator.Close()
+
+	// Slash all immature undelegations
+	var slashes []types.UndelegationSlash
+	slashedTokens := sdk.NewCoins()
+	for _, undelegationKey := range undelegationKeys {
 		b := store.Get(undelegationKey)
 		var undelegations types.QueuedUndelegation
 		k.cdc.MustUnmarshal(b, &undelegations)
 
-		// Slash undelegations by sending slashed tokens to fee pool
+		// Slash undelegations by removing the slashed tokens from their balance
 		for _, entry := range undelegations.Entries {
-			tokensToSlash := fraction.MulInt(entry.Balance.Amount).TruncateInt()
+			if entry.ValidatorAddress != valAddr.String() {
+				continue
+			}
+			tokensToSlash := k.slashFraction(ctx, entry.Balance.Denom, fraction).MulInt(entry.Balance.Amount).TruncateInt()
+			if tokensToSlash.IsZero() {
+				continue
+			}
 			entry.Balance = sdk.NewCoin(entry.Balance.Denom, entry.Balance.Amount.Sub(tokensToSlash))
 			coinToSlash := sdk.NewCoin(entry.Balance.Denom, tokensToSlash)
-			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(coinToSlash))
-			if err != nil {
-				return err
-			}
+			slashedTokens = slashedTokens.Add(coinToSlash)
+			slashes = append(slashes, types.UndelegationSlash{
+				DelegatorAddress: entry.DelegatorAddress,
+				Amount:           coinToSlash,
+			})
 		}
 		b = k.cdc.MustMarshal(&undelegations)
 		store.Set(undelegationKey, b)
 	}
-	return nil
+	if err := k.sendSlashedTokens(ctx, slashedTokens); err != nil {
+		return nil, err
+	}
+	return slashes, nil
+}
+
+// slashFraction returns the fraction the delegations of an asset are slashed by when their validator is slashed by the
+// fraction. Undelegations of assets that have been deleted since are slashed by the fraction of the validator.
+func (k Keeper) slashFraction(ctx sdk.Context, denom string, fraction sdk.Dec) sdk.Dec {
+	asset, found := k.GetAssetByDenom(ctx, denom)
+	if !found {
+		return fraction
+	}
+	return asset.SlashFraction(fraction)
+}
+
+// sendSlashedTokens moves slashed tokens out of the module account to the slash destination
+func (k Keeper) sendSlashedTokens(ctx sdk.Context, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	switch k.SlashDestination(ctx) {
+	case types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL:
+		return k.distributionKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	case types.SlashDestination_SLASH_DESTINATION_BURN:
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	default:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+	}
+}
+
+func (k Keeper) nextSlashID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if b := store.Get(types.NextFuryaSlashIDKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	store.Set(types.NextFuryaSlashIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+func (k Keeper) setNextSlashID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextFuryaSlashIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) SetSlash(ctx sdk.Context, slash types.FuryaSlash) {
+	valAddr, err := sdk.ValAddressFromBech32(slash.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFuryaSlashKey(valAddr, slash.Id), k.cdc.MustMarshal(&slash))
+}
+
+func (k Keeper) IterateSlashes(ctx sdk.Context, cb func(slash types.FuryaSlash) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FuryaSlashKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var slash types.FuryaSlash
+		k.cdc.MustUnmarshal(iter.Value(), &slash)
+		if cb(slash) {
+			return
+		}
+	}
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	test_helpers "github.com/furya-official/furya/app"
 	"github.com/furya-official/furya/x/furya"
+	"github.com/furya-official/furya/x/furya/keeper"
 	"github.com/furya-official/furya/x/furya/types"
 	"testing"
 	"time"
@@ -137,14 +138,14 @@ func TestSlashingEvent(t *testing.T) {
 	tokens = val1.TotalTokensWithAsset(asset2).TruncateInt()
 	require.Greater(t, sdk.NewInt(20_000_000).Int64(), tokens.Int64())
 
-	// Expect that total tokens with validator 2 increased (redistributed from slashing)
+	// Expect that total tokens with validator 2 stayed the same since only the delegators of validator 1 are slashed
 	val2, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
 	asset1, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
 	tokens = val2.TotalTokensWithAsset(asset1).TruncateInt()
-	require.Less(t, sdk.NewInt(20_000_000).Int64(), tokens.Int64())
+	require.Equal(t, sdk.NewInt(20_000_000), tokens)
 	asset2, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
 	tokens = val2.TotalTokensWithAsset(asset2).TruncateInt()
-	require.Less(t, sdk.NewInt(20_000_000).Int64(), tokens.Int64())
+	require.Equal(t, sdk.NewInt(20_000_000), tokens)
 
 	// Expect that consensus power for val1 dropped
 	newValPower1 := val1.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
@@ -426,3 +427,326 @@ func TestSlashingAfterUndelegation(t *testing.T) {
 	_, stop := furya.RunAllInvariants(ctx, app.FuryaKeeper)
 	require.False(t, stop)
 }
+
+func TestSlashHistory(t *testing.T) {
+	var err error
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			{
+				Denom:        FURYA_TOKEN_DENOM,
+				RewardWeight: sdk.NewDec(2),
+				TakeRate:     sdk.NewDec(0),
+				TotalTokens:  sdk.ZeroInt(),
+			},
+			{
+				Denom:        FURYA_2_TOKEN_DENOM,
+				RewardWeight: sdk.NewDec(10),
+				TakeRate:     sdk.NewDec(0),
+				TotalTokens:  sdk.ZeroInt(),
+			},
+		},
+	})
+
+	// Accounts
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 5, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(20_000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(20_000_000)),
+	))
+	pks := test_helpers.CreateTestPubKeys(2)
+
+	valAddr1 := sdk.ValAddress(addrs[0])
+	_val1 := teststaking.NewValidator(t, valAddr1, pks[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val1)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+
+	valAddr2 := sdk.ValAddress(addrs[1])
+	_val2 := teststaking.NewValidator(t, valAddr2, pks[1])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	val2, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+
+	user1 := addrs[2]
+	user2 := addrs[3]
+	user3 := addrs[4]
+
+	// user1 undelegates from val1, user2 redelegates from val1 to val2 and user3 stays delegated to val1
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val1, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user3, val1, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user3, val2, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	_, err = app.FuryaKeeper.Undelegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Redelegate(ctx, user2, val1, val2, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+
+	assets = app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	// Slash val1
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	val1, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	valPower1 := val1.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx))
+	valConAddr1, _ := val1.GetConsAddr()
+	slashFraction := app.SlashingKeeper.SlashFractionDoubleSign(ctx)
+	app.SlashingKeeper.Slash(ctx, valConAddr1, slashFraction, valPower1, 1)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "furya.furya.EventFuryaSlash" {
+			emitted = true
+		}
+	}
+	require.True(t, emitted)
+
+	// The slash is recorded with the validator shares, redelegations and undelegations it slashed
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+	res, err := queryServer.FuryaSlashes(ctx, &types.QueryFuryaSlashesRequest{ValidatorAddr: valAddr1.String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Slashes))
+	slash := res.Slashes[0]
+	require.Equal(t, uint64(1), slash.Id)
+	require.Equal(t, int64(2), slash.Height)
+	require.Equal(t, slashFraction, slash.Fraction)
+	require.True(t, slash.SlashedValidatorShares.AmountOf(FURYA_2_TOKEN_DENOM).IsPositive())
+	require.True(t, slash.SlashedValidatorShares.AmountOf(FURYA_TOKEN_DENOM).IsZero())
+	require.True(t, slash.SlashedTokens.AmountOf(FURYA_2_TOKEN_DENOM).IsPositive())
+	require.Equal(t, []types.RedelegationSlash{
+		{
+			DelegatorAddress:    user2.String(),
+			DstValidatorAddress: valAddr2.String(),
+			Amount:              sdk.NewCoin(FURYA_2_TOKEN_DENOM, slashFraction.MulInt64(10_000_000).TruncateInt()),
+			Shares:              slash.Redelegations[0].Shares,
+		},
+	}, slash.Redelegations)
+	require.Equal(t, []types.UndelegationSlash{
+		{
+			DelegatorAddress: user1.String(),
+			Amount:           sdk.NewCoin(FURYA_TOKEN_DENOM, slashFraction.MulInt64(10_000_000).TruncateInt()),
+		},
+	}, slash.Undelegations)
+
+	res, err = queryServer.FuryaSlashes(ctx, &types.QueryFuryaSlashesRequest{ValidatorAddr: valAddr2.String()})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(res.Slashes))
+
+	// Delegators find the slashes of their delegations, redelegations and undelegations
+	for _, tc := range []struct {
+		delegator sdk.AccAddress
+		denom     string
+		found     bool
+	}{
+		{user1, "", true},
+		{user1, FURYA_TOKEN_DENOM, true},
+		{user1, FURYA_2_TOKEN_DENOM, false},
+		{user2, FURYA_2_TOKEN_DENOM, true},
+		{user3, "", true},
+		{user3, FURYA_2_TOKEN_DENOM, true},
+		{user3, FURYA_TOKEN_DENOM, false},
+	} {
+		res, err = queryServer.FuryaSlashes(ctx, &types.QueryFuryaSlashesRequest{DelegatorAddr: tc.delegator.String(), Denom: tc.denom})
+		require.NoError(t, err)
+		require.Equal(t, tc.found, len(res.Slashes) == 1, "delegator %s denom %s", tc.delegator, tc.denom)
+	}
+
+	// Slashes are exported and the next slash id continues after them
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Equal(t, 1, len(genesis.Slashes))
+	app.FuryaKeeper.InitGenesis(ctx, genesis)
+	app.SlashingKeeper.Slash(ctx, valConAddr1, slashFraction, valPower1, 2)
+	res, err = queryServer.FuryaSlashes(ctx, &types.QueryFuryaSlashesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Slashes))
+	require.Equal(t, uint64(2), res.Slashes[1].Id)
+
+	_, stop := furya.RunAllInvariants(ctx, app.FuryaKeeper)
+	require.False(t, stop)
+}
+
+func TestSlashedTokensDestination(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.SlashDestination = types.SlashDestination_SLASH_DESTINATION_BURN
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr2 := sdk.ValAddress(addrs[0])
+	_val2 := teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	val2, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	user1 := addrs[1]
+	user2 := addrs[2]
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val2, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	val1, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	_, err = app.FuryaKeeper.Undelegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+
+	// Slashed tokens of the delegations and the undelegations are burned
+	supply := app.BankKeeper.GetSupply(ctx, FURYA_TOKEN_DENOM).Amount
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	val1, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	delegation, _ := app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	delegationTokens := types.GetDelegationTokens(delegation, val1, asset).Amount
+	slashedTokens := sdk.MustNewDecFromStr("0.1").Mul(val1.TotalDecTokensWithAsset(asset)).TruncateInt()
+	err = app.FuryaKeeper.SlashValidator(ctx, valAddr1, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	require.Equal(t, supply.Sub(slashedTokens).Sub(sdk.NewInt(50_000)), app.BankKeeper.GetSupply(ctx, FURYA_TOKEN_DENOM).Amount)
+
+	// Only the delegators of the slashed validator lose tokens
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1500_000).Sub(slashedTokens), asset.TotalTokens)
+	val1, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	delegation, _ = app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	require.True(t, types.GetDelegationTokens(delegation, val1, asset).Amount.Sub(delegationTokens.Sub(slashedTokens)).Abs().LTE(sdk.OneInt()))
+	val2, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	delegation, _ = app.FuryaKeeper.GetDelegation(ctx, user2, val2, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1000_000), types.GetDelegationTokens(delegation, val2, asset).Amount)
+
+	// The module account holds exactly the total tokens and the pending undelegations
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1950_000).Sub(slashedTokens))), app.FuryaKeeper.TrackedModuleAssetBalance(ctx))
+	require.Equal(t, app.FuryaKeeper.TrackedModuleAssetBalance(ctx), app.FuryaKeeper.ModuleAssetBalance(ctx))
+
+	// Slashed tokens can fund the community pool instead
+	params = app.FuryaKeeper.GetParams(ctx)
+	params.SlashDestination = types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL
+	app.FuryaKeeper.SetParams(ctx, params)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(FURYA_TOKEN_DENOM)
+	err = app.FuryaKeeper.SlashValidator(ctx, valAddr2, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	require.Equal(t, communityPool.Add(sdk.NewDec(100_000)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(FURYA_TOKEN_DENOM))
+	require.Equal(t, app.FuryaKeeper.TrackedModuleAssetBalance(ctx), app.FuryaKeeper.ModuleAssetBalance(ctx))
+
+	_, stop := furya.RunAllInvariants(ctx, app.FuryaKeeper)
+	require.False(t, stop)
+
+	// Pending undelegations of a deleted asset are still held by the module account
+	app.FuryaKeeper.DeleteAsset(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(450_000))), app.FuryaKeeper.TrackedModuleAssetBalance(ctx))
+	_, stop = furya.ModuleBalanceInvariant(app.FuryaKeeper)(ctx)
+	require.False(t, stop)
+}
+
+func TestSlashMultiplier(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	riskyAsset := types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	riskyMultiplier := sdk.NewDec(3)
+	riskyAsset.SlashMultiplier = &riskyMultiplier
+	safeAsset := types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	safeMultiplier := sdk.ZeroDec()
+	safeAsset.SlashMultiplier = &safeMultiplier
+	params := types.DefaultParams()
+	params.SlashDestination = types.SlashDestination_SLASH_DESTINATION_BURN
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.FuryaAsset{riskyAsset, safeAsset},
+	})
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[0]
+
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	_, err = app.FuryaKeeper.Delegate(ctx, user, val, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	_, err = app.FuryaKeeper.Undelegate(ctx, user, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+
+	// The risky asset is slashed three times as much while the safe asset is not slashed
+	riskyAsset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	val, _ = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	slashedTokens := sdk.MustNewDecFromStr("0.3").Mul(val.TotalDecTokensWithAsset(riskyAsset)).TruncateInt()
+	err = app.FuryaKeeper.SlashValidator(ctx, valAddr, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+
+	riskyAsset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(500_000).Sub(slashedTokens), riskyAsset.TotalTokens)
+	safeAsset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_2_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1000_000), safeAsset.TotalTokens)
+
+	var slashes []types.FuryaSlash
+	app.FuryaKeeper.IterateSlashes(ctx, func(slash types.FuryaSlash) (stop bool) {
+		slashes = append(slashes, slash)
+		return false
+	})
+	require.Len(t, slashes, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), slashes[0].Fraction)
+	require.Equal(t, []types.AssetSlashFraction{
+		{Denom: FURYA_TOKEN_DENOM, Fraction: sdk.MustNewDecFromStr("0.3")},
+	}, slashes[0].EffectiveFractions)
+	require.Equal(t, []types.UndelegationSlash{
+		{DelegatorAddress: user.String(), Amount: sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(150_000))},
+	}, slashes[0].Undelegations)
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), slashes[0].EffectiveFraction(FURYA_TOKEN_DENOM))
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), slashes[0].EffectiveFraction(FURYA_2_TOKEN_DENOM))
+
+	// The effective fraction is capped at slashing all delegations of the asset
+	err = app.FuryaKeeper.SlashValidator(ctx, valAddr, sdk.MustNewDecFromStr("0.5"))
+	require.NoError(t, err)
+	riskyAsset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.True(t, riskyAsset.TotalTokens.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000))), app.FuryaKeeper.TrackedModuleAssetBalance(ctx))
+	require.Equal(t, app.FuryaKeeper.TrackedModuleAssetBalance(ctx), app.FuryaKeeper.ModuleAssetBalance(ctx))
+
+	slashes = nil
+	app.FuryaKeeper.IterateSlashes(ctx, func(slash types.FuryaSlash) (stop bool) {
+		slashes = append(slashes, slash)
+		return false
+	})
+	require.Len(t, slashes, 2)
+	require.Equal(t, sdk.OneDec(), slashes[1].EffectiveFraction(FURYA_TOKEN_DENOM))
+
+	_, stop := furya.RunAllInvariants(ctx, app.FuryaKeeper)
+	require.False(t, stop)
+
+	// Negative slash multipliers are rejected
+	negativeMultiplier := sdk.NewDec(-1)
+	proposal := types.NewMsgUpdateFuryaProposal("", "", FURYA_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), sdk.OneDec(), 0, nil, nil, nil, nil, nil, nil, &negativeMultiplier)
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/keeper"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardWeightChangeSnapshotCompaction(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.FuryaKeeper)
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+
+	for _, user := range addrs {
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		_, err = app.FuryaKeeper.Delegate(ctx, user, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+		require.NoError(t, err)
+	}
+
+	addRewards := func() {
+		err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+		require.NoError(t, err)
+	}
+	takeSnapshot := func() {
+		asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+		val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+		require.NoError(t, err)
+		app.FuryaKeeper.SetRewardWeightChangeSnapshot(ctx, asset, val)
+	}
+	snapshotCounts := func() *types.QueryFuryaSnapshotCountsResponse {
+		res, err := queryServer.FuryaSnapshotCounts(ctx, &types.QueryFuryaSnapshotCountsRequest{})
+		require.NoError(t, err)
+		return res
+	}
+
+	addRewards()
+	ctx = ctx.WithBlockHeight(2)
+	takeSnapshot()
+	require.Equal(t, uint64(1), snapshotCounts().Total)
+
+	// No rewards were distributed since the last snapshot so the new snapshot is skipped
+	ctx = ctx.WithBlockHeight(3)
+	takeSnapshot()
+	require.Equal(t, uint64(1), snapshotCounts().Total)
+
+	// The weight did not change since the last snapshot so the last snapshot is replaced
+	addRewards()
+	ctx = ctx.WithBlockHeight(4)
+	takeSnapshot()
+	res := snapshotCounts()
+	require.Equal(t, uint64(1), res.Total)
+	require.Equal(t, []types.FuryaSnapshotCount{
+		{
+			Denom:             FURYA_TOKEN_DENOM,
+			ValidatorAddress:  valAddr.String(),
+			Count:             1,
+			OldestClaimHeight: 1,
+		},
+	}, res.Counts)
+
+	ctx = ctx.WithBlockHeight(5)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), coins)
+
+	// The snapshot is still referenced by the second delegation
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	asset.RewardWeight = sdk.NewDec(3)
+	app.FuryaKeeper.SetAsset(ctx, asset)
+	addRewards()
+	ctx = ctx.WithBlockHeight(6)
+	takeSnapshot()
+	require.Equal(t, uint64(2), snapshotCounts().Total)
+
+	// Snapshots below the oldest claim height are garbage collected
+	ctx = ctx.WithBlockHeight(7)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err = app.FuryaKeeper.ClaimDelegationRewards(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1500_000))), coins)
+	pruned := app.FuryaKeeper.GarbageCollectWeightChangeSnapshots(ctx, FURYA_TOKEN_DENOM, valAddr)
+	require.Equal(t, uint64(1), pruned)
+	res = snapshotCounts()
+	require.Equal(t, uint64(1), res.Total)
+	require.Equal(t, uint64(5), res.Counts[0].OldestClaimHeight)
+
+	// Snapshots are kept while delegations stored before the claim height index existed are not indexed
+	store := ctx.KVStore(app.FuryaKeeper.StoreKey())
+	var indexKeys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.DelegationClaimHeightIndexKey)
+	for ; iter.Valid(); iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range indexKeys {
+		store.Delete(key)
+	}
+	store.Delete(types.DelegationClaimHeightIndexedKey)
+	pruned = app.FuryaKeeper.GarbageCollectWeightChangeSnapshots(ctx, FURYA_TOKEN_DENOM, valAddr)
+	require.Equal(t, uint64(0), pruned)
+	require.Equal(t, uint64(1), snapshotCounts().Total)
+
+	// The store migration indexes the delegations again
+	err = keeper.NewMigrator(app.FuryaKeeper).Migrate3to4(ctx)
+	require.NoError(t, err)
+	require.True(t, app.FuryaKeeper.IsDelegationClaimHeightIndexed(ctx))
+	pruned = app.FuryaKeeper.GarbageCollectWeightChangeSnapshots(ctx, FURYA_TOKEN_DENOM, valAddr)
+	require.Equal(t, uint64(0), pruned)
+	res = snapshotCounts()
+	require.Equal(t, uint64(1), res.Total)
+	require.Equal(t, uint64(5), res.Counts[0].OldestClaimHeight)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// distributeTakeRate splits the coins deducted by the take rate between the take rate destinations by their weight.
+// The last destination receives what is left after truncation so that the module does not keep any deducted coins.
+// Coins that cannot be sent to their destination are sent to the fee collector instead.
+func (k Keeper) distributeTakeRate(ctx sdk.Context, coins sdk.Coins) error {
+	destinations := k.TakeRateDestinations(ctx)
+	if len(destinations) == 0 {
+		destinations = []types.TakeRateDestination{{
+			Type:   types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR,
+			Weight: sdk.OneDec(),
+		}}
+	}
+
+	event := types.EventFuryaTakeRate{Deducted: coins}
+	remaining := coins
+	for i, destination := range destinations {
+		amount := remaining
+		if i < len(destinations)-1 {
+			amount = sdk.NewCoins()
+			for _, c := range coins {
+				amount = amount.Add(sdk.NewCoin(c.Denom, destination.Weight.MulInt(c.Amount).TruncateInt()))
+			}
+		}
+		remaining = remaining.Sub(amount...)
+		if amount.IsZero() {
+			continue
+		}
+		// Each destination is paid in its own cache context so that an unreachable destination does not halt the chain
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sendTakeRate(cacheCtx, destination, amount); err != nil {
+			k.Logger(ctx).Error("failed to send take rate to its destination, sending it to the fee collector instead",
+				"type", destination.Type.String(), "target", destination.Target, "error", err)
+			destination = types.TakeRateDestination{Type: types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR}
+			if err := k.sendTakeRate(ctx, destination, amount); err != nil {
+				return err
+			}
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+		event.Payouts = append(event.Payouts, types.TakeRatePayout{
+			Type:   destination.Type,
+			Target: destination.Target,
+			Amount: amount,
+		})
+	}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+// ValidateTakeRateDestinations checks that the take rate destinations can receive coins from the module account
+func (k Keeper) ValidateTakeRateDestinations(ctx sdk.Context, destinations []types.TakeRateDestination) error {
+	if err := types.ValidateTakeRateDestinations(destinations); err != nil {
+		return err
+	}
+	for _, destination := range destinations {
+		switch destination.Type {
+		case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT:
+			if k.accountKeeper.GetModuleAddress(destination.Target) == nil {
+				return fmt.Errorf("take rate destination module account %s does not exist", destination.Target)
+			}
+		case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS:
+			addr, err := sdk.AccAddressFromBech32(destination.Target)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(addr) {
+				return fmt.Errorf("take rate destination address %s is not allowed to receive funds", destination.Target)
+			}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) sendTakeRate(ctx sdk.Context, destination types.TakeRateDestination, amount sdk.Coins) error {
+	switch destination.Type {
+	case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, amount)
+	case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL:
+		return k.distributionKeeper.FundCommunityPool(ctx, amount, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_BURN:
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT:
+		// SendCoinsFromModuleToModule panics if the recipient module account does not exist
+		if k.accountKeeper.GetModuleAddress(destination.Target) == nil {
+			return fmt.Errorf("take rate destination module account %s does not exist", destination.Target)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.Target, amount)
+	case types.TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS:
+		addr, err := sdk.AccAddressFromBech32(destination.Target)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount)
+	default:
+		return fmt.Errorf("invalid take rate destination type: %s", destination.Type)
+	}
+}
+
+func (k Keeper) addTakeRateRevenue(ctx sdk.Context, coin sdk.Coin) {
+	revenue := k.GetTakeRateRevenue(ctx, coin.Denom)
+	k.SetTakeRateRevenue(ctx, revenue.Add(coin))
+}
+
+// GetTakeRateRevenue returns the cumulative amount of an asset that was deducted by the take rate
+func (k Keeper) GetTakeRateRevenue(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetTakeRateRevenueKey(denom))
+	if b == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+func (k Keeper) SetTakeRateRevenue(ctx sdk.Context, revenue sdk.Coin) {
+	b, err := revenue.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTakeRateRevenueKey(revenue.Denom), b)
+}
+
+func (k Keeper) IterateTakeRateRevenues(ctx sdk.Context, cb func(revenue sdk.Coin) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TakeRateRevenueKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := types.ParseTakeRateRevenueKey(iter.Key())
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		if cb(sdk.NewCoin(denom, amount)) {
+			return
+		}
+	}
+}
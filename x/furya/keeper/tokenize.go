@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TokenizeDelegation moves delegation shares to the furya module and mints a token that represents them
+// One token is minted for each whole delegation share, fractional shares stay with the delegator
+func (k Keeper) TokenizeDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.FuryaValidator, coin sdk.Coin) (*sdk.Coin, error) {
+	_, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
+	}
+
+	_, found = k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	if !found {
+		return nil, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	// Redelegated tokens can still be slashed from the source validator so they cannot change owner until they mature
+	if k.HasRedelegation(ctx, delAddr, validator.GetOperator(), coin.Denom) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot tokenize a delegation with immature redelegations")
+	}
+
+	tokenDenom := types.GetTokenizedDenom(validator.GetOperator(), coin.Denom)
+	if err := sdk.ValidateDenom(tokenDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot tokenize denom %s: %s", coin.Denom, err)
+	}
+
+	_, err := k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenized, found := k.GetTokenizedDelegation(ctx, tokenDenom)
+	if !found {
+		tokenized = types.TokenizedDelegation{
+			TokenDenom:       tokenDenom,
+			ValidatorAddress: validator.GetOperator().String(),
+			Denom:            coin.Denom,
+			RewardHistory:    types.RewardHistories{},
+		}
+	}
+	// Rewards of the tokenized shares and of the new holder are settled before the token supply changes
+	tokenized, err = k.accrueTokenizedRewards(ctx, tokenized)
+	if err != nil {
+		return nil, err
+	}
+	_, err = k.settleTokenHolderRewards(ctx, delAddr, tokenized)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delegation, validator and asset are queried again since they might have been modified when claiming delegation rewards
+	delegation, _ := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	validator, err = k.GetFuryaValidator(ctx, validator.GetOperator())
+	if err != nil {
+		return nil, err
+	}
+	asset, _ := k.GetAssetByDenom(ctx, coin.Denom)
+
+	shares, err := k.ValidateDelegatedAmount(delegation, coin, validator, asset)
+	if err != nil {
+		return nil, err
+	}
+	shares = shares.TruncateDec()
+	if !shares.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount %s is too small to be tokenized", coin)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	k.moveDelegationShares(ctx, delAddr, moduleAddr, validator, coin, shares, delegation)
+	// Tokenized delegation is stored before the tokens are sent since the transfer settles the rewards from the store
+	k.SetTokenizedDelegation(ctx, tokenized)
+
+	token := sdk.NewCoin(tokenDenom, shares.TruncateInt())
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token))
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(token))
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RedeemTokens burns tokenized shares and moves the shares they represent back into a delegation of the holder
+func (k Keeper) RedeemTokens(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.Coin) (*sdk.Dec, error) {
+	tokenized, found := k.GetTokenizedDelegation(ctx, token.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Denom %s is not a tokenized furya delegation", token.Denom)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(tokenized.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !k.IsAssetAllowed(ctx, valAddr, tokenized.Denom) {
+		return nil, types.ErrAssetNotAllowed.Wrapf("validator %s does not accept %s", valAddr, tokenized.Denom)
+	}
+
+	tokenized, err = k.accrueTokenizedRewards(ctx, tokenized)
+	if err != nil {
+		return nil, err
+	}
+	_, err = k.settleTokenHolderRewards(ctx, delAddr, tokenized)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetTokenizedDelegation(ctx, tokenized)
+
+	_, found = k.GetDelegation(ctx, delAddr, validator, tokenized.Denom)
+	if found {
+		_, err = k.ClaimDelegationRewards(ctx, delAddr, validator, tokenized.Denom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(token))
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token))
+	if err != nil {
+		return nil, err
+	}
+
+	// Validator and module delegation are queried after the transfer since it accrues the rewards of the tokenized shares
+	validator, err = k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	moduleDelegation, found := k.GetDelegation(ctx, moduleAddr, validator, tokenized.Denom)
+	shares := sdk.NewDecFromInt(token.Amount)
+	if !found || moduleDelegation.Shares.LT(shares) {
+		return nil, stakingtypes.ErrInsufficientShares
+	}
+
+	k.moveDelegationShares(ctx, moduleAddr, delAddr, validator, sdk.NewCoin(tokenized.Denom, token.Amount), shares, moduleDelegation)
+	return &shares, nil
+}
+
+// ClaimTokenizedRewards pays the rewards accrued by the tokenized shares held by an account
+func (k Keeper) ClaimTokenizedRewards(ctx sdk.Context, holder sdk.AccAddress, tokenDenom string) (sdk.Coins, error) {
+	tokenized, found := k.GetTokenizedDelegation(ctx, tokenDenom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Denom %s is not a tokenized furya delegation", tokenDenom)
+	}
+	tokenized, err := k.accrueTokenizedRewards(ctx, tokenized)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := k.settleTokenHolderRewards(ctx, holder, tokenized)
+	if err != nil {
+		return nil, err
+	}
+	k.SetTokenizedDelegation(ctx, tokenized)
+	return coins, nil
+}
+
+// BeforeTokenizedSharesTransfer settles the rewards of all accounts involved in a transfer before tokenized shares
+// change owner. It is called by the bank keeper since rewards are tracked per holder.
+func (k Keeper) BeforeTokenizedSharesTransfer(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if !types.IsTokenizedDenom(coin.Denom) {
+			continue
+		}
+		tokenized, found := k.GetTokenizedDelegation(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		tokenized, err := k.accrueTokenizedRewards(ctx, tokenized)
+		if err != nil {
+			return err
+		}
+		for _, addr := range addrs {
+			_, err = k.settleTokenHolderRewards(ctx, addr, tokenized)
+			if err != nil {
+				return err
+			}
+		}
+		k.SetTokenizedDelegation(ctx, tokenized)
+	}
+	return nil
+}
+
+// accrueTokenizedRewards claims the rewards of the shares owned by the furya module and adds them to the reward index
+// of the tokenized delegation. The rewards stay in the rewards pool until holders claim them.
+func (k Keeper) accrueTokenizedRewards(ctx sdk.Context, tokenized types.TokenizedDelegation) (types.TokenizedDelegation, error) {
+	valAddr, err := sdk.ValAddressFromBech32(tokenized.ValidatorAddress)
+	if err != nil {
+		return tokenized, err
+	}
+	validator, err := k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return tokenized, err
+	}
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	delegation, found := k.GetDelegation(ctx, moduleAddr, validator, tokenized.Denom)
+	if !found {
+		return tokenized, nil
+	}
+	asset, found := k.GetAssetByDenom(ctx, tokenized.Denom)
+	if !found {
+		return tokenized, types.ErrUnknownAsset
+	}
+
+	_, err = k.ClaimValidatorRewards(ctx, validator)
+	if err != nil {
+		return tokenized, err
+	}
+	// Validator is queried again since claiming validator rewards updates its reward history
+	validator, err = k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return tokenized, err
+	}
+
+	coins, err := k.accrueDelegationRewards(ctx, &delegation, validator, asset)
+	if err != nil {
+		return tokenized, err
+	}
+	k.SetDelegation(ctx, moduleAddr, valAddr, tokenized.Denom, delegation)
+
+	supply := k.bankKeeper.GetSupply(ctx, tokenized.TokenDenom).Amount
+	if !supply.IsPositive() {
+		return tokenized, nil
+	}
+	rewardHistories := types.NewRewardHistories(tokenized.RewardHistory)
+	for _, c := range coins {
+		rewardHistory, found := rewardHistories.GetIndexByDenom(c.Denom)
+		if !found {
+			rewardHistories = append(rewardHistories, types.RewardHistory{
+				Denom: c.Denom,
+				Index: sdk.NewDecFromInt(c.Amount).QuoInt(supply),
+			})
+		} else {
+			rewardHistory.Index = rewardHistory.Index.Add(sdk.NewDecFromInt(c.Amount).QuoInt(supply))
+		}
+	}
+	tokenized.RewardHistory = rewardHistories
+	return tokenized, nil
+}
+
+// settleTokenHolderRewards pays the rewards accrued by the tokens of a holder since its last settlement
+// and updates the reward index of the holder. Holders without a reward index have nothing to claim.
+func (k Keeper) settleTokenHolderRewards(ctx sdk.Context, holder sdk.AccAddress, tokenized types.TokenizedDelegation) (sdk.Coins, error) {
+	holderHistory, found := k.GetTokenHolderRewardHistory(ctx, tokenized.TokenDenom, holder)
+	if !found {
+		holderHistory = types.TokenHolderRewardHistory{
+			HolderAddress: holder.String(),
+			TokenDenom:    tokenized.TokenDenom,
+			RewardHistory: tokenized.RewardHistory,
+		}
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, holder, tokenized.TokenDenom).Amount
+	rewards := calculateTokenHolderRewards(tokenized, holderHistory, balance)
+	if !rewards.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, k.GetWithdrawAddress(ctx, holder), rewards)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	holderHistory.RewardHistory = tokenized.RewardHistory
+	k.SetTokenHolderRewardHistory(ctx, holder, holderHistory)
+	return rewards, nil
+}
+
+// calculateTokenHolderRewards calculates the rewards accrued by the balance of a holder since its last settlement
+func calculateTokenHolderRewards(tokenized types.TokenizedDelegation, holderHistory types.TokenHolderRewardHistory, balance sdk.Int) sdk.Coins {
+	holderIndices := types.NewRewardHistories(holderHistory.RewardHistory)
+	rewards := sdk.NewCoins()
+	for _, history := range tokenized.RewardHistory {
+		index := sdk.ZeroDec()
+		if holderIndex, found := holderIndices.GetIndexByDenom(history.Denom); found {
+			index = holderIndex.Index
+		}
+		if index.GTE(history.Index) {
+			continue
+		}
+		rewards = rewards.Add(sdk.NewCoin(history.Denom, history.Index.Sub(index).MulInt(balance).TruncateInt()))
+	}
+	return rewards
+}
+
+func (k Keeper) GetTokenizedDelegation(ctx sdk.Context, tokenDenom string) (tokenized types.TokenizedDelegation, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetTokenizedDelegationKey(tokenDenom))
+	if b == nil {
+		return tokenized, false
+	}
+	k.cdc.MustUnmarshal(b, &tokenized)
+	return tokenized, true
+}
+
+func (k Keeper) SetTokenizedDelegation(ctx sdk.Context, tokenized types.TokenizedDelegation) {
+	b := k.cdc.MustMarshal(&tokenized)
+	ctx.KVStore(k.storeKey).Set(types.GetTokenizedDelegationKey(tokenized.TokenDenom), b)
+}
+
+func (k Keeper) IterateTokenizedDelegations(ctx sdk.Context, cb func(tokenized types.TokenizedDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TokenizedDelegationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tokenized types.TokenizedDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &tokenized)
+		if cb(tokenized) {
+			return
+		}
+	}
+}
+
+func (k Keeper) GetTokenHolderRewardHistory(ctx sdk.Context, tokenDenom string, holder sdk.AccAddress) (history types.TokenHolderRewardHistory, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetTokenHolderRewardHistoryKey(tokenDenom, holder))
+	if b == nil {
+		return history, false
+	}
+	k.cdc.MustUnmarshal(b, &history)
+	return history, true
+}
+
+func (k Keeper) SetTokenHolderRewardHistory(ctx sdk.Context, holder sdk.AccAddress, history types.TokenHolderRewardHistory) {
+	b := k.cdc.MustMarshal(&history)
+	ctx.KVStore(k.storeKey).Set(types.GetTokenHolderRewardHistoryKey(history.TokenDenom, holder), b)
+}
+
+func (k Keeper) IterateTokenHolderRewardHistories(ctx sdk.Context, cb func(history types.TokenHolderRewardHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TokenHolderRewardHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var history types.TokenHolderRewardHistory
+		k.cdc.MustUnmarshal(iter.Value(), &history)
+		if cb(history) {
+			return
+		}
+	}
+}
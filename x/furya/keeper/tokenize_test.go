@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizeDelegation(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+	tokenDenom := types.GetTokenizedDenom(valAddr, FURYA_TOKEN_DENOM)
+
+	// Tokenizing requires an existing delegation
+	_, err = app.FuryaKeeper.TokenizeDelegation(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegatorForAddress)
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	assets := app.FuryaKeeper.GetAllAssets(ctx)
+	err = app.FuryaKeeper.RebalanceBondTokenWeights(ctx, assets)
+	require.NoError(t, err)
+
+	// Tokenize half of the delegation
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	totalDelegatorShares := val.TotalDelegatorShares
+	token, err := app.FuryaKeeper.TokenizeDelegation(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(tokenDenom, sdk.NewInt(500_000)), *token)
+	require.Equal(t, *token, app.BankKeeper.GetBalance(ctx, user1, tokenDenom))
+	require.Equal(t, *token, app.BankKeeper.GetSupply(ctx, tokenDenom))
+
+	// Shares are owned by the module and validator shares are not changed
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, totalDelegatorShares, val.TotalDelegatorShares)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	delegation1, found := app.FuryaKeeper.GetDelegation(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)), types.GetDelegationTokens(delegation1, val, asset))
+	moduleDelegation, found := app.FuryaKeeper.GetDelegation(ctx, moduleAddr, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(token.Amount), moduleDelegation.Shares)
+
+	// Transfer to reward pool
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Sending tokens settles the rewards accrued by the sender before the transfer
+	err = app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(sdk.NewCoin(tokenDenom, sdk.NewInt(250_000))))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(500_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+	require.Equal(t, sdk.NewCoin("stake", sdk.NewInt(0)), app.BankKeeper.GetBalance(ctx, user2, "stake"))
+
+	// Holders earn rewards proportionally to their tokens
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimTokenizedRewards(ctx, user2, tokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(250_000))), coins)
+	coins, err = app.FuryaKeeper.ClaimTokenizedRewards(ctx, user2, tokenDenom)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+
+	// Redeeming more tokens than owned fails
+	_, err = app.FuryaKeeper.RedeemTokens(ctx, user2, sdk.NewCoin(tokenDenom, sdk.NewInt(300_000)))
+	require.Error(t, err)
+
+	// Redeeming tokens moves the shares back into a delegation
+	shares, err := app.FuryaKeeper.RedeemTokens(ctx, user2, sdk.NewCoin(tokenDenom, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(250_000), *shares)
+	require.Equal(t, sdk.NewCoin(tokenDenom, sdk.NewInt(250_000)), app.BankKeeper.GetSupply(ctx, tokenDenom))
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, totalDelegatorShares, val.TotalDelegatorShares)
+	delegation2, found := app.FuryaKeeper.GetDelegation(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(250_000)), types.GetDelegationTokens(delegation2, val, asset))
+	moduleDelegation, found = app.FuryaKeeper.GetDelegation(ctx, moduleAddr, val, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(250_000), moduleDelegation.Shares)
+	tokenized, found := app.FuryaKeeper.GetTokenizedDelegation(ctx, tokenDenom)
+	require.True(t, found)
+	holderHistory, found := app.FuryaKeeper.GetTokenHolderRewardHistory(ctx, tokenDenom, user2)
+	require.True(t, found)
+	require.Equal(t, tokenized.RewardHistory, holderHistory.RewardHistory)
+
+	// Rewards of the remaining holder are kept
+	coins, err = app.FuryaKeeper.ClaimTokenizedRewards(ctx, user1, tokenDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(250_000))), coins)
+
+	// Sending tokens to a module account also settles the rewards of the sender
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+	require.NoError(t, err)
+	balance := app.BankKeeper.GetBalance(ctx, user1, "stake")
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, user1, govtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(tokenDenom, sdk.NewInt(250_000))))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user1, "stake").IsGTE(balance.AddAmount(sdk.NewInt(1))))
+	coins, err = app.FuryaKeeper.ClaimTokenizedRewards(ctx, user1, tokenDenom)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+
+	// Tokenized delegations are exported, module accounts that held tokens are tracked like any other holder
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.TokenizedDelegations, 1)
+	require.Equal(t, tokenDenom, genesis.TokenizedDelegations[0].TokenDenom)
+	require.Len(t, genesis.TokenHolderRewardHistories, 4)
+}
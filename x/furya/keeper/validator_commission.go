@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetValidatorCommission sets the commission a validator takes from the furya rewards of its delegators.
+// Similar to the staking commission, the max rate and max change rate are fixed the first time the commission is set
+// and the rate can only be changed once every 24 hours by at most the max change rate.
+func (k Keeper) SetValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, rates stakingtypes.CommissionRates) error {
+	_, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrValidatorNotFound
+	}
+	if err := rates.Validate(); err != nil {
+		return err
+	}
+
+	commission, found := k.GetValidatorCommission(ctx, valAddr)
+	if !found {
+		commission.Commission = stakingtypes.NewCommissionWithTime(rates.Rate, rates.MaxRate, rates.MaxChangeRate, ctx.BlockTime())
+		k.setValidatorCommission(ctx, valAddr, commission)
+		return nil
+	}
+
+	if !rates.MaxRate.Equal(commission.Commission.MaxRate) || !rates.MaxChangeRate.Equal(commission.Commission.MaxChangeRate) {
+		return types.ErrCommissionImmutable
+	}
+	if err := commission.Commission.ValidateNewRate(rates.Rate, ctx.BlockTime()); err != nil {
+		return err
+	}
+	commission.Commission.Rate = rates.Rate
+	commission.Commission.UpdateTime = ctx.BlockTime()
+	k.setValidatorCommission(ctx, valAddr, commission)
+	return nil
+}
+
+// WithdrawValidatorCommission transfers the accrued furya commission of a validator to the withdraw address of the operator
+func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	commission, found := k.GetValidatorCommission(ctx, valAddr)
+	if !found || commission.Accrued.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	coins := commission.Accrued
+	commission.Accrued = sdk.NewCoins()
+	k.setValidatorCommission(ctx, valAddr, commission)
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, k.GetWithdrawAddress(ctx, sdk.AccAddress(valAddr)), coins)
+	if err != nil {
+		return nil, err
+	}
+	return coins, nil
+}
+
+// takeValidatorCommission deducts the validator commission from the rewards and adds it to the accrued commission
+// Returns the remaining rewards that are distributed to the delegators
+func (k Keeper) takeValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, coins sdk.Coins) sdk.Coins {
+	commission, found := k.GetValidatorCommission(ctx, valAddr)
+	if !found || !commission.Commission.Rate.IsPositive() {
+		return coins
+	}
+
+	cut, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(commission.Commission.Rate).TruncateDecimal()
+	if cut.IsZero() {
+		return coins
+	}
+	commission.Accrued = commission.Accrued.Add(cut...)
+	k.setValidatorCommission(ctx, valAddr, commission)
+	return coins.Sub(cut...)
+}
+
+func (k Keeper) setValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission types.ValidatorFuryaCommission) {
+	commission.ValidatorAddress = valAddr.String()
+	ctx.KVStore(k.storeKey).Set(types.GetValidatorCommissionKey(valAddr), k.cdc.MustMarshal(&commission))
+}
+
+func (k Keeper) GetValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (commission types.ValidatorFuryaCommission, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetValidatorCommissionKey(valAddr))
+	if b == nil {
+		return types.ValidatorFuryaCommission{ValidatorAddress: valAddr.String()}, false
+	}
+	k.cdc.MustUnmarshal(b, &commission)
+	return commission, true
+}
+
+func (k Keeper) IterateValidatorCommissions(ctx sdk.Context, cb func(commission types.ValidatorFuryaCommission) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorCommissionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var commission types.ValidatorFuryaCommission
+		k.cdc.MustUnmarshal(iter.Value(), &commission)
+		if cb(commission) {
+			return
+		}
+	}
+}
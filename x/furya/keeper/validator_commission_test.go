@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorCommission(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	operatorAddr := sdk.AccAddress(valAddr)
+	operatorBalance := app.BankKeeper.GetBalance(ctx, operatorAddr, "stake")
+
+	// Only existing validators can set a commission
+	rates := stakingtypes.NewCommissionRates(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.01"))
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, sdk.ValAddress(user1), rates)
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, rates)
+	require.NoError(t, err)
+
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// Commission is taken before the rewards are distributed to the delegators
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	err = app.FuryaKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	commission, found := app.FuryaKeeper.GetValidatorCommission(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100_000))), commission.Accrued)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	coins, err := app.FuryaKeeper.ClaimDelegationRewards(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(900_000))), coins)
+
+	// The rate can only be changed once a day
+	rates.Rate = sdk.MustNewDecFromStr("0.11")
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, rates)
+	require.ErrorIs(t, err, stakingtypes.ErrCommissionUpdateTime)
+
+	// The rate can only be changed by the max change rate
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	rates.Rate = sdk.MustNewDecFromStr("0.12")
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, rates)
+	require.ErrorIs(t, err, stakingtypes.ErrCommissionGTMaxChangeRate)
+
+	// The max rate cannot be changed
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, stakingtypes.NewCommissionRates(sdk.MustNewDecFromStr("0.11"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.01")))
+	require.ErrorIs(t, err, types.ErrCommissionImmutable)
+
+	rates.Rate = sdk.MustNewDecFromStr("0.11")
+	err = app.FuryaKeeper.SetValidatorCommission(ctx, valAddr, rates)
+	require.NoError(t, err)
+	commission, _ = app.FuryaKeeper.GetValidatorCommission(ctx, valAddr)
+	require.Equal(t, sdk.MustNewDecFromStr("0.11"), commission.Commission.Rate)
+	require.Equal(t, ctx.BlockTime(), commission.Commission.UpdateTime)
+
+	// Commission is exported
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ValidatorCommissions, 1)
+
+	// Accrued commission is withdrawn to the operator account
+	coins, err = app.FuryaKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100_000))), coins)
+	require.Equal(t, operatorBalance.AddAmount(sdk.NewInt(100_000)), app.BankKeeper.GetBalance(ctx, operatorAddr, "stake"))
+	commission, _ = app.FuryaKeeper.GetValidatorCommission(ctx, valAddr)
+	require.True(t, commission.Accrued.IsZero())
+	coins, err = app.FuryaKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+}
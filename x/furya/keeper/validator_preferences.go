@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetValidatorPreferences updates the furya assets that can be delegated to a validator.
+// Delegations of assets that are no longer allowed are queued to be force undelegated at the end of the block.
+func (k Keeper) SetValidatorPreferences(ctx sdk.Context, valAddr sdk.ValAddress, allowedDenoms []string, blockedDenoms []string) error {
+	_, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrValidatorNotFound
+	}
+
+	oldPreferences, _ := k.GetValidatorPreferences(ctx, valAddr)
+	newPreferences := types.ValidatorFuryaPreferences{
+		ValidatorAddress: valAddr.String(),
+		AllowedDenoms:    allowedDenoms,
+		BlockedDenoms:    blockedDenoms,
+	}
+
+	k.setValidatorPreferences(ctx, valAddr, newPreferences)
+
+	for _, asset := range k.GetAllAssets(ctx) {
+		if oldPreferences.IsDenomAllowed(asset.Denom) && !newPreferences.IsDenomAllowed(asset.Denom) {
+			k.setForceUndelegation(ctx, valAddr, asset.Denom, []byte{})
+		}
+	}
+	return nil
+}
+
+// setForceUndelegation queues the delegations of a denom to a validator to be force undelegated,
+// starting from the delegation stored at nextKey or from the first one if nextKey is empty
+func (k Keeper) setForceUndelegation(ctx sdk.Context, valAddr sdk.ValAddress, denom string, nextKey []byte) {
+	// Store values cannot be nil and empty bytes are decoded as nil from genesis
+	if nextKey == nil {
+		nextKey = []byte{}
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetForceUndelegationQueueKey(valAddr, denom), nextKey)
+}
+
+func (k Keeper) IterateForceUndelegations(ctx sdk.Context, cb func(valAddr sdk.ValAddress, denom string, nextKey []byte) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ForceUndelegationQueueKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		valAddr, denom := types.ParseForceUndelegationQueueKey(iter.Key())
+		if cb(valAddr, denom, iter.Value()) {
+			return
+		}
+	}
+}
+
+func (k Keeper) setValidatorPreferences(ctx sdk.Context, valAddr sdk.ValAddress, preferences types.ValidatorFuryaPreferences) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorPreferencesKey(valAddr)
+	if len(preferences.AllowedDenoms) == 0 && len(preferences.BlockedDenoms) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&preferences))
+}
+
+func (k Keeper) GetValidatorPreferences(ctx sdk.Context, valAddr sdk.ValAddress) (preferences types.ValidatorFuryaPreferences, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetValidatorPreferencesKey(valAddr))
+	if b == nil {
+		return types.ValidatorFuryaPreferences{ValidatorAddress: valAddr.String()}, false
+	}
+	k.cdc.MustUnmarshal(b, &preferences)
+	return preferences, true
+}
+
+func (k Keeper) IterateValidatorPreferences(ctx sdk.Context, cb func(preferences types.ValidatorFuryaPreferences) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorPreferencesKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var preferences types.ValidatorFuryaPreferences
+		k.cdc.MustUnmarshal(iter.Value(), &preferences)
+		if cb(preferences) {
+			return
+		}
+	}
+}
+
+// IsAssetAllowed returns false if the validator does not accept delegations of the furya asset
+func (k Keeper) IsAssetAllowed(ctx sdk.Context, valAddr sdk.ValAddress, denom string) bool {
+	preferences, found := k.GetValidatorPreferences(ctx, valAddr)
+	return !found || preferences.IsDenomAllowed(denom)
+}
+
+// forceUndelegationsPerBlock limits the delegations visited by the force undelegation hook in a single block
+const forceUndelegationsPerBlock = 100
+
+// ForceUndelegationHook undelegates the delegations of assets that were blocked by validators,
+// visiting at most forceUndelegationsPerBlock delegations per block.
+func (k Keeper) ForceUndelegationHook(ctx sdk.Context) {
+	k.ForceUndelegate(ctx, forceUndelegationsPerBlock)
+}
+
+// ForceUndelegate undelegates up to limit delegations of assets that were blocked by validators. Delegations are found
+// through the claim height index of the validator and denom. Each queue entry stores the index key to resume from and
+// is removed once all delegations of the validator and denom were visited.
+// Tokenized delegations owned by the furya module are not undelegated since they back tokens held by other accounts.
+func (k Keeper) ForceUndelegate(ctx sdk.Context, limit uint64) (undelegated uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var queueKeys, cursors [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.ForceUndelegationQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		queueKeys = append(queueKeys, iter.Key())
+		cursors = append(cursors, iter.Value())
+	}
+	iter.Close()
+	if len(queueKeys) == 0 {
+		return 0
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	var visited uint64
+	for i, queueKey := range queueKeys {
+		if visited >= limit {
+			break
+		}
+		valAddr, denom := types.ParseForceUndelegationQueueKey(queueKey)
+		prefix := types.GetDelegationClaimHeightIndexPrefix(denom, valAddr)
+		start := prefix
+		if len(cursors[i]) > 0 {
+			start = cursors[i]
+		}
+
+		// Delegations are collected first since undelegating them writes to the store
+		var delegations []types.Delegation
+		indexIter := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+		for ; indexIter.Valid() && visited < limit; indexIter.Next() {
+			visited++
+			_, _, _, delAddr := types.ParseDelegationClaimHeightIndexKey(indexIter.Key())
+			if delAddr.Equals(moduleAddr) {
+				continue
+			}
+			b := store.Get(types.GetDelegationKey(delAddr, valAddr, denom))
+			if b == nil {
+				continue
+			}
+			var d types.Delegation
+			k.cdc.MustUnmarshal(b, &d)
+			delegations = append(delegations, d)
+		}
+		var nextKey []byte
+		if indexIter.Valid() {
+			nextKey = indexIter.Key()
+		}
+		indexIter.Close()
+
+		if nextKey != nil {
+			store.Set(queueKey, nextKey)
+		} else {
+			store.Delete(queueKey)
+		}
+		undelegated += k.forceUndelegateAll(ctx, delegations)
+	}
+	if undelegated > 0 {
+		k.QueueAssetRebalanceEvent(ctx)
+	}
+	return undelegated
+}
+
+func (k Keeper) forceUndelegateAll(ctx sdk.Context, delegations []types.Delegation) (undelegated uint64) {
+	for _, d := range delegations {
+		// Each delegation is undelegated in its own cache context so that a single failure does not halt the others
+		cacheCtx, write := ctx.CacheContext()
+		coin, completionTime, err := k.forceUndelegate(cacheCtx, d)
+		if err != nil {
+			k.Logger(ctx).Error("failed to force undelegate furya delegation",
+				"delegator", d.DelegatorAddress, "validator", d.ValidatorAddress, "denom", d.Denom, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if coin.IsZero() {
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeForceUndelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		))
+		undelegated++
+	}
+	return undelegated
+}
+
+func (k Keeper) forceUndelegate(ctx sdk.Context, d types.Delegation) (sdk.Coin, *time.Time, error) {
+	delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	validator, err := k.GetFuryaValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	asset, found := k.GetAssetByDenom(ctx, d.Denom)
+	if !found {
+		return sdk.Coin{}, nil, types.ErrUnknownAsset
+	}
+
+	coin := types.GetDelegationTokens(d, validator, asset)
+	if coin.IsZero() {
+		return coin, nil, nil
+	}
+	completionTime, err := k.undelegate(ctx, delAddr, validator, coin)
+	return coin, completionTime, err
+}
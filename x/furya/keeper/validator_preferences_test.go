@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	test_helpers "github.com/furya-official/furya/app"
+	"github.com/furya-official/furya/x/furya/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorPreferences(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewFuryaAsset(FURYA_2_TOKEN_DENOM, sdk.NewDec(10), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr2 := sdk.ValAddress(addrs[0])
+	_val2 := teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	user1 := addrs[1]
+	user2 := addrs[2]
+
+	// Only existing validators can set preferences
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, sdk.ValAddress(user1), nil, []string{FURYA_TOKEN_DENOM})
+	require.ErrorIs(t, err, types.ErrValidatorNotFound)
+
+	val1, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	val2, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val2, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+
+	// Validator 1 blocks the first asset
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr1, nil, []string{FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.ErrorIs(t, err, types.ErrAssetNotAllowed)
+	val2, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Redelegate(ctx, user2, val2, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.ErrorIs(t, err, types.ErrAssetNotAllowed)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val1, sdk.NewCoin(FURYA_2_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.NoError(t, err)
+
+	// Existing delegations of the blocked asset are undelegated at the end of the block
+	app.FuryaKeeper.ForceUndelegationHook(ctx)
+	val1, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, found := app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, user1, val1, FURYA_2_TOKEN_DENOM)
+	require.True(t, found)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, user2, val2, FURYA_TOKEN_DENOM)
+	require.True(t, found)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(500_000), asset.TotalTokens)
+
+	// Forced undelegations cannot be cancelled
+	completionTime := ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx))
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)), completionTime)
+	require.ErrorIs(t, err, types.ErrAssetNotAllowed)
+
+	// Validator 2 only allows the second asset
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr2, []string{FURYA_2_TOKEN_DENOM}, nil)
+	require.NoError(t, err)
+	app.FuryaKeeper.ForceUndelegationHook(ctx)
+	val2, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, user2, val2, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	asset, _ = app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.True(t, asset.TotalTokens.IsZero())
+
+	// Preferences are exported
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ValidatorPreferences, 2)
+
+	// Removing the preferences allows all assets again
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr1, nil, nil)
+	require.NoError(t, err)
+	_, found = app.FuryaKeeper.GetValidatorPreferences(ctx, valAddr1)
+	require.False(t, found)
+	_, err = app.FuryaKeeper.CancelUndelegation(ctx, user1, val1, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(500_000)), completionTime)
+	require.NoError(t, err)
+}
+
+func TestForceUndelegationLimits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.FuryaKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.FuryaAsset{
+			types.NewFuryaAsset(FURYA_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	// Accounts
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+
+	val, err := app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(400_000)))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.Delegate(ctx, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	token, err := app.FuryaKeeper.TokenizeDelegation(ctx, user1, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(300_000)))
+	require.NoError(t, err)
+
+	// Blocked assets cannot be transferred or redeemed into delegations
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr, nil, []string{FURYA_TOKEN_DENOM})
+	require.NoError(t, err)
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.TransferDelegation(ctx, user1, user2, val, sdk.NewCoin(FURYA_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.ErrorIs(t, err, types.ErrAssetNotAllowed)
+	_, err = app.FuryaKeeper.RedeemTokens(ctx, user1, *token)
+	require.ErrorIs(t, err, types.ErrAssetNotAllowed)
+
+	// Delegations are force undelegated a limited number at a time, the tokenized delegation is visited but kept
+	undelegated := app.FuryaKeeper.ForceUndelegate(ctx, 2)
+	require.LessOrEqual(t, undelegated, uint64(2))
+
+	// The queue entry and the delegation to resume from are exported
+	genesis := app.FuryaKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ForceUndelegations, 1)
+	require.Equal(t, valAddr.String(), genesis.ForceUndelegations[0].ValidatorAddress)
+	require.Equal(t, FURYA_TOKEN_DENOM, genesis.ForceUndelegations[0].Denom)
+	require.NotEmpty(t, genesis.ForceUndelegations[0].NextKey)
+
+	undelegated += app.FuryaKeeper.ForceUndelegate(ctx, 2)
+	require.Equal(t, uint64(2), undelegated)
+	require.Empty(t, app.FuryaKeeper.ExportGenesis(ctx).ForceUndelegations)
+
+	// Nothing is rebalanced when no delegation was undelegated
+	app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx)
+	require.Equal(t, uint64(0), app.FuryaKeeper.ForceUndelegate(ctx, 2))
+	require.False(t, app.FuryaKeeper.ConsumeAssetRebalanceEvent(ctx))
+	val, err = app.FuryaKeeper.GetFuryaValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, found := app.FuryaKeeper.GetDelegation(ctx, user1, val, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	_, found = app.FuryaKeeper.GetDelegation(ctx, user2, val, FURYA_TOKEN_DENOM)
+	require.False(t, found)
+	asset, _ := app.FuryaKeeper.GetAssetByDenom(ctx, FURYA_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(300_000), asset.TotalTokens)
+
+	// Tokens are redeemed once the asset is allowed again
+	err = app.FuryaKeeper.SetValidatorPreferences(ctx, valAddr, nil, nil)
+	require.NoError(t, err)
+	_, err = app.FuryaKeeper.RedeemTokens(ctx, user1, *token)
+	require.NoError(t, err)
+}
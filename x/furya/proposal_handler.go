@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 func NewFuryaProposalHandler(k keeper.Keeper) govtypes.Handler {
@@ -24,3 +25,34 @@ func NewFuryaProposalHandler(k keeper.Keeper) govtypes.Handler {
 		}
 	}
 }
+
+// NewParamChangeProposalHandler wraps the params proposal handler to check that changed take rate destinations
+// can receive coins, which depends on the accounts of the chain and cannot be checked by the param validation
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok || !changesTakeRateDestinations(c) {
+			return handler(ctx, content)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := handler(cacheCtx, content); err != nil {
+			return err
+		}
+		if err := k.ValidateTakeRateDestinations(cacheCtx, k.TakeRateDestinations(cacheCtx)); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+}
+
+func changesTakeRateDestinations(c *paramproposal.ParameterChangeProposal) bool {
+	for _, change := range c.Changes {
+		if change.Subspace == types.ModuleName && change.Key == string(types.TakeRateDestinations) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,26 @@ func genTakeRateClaimInterval(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 60*60)) * time.Second
 }
 
+func genAutoCompoundInterval(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 60*60*24)) * time.Second
+}
+
+func genPowerShare(r *rand.Rand) sdk.Dec {
+	return simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("0.5"))
+}
+
+func genRewardIndexSampleInterval(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24)) * time.Second
+}
+
+func genRewardIndexSampleSize(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 0, 50))
+}
+
+func genMaxPriceAge(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60)) * time.Second
+}
+
 func genNumOfFuryaAssets(r *rand.Rand) int {
 	return simulation.RandIntBetween(r, 0, 50)
 }
@@ -48,6 +68,21 @@ func RandomizedGenesisState(simState *module.SimulationState) {
 			RewardDelayTime:       rewardDelayTime,
 			TakeRateClaimInterval: rewardClaimInterval,
 			LastTakeRateClaimTime: simState.GenTimestamp,
+			AutoCompoundInterval:  genAutoCompoundInterval(r),
+			LastAutoCompoundTime:  simState.GenTimestamp,
+
+			MaxValidatorFuryaPowerShare: genPowerShare(r),
+			MaxFuryaPowerShare:          genPowerShare(r),
+
+			RewardIndexSampleInterval: genRewardIndexSampleInterval(r),
+			RewardIndexSampleSize:     genRewardIndexSampleSize(r),
+
+			PriceFeeders: []string{},
+			MaxPriceAge:  genMaxPriceAge(r),
+
+			IncentiveCreationFee:     sdk.Coins{},
+			MaxActiveIncentives:      100,
+			MaxIncentiveRewardDenoms: 3,
 		},
 		Assets: furyaAssets,
 	}
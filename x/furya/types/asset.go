@@ -2,7 +2,9 @@ package types
 
 import (
 	cosmosmath "cosmossdk.io/math"
+	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"sort"
 	"time"
 )
 
@@ -57,3 +59,195 @@ func GetValidatorShares(asset FuryaAsset, token cosmosmath.Int) sdk.Dec {
 func (a FuryaAsset) HasPositiveDecay() bool {
 	return a.RewardChangeInterval > 0 && a.RewardChangeRate.IsPositive()
 }
+
+// RemainingCapacity returns the amount that can still be delegated before the asset reaches its max total tokens.
+// It returns nil when the asset has no cap.
+func (a FuryaAsset) RemainingCapacity() *cosmosmath.Int {
+	if a.MaxTotalTokens == nil {
+		return nil
+	}
+	remaining := a.MaxTotalTokens.Sub(a.TotalTokens)
+	if remaining.IsNegative() {
+		remaining = sdk.ZeroInt()
+	}
+	return &remaining
+}
+
+// ValidateMinDelegationAmount returns an error if amount is smaller than the minimum delegation amount of the asset
+func (a FuryaAsset) ValidateMinDelegationAmount(amount cosmosmath.Int) error {
+	if a.MinDelegationAmount != nil && amount.LT(*a.MinDelegationAmount) {
+		return ErrBelowMinDelegation.Wrapf("%s%s is less than %s%s", amount, a.Denom, a.MinDelegationAmount, a.Denom)
+	}
+	return nil
+}
+
+// ValidateCapacity returns an error if delegating amount would take the asset over its max total tokens
+func (a FuryaAsset) ValidateCapacity(amount cosmosmath.Int) error {
+	remaining := a.RemainingCapacity()
+	if remaining != nil && amount.GT(*remaining) {
+		return ErrAssetCapacityExceeded.Wrapf("%s%s is more than the remaining %s%s", amount, a.Denom, remaining, a.Denom)
+	}
+	return nil
+}
+
+// Validate checks that the peg can only set positive reward weights
+func (p RewardWeightPricePeg) Validate() error {
+	if p.TargetValueRatio.IsNil() || !p.TargetValueRatio.IsPositive() {
+		return fmt.Errorf("target value ratio must be a positive number")
+	}
+	if p.MinWeight.IsNil() || !p.MinWeight.IsPositive() {
+		return fmt.Errorf("min weight must be a positive number")
+	}
+	if p.MaxWeight.IsNil() || p.MaxWeight.LT(p.MinWeight) {
+		return fmt.Errorf("max weight must be more or equals to the min weight")
+	}
+	return nil
+}
+
+// RewardWeight returns the reward weight pegged to the value of the asset relative to the native token,
+// clamped to the bounds of the peg
+func (p RewardWeightPricePeg) RewardWeight(assetPrice sdk.Dec, nativePrice sdk.Dec) sdk.Dec {
+	weight := p.TargetValueRatio.Mul(assetPrice).Quo(nativePrice)
+	if weight.LT(p.MinWeight) {
+		return p.MinWeight
+	}
+	if weight.GT(p.MaxWeight) {
+		return p.MaxWeight
+	}
+	return weight
+}
+
+// ValidateRewardWeightBounds checks that the reward weight bounds are positive and that the floor is not above the ceiling
+func ValidateRewardWeightBounds(minRewardWeight, maxRewardWeight *sdk.Dec) error {
+	if minRewardWeight != nil && !minRewardWeight.IsPositive() {
+		return fmt.Errorf("min reward weight must be a positive number")
+	}
+	if maxRewardWeight != nil && !maxRewardWeight.IsPositive() {
+		return fmt.Errorf("max reward weight must be a positive number")
+	}
+	if minRewardWeight != nil && maxRewardWeight != nil && maxRewardWeight.LT(*minRewardWeight) {
+		return fmt.Errorf("max reward weight must be more or equals to the min reward weight")
+	}
+	return nil
+}
+
+// ClampRewardWeight returns the weight limited to the reward weight bounds of the asset
+func (a FuryaAsset) ClampRewardWeight(weight sdk.Dec) sdk.Dec {
+	if a.MinRewardWeight != nil && weight.LT(*a.MinRewardWeight) {
+		return *a.MinRewardWeight
+	}
+	if a.MaxRewardWeight != nil && weight.GT(*a.MaxRewardWeight) {
+		return *a.MaxRewardWeight
+	}
+	return weight
+}
+
+// ValidateSlashMultiplier checks that the slash multiplier is not negative
+func ValidateSlashMultiplier(slashMultiplier *sdk.Dec) error {
+	if slashMultiplier != nil && (slashMultiplier.IsNil() || slashMultiplier.IsNegative()) {
+		return fmt.Errorf("slash multiplier must be more or equals to 0")
+	}
+	return nil
+}
+
+// SlashFraction returns the fraction delegations of the asset are slashed by when a validator is slashed by the fraction.
+// The slash multiplier of the asset is applied to the fraction which is capped at slashing all delegations.
+func (a FuryaAsset) SlashFraction(fraction sdk.Dec) sdk.Dec {
+	if a.SlashMultiplier == nil {
+		return fraction
+	}
+	return sdk.MinDec(fraction.Mul(*a.SlashMultiplier), sdk.OneDec())
+}
+
+// RewardWeightBoundReached returns true if the reward change rate cannot move the reward weight any further
+// because it already is at the bound it is heading to
+func (a FuryaAsset) RewardWeightBoundReached() bool {
+	if a.RewardChangeRate.LT(sdk.OneDec()) {
+		return a.MinRewardWeight != nil && a.RewardWeight.LTE(*a.MinRewardWeight)
+	}
+	if a.RewardChangeRate.GT(sdk.OneDec()) {
+		return a.MaxRewardWeight != nil && a.RewardWeight.GTE(*a.MaxRewardWeight)
+	}
+	return false
+}
+
+// ProjectRewardWeights returns up to limit reward weights the asset will have at its next scheduled reward changes.
+// The projection stops once a reward weight bound is reached.
+func (a FuryaAsset) ProjectRewardWeights(limit int) (projections []ProjectedRewardWeight) {
+	if a.PricePeg != nil || a.RewardWeightSchedule != nil || !a.HasPositiveDecay() || a.RewardChangeRate.Equal(sdk.OneDec()) {
+		return projections
+	}
+	for len(projections) < limit && !a.RewardWeightBoundReached() {
+		a.RewardWeight = a.ClampRewardWeight(a.RewardWeight.Mul(a.RewardChangeRate))
+		a.LastRewardChangeTime = a.LastRewardChangeTime.Add(a.RewardChangeInterval)
+		projections = append(projections, ProjectedRewardWeight{
+			Time:         a.LastRewardChangeTime,
+			RewardWeight: a.RewardWeight,
+		})
+	}
+	return projections
+}
+
+// Validate checks that the breakpoints are ordered in time and only set positive reward weights
+func (s RewardWeightSchedule) Validate() error {
+	if len(s.Breakpoints) == 0 {
+		return fmt.Errorf("schedule must have at least one breakpoint")
+	}
+	switch s.Interpolation {
+	case RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_STEP:
+	case RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR:
+		if s.UpdateInterval <= 0 {
+			return fmt.Errorf("update interval must be positive for linear schedules")
+		}
+	default:
+		return fmt.Errorf("unknown interpolation %s", s.Interpolation)
+	}
+	for i, breakpoint := range s.Breakpoints {
+		if breakpoint.RewardWeight.IsNil() || !breakpoint.RewardWeight.IsPositive() {
+			return fmt.Errorf("reward weight of breakpoint %d must be a positive number", i)
+		}
+		if i > 0 && !breakpoint.Time.After(s.Breakpoints[i-1].Time) {
+			return fmt.Errorf("breakpoint %d must be after the previous breakpoint", i)
+		}
+	}
+	return nil
+}
+
+// nextBreakpoint returns the index of the first breakpoint after t
+func (s RewardWeightSchedule) nextBreakpoint(t time.Time) int {
+	return sort.Search(len(s.Breakpoints), func(i int) bool {
+		return s.Breakpoints[i].Time.After(t)
+	})
+}
+
+// RewardWeightAt returns the scheduled reward weight at t. It returns false before the first breakpoint.
+func (s RewardWeightSchedule) RewardWeightAt(t time.Time) (sdk.Dec, bool) {
+	next := s.nextBreakpoint(t)
+	if next == 0 {
+		return sdk.Dec{}, false
+	}
+	current := s.Breakpoints[next-1]
+	if s.Interpolation != RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR || next == len(s.Breakpoints) {
+		return current.RewardWeight, true
+	}
+	elapsed := t.Sub(current.Time)
+	total := s.Breakpoints[next].Time.Sub(current.Time)
+	change := s.Breakpoints[next].RewardWeight.Sub(current.RewardWeight).MulInt64(int64(elapsed)).QuoInt64(int64(total))
+	return current.RewardWeight.Add(change), true
+}
+
+// NextUpdateTime returns when the reward weight has to be updated next after t.
+// It returns false once the last breakpoint has been reached.
+func (s RewardWeightSchedule) NextUpdateTime(t time.Time) (time.Time, bool) {
+	next := s.nextBreakpoint(t)
+	if next == len(s.Breakpoints) {
+		return time.Time{}, false
+	}
+	nextTime := s.Breakpoints[next].Time
+	if s.Interpolation == RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR && next > 0 {
+		if updateTime := t.Add(s.UpdateInterval); updateTime.Before(nextTime) {
+			return updateTime, true
+		}
+	}
+	return nextTime, true
+}
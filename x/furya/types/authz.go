@@ -0,0 +1,153 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration matches the gas charged by the staking StakeAuthorization for every list entry that is checked
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &FuryaStakeAuthorization{}
+
+// NewFuryaStakeAuthorization creates a new FuryaStakeAuthorization. Only one of the allowed and denied validator lists can be set.
+func NewFuryaStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, allowedDenoms []string, maxTokens sdk.Coins, authzType FuryaAuthorizationType) (*FuryaStakeAuthorization, error) {
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot set both allowed & deny list")
+	}
+
+	a := FuryaStakeAuthorization{
+		MaxTokens:         maxTokens,
+		AllowedDenoms:     allowedDenoms,
+		AuthorizationType: authzType,
+	}
+	if len(allowed) > 0 {
+		a.Validators = &FuryaStakeAuthorization_AllowList{AllowList: &FuryaStakeAuthorization_Validators{Address: valAddressesToStrings(allowed)}}
+	} else if len(denied) > 0 {
+		a.Validators = &FuryaStakeAuthorization_DenyList{DenyList: &FuryaStakeAuthorization_Validators{Address: valAddressesToStrings(denied)}}
+	}
+	return &a, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FuryaStakeAuthorization) MsgTypeURL() string {
+	authzType, err := normalizeAuthzType(a.AuthorizationType)
+	if err != nil {
+		panic(err)
+	}
+	return authzType
+}
+
+func (a FuryaStakeAuthorization) ValidateBasic() error {
+	if _, err := normalizeAuthzType(a.AuthorizationType); err != nil {
+		return err
+	}
+	if err := a.MaxTokens.Validate(); err != nil {
+		return sdkerrors.Wrapf(authz.ErrNegativeMaxTokens, "invalid max tokens %v: %s", a.MaxTokens, err)
+	}
+	for _, denom := range a.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid allowed denom: %s", err)
+		}
+	}
+	for _, validators := range [][]string{a.GetAllowList().GetAddress(), a.GetDenyList().GetAddress()} {
+		for _, validator := range validators {
+			if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address %s: %s", validator, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Accept implements Authorization.Accept.
+// The max tokens of the used denom are decreased by the amount of the message. The authorization is deleted once all
+// max tokens are used up.
+func (a FuryaStakeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var validatorAddress string
+	var amount sdk.Coin
+
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgUndelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgRedelegate:
+		validatorAddress = msg.ValidatorDstAddress
+		amount = msg.Amount
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	isValidatorExists := false
+	allowedList := a.GetAllowList().GetAddress()
+	for _, validator := range allowedList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "furya stake authorization")
+		if validator == validatorAddress {
+			isValidatorExists = true
+			break
+		}
+	}
+
+	for _, validator := range a.GetDenyList().GetAddress() {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "furya stake authorization")
+		if validator == validatorAddress {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validator)
+		}
+	}
+
+	if len(allowedList) > 0 && !isValidatorExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	isDenomAllowed := len(a.AllowedDenoms) == 0
+	for _, denom := range a.AllowedDenoms {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "furya stake authorization")
+		if denom == amount.Denom {
+			isDenomAllowed = true
+			break
+		}
+	}
+	if !isDenomAllowed {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot delegate/undelegate %s", amount.Denom)
+	}
+
+	if a.MaxTokens.Empty() {
+		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+	}
+
+	limitLeft, isNegative := a.MaxTokens.SafeSub(amount)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("%s exceeds the max tokens of %s", amount, a.MaxTokens)
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	a.MaxTokens = limitLeft
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+func valAddressesToStrings(valAddrs []sdk.ValAddress) []string {
+	addresses := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		addresses[i] = valAddr.String()
+	}
+	return addresses
+}
+
+// Normalized Msg type URLs
+func normalizeAuthzType(authzType FuryaAuthorizationType) (string, error) {
+	switch authzType {
+	case FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_DELEGATE:
+		return sdk.MsgTypeURL(&MsgDelegate{}), nil
+	case FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNDELEGATE:
+		return sdk.MsgTypeURL(&MsgUndelegate{}), nil
+	case FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_REDELEGATE:
+		return sdk.MsgTypeURL(&MsgRedelegate{}), nil
+	default:
+		return "", sdkerrors.Wrapf(authz.ErrUnknownAuthorizationType, "cannot normalize authz type with %T", authzType)
+	}
+}
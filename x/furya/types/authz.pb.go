@@ -0,0 +1,856 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FuryaAuthorizationType defines the furya message that is authorized by a FuryaStakeAuthorization
+type FuryaAuthorizationType int32
+
+const (
+	FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNSPECIFIED FuryaAuthorizationType = 0
+	// FURYA_AUTHORIZATION_TYPE_DELEGATE authorizes MsgDelegate
+	FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_DELEGATE FuryaAuthorizationType = 1
+	// FURYA_AUTHORIZATION_TYPE_UNDELEGATE authorizes MsgUndelegate
+	FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNDELEGATE FuryaAuthorizationType = 2
+	// FURYA_AUTHORIZATION_TYPE_REDELEGATE authorizes MsgRedelegate
+	FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_REDELEGATE FuryaAuthorizationType = 3
+)
+
+var FuryaAuthorizationType_name = map[int32]string{
+	0: "FURYA_AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "FURYA_AUTHORIZATION_TYPE_DELEGATE",
+	2: "FURYA_AUTHORIZATION_TYPE_UNDELEGATE",
+	3: "FURYA_AUTHORIZATION_TYPE_REDELEGATE",
+}
+
+var FuryaAuthorizationType_value = map[string]int32{
+	"FURYA_AUTHORIZATION_TYPE_UNSPECIFIED": 0,
+	"FURYA_AUTHORIZATION_TYPE_DELEGATE":    1,
+	"FURYA_AUTHORIZATION_TYPE_UNDELEGATE":  2,
+	"FURYA_AUTHORIZATION_TYPE_REDELEGATE":  3,
+}
+
+func (x FuryaAuthorizationType) String() string {
+	return proto.EnumName(FuryaAuthorizationType_name, int32(x))
+}
+
+func (FuryaAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f2f7e296d99447f0, []int{0}
+}
+
+// FuryaStakeAuthorization defines an authorization for furya delegate, undelegate and redelegate messages
+type FuryaStakeAuthorization struct {
+	// max_tokens is the remaining amount per denom that can be used by the grantee. When it is empty, there is no limit.
+	// When it is not empty, only the listed denoms can be used and the authorization is removed once all of them are used up.
+	MaxTokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_tokens,json=maxTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_tokens"`
+	// allowed_denoms restricts the furya assets that can be used. When it is empty, all denoms are allowed.
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// validators is the oneof that represents either allow_list or deny_list
+	//
+	// Types that are valid to be assigned to Validators:
+	//	*FuryaStakeAuthorization_AllowList
+	//	*FuryaStakeAuthorization_DenyList
+	Validators        isFuryaStakeAuthorization_Validators `protobuf_oneof:"validators"`
+	AuthorizationType FuryaAuthorizationType               `protobuf:"varint,5,opt,name=authorization_type,json=authorizationType,proto3,enum=furya.furya.FuryaAuthorizationType" json:"authorization_type,omitempty"`
+}
+
+func (m *FuryaStakeAuthorization) Reset()         { *m = FuryaStakeAuthorization{} }
+func (m *FuryaStakeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FuryaStakeAuthorization) ProtoMessage()    {}
+func (*FuryaStakeAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2f7e296d99447f0, []int{0}
+}
+func (m *FuryaStakeAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaStakeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaStakeAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FuryaStakeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaStakeAuthorization.Merge(m, src)
+}
+func (m *FuryaStakeAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaStakeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaStakeAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FuryaStakeAuthorization proto.InternalMessageInfo
+
+type isFuryaStakeAuthorization_Validators interface {
+	isFuryaStakeAuthorization_Validators()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FuryaStakeAuthorization_AllowList struct {
+	AllowList *FuryaStakeAuthorization_Validators `protobuf:"bytes,3,opt,name=allow_list,json=allowList,proto3,oneof" json:"allow_list,omitempty"`
+}
+type FuryaStakeAuthorization_DenyList struct {
+	DenyList *FuryaStakeAuthorization_Validators `protobuf:"bytes,4,opt,name=deny_list,json=denyList,proto3,oneof" json:"deny_list,omitempty"`
+}
+
+func (*FuryaStakeAuthorization_AllowList) isFuryaStakeAuthorization_Validators() {}
+func (*FuryaStakeAuthorization_DenyList) isFuryaStakeAuthorization_Validators()  {}
+
+func (m *FuryaStakeAuthorization) GetValidators() isFuryaStakeAuthorization_Validators {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *FuryaStakeAuthorization) GetMaxTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
+func (m *FuryaStakeAuthorization) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *FuryaStakeAuthorization) GetAllowList() *FuryaStakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*FuryaStakeAuthorization_AllowList); ok {
+		return x.AllowList
+	}
+	return nil
+}
+
+func (m *FuryaStakeAuthorization) GetDenyList() *FuryaStakeAuthorization_Validators {
+	if x, ok := m.GetValidators().(*FuryaStakeAuthorization_DenyList); ok {
+		return x.DenyList
+	}
+	return nil
+}
+
+func (m *FuryaStakeAuthorization) GetAuthorizationType() FuryaAuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FuryaStakeAuthorization) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FuryaStakeAuthorization_AllowList)(nil),
+		(*FuryaStakeAuthorization_DenyList)(nil),
+	}
+}
+
+// Validators defines list of validator addresses.
+type FuryaStakeAuthorization_Validators struct {
+	Address []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FuryaStakeAuthorization_Validators) Reset()         { *m = FuryaStakeAuthorization_Validators{} }
+func (m *FuryaStakeAuthorization_Validators) String() string { return proto.CompactTextString(m) }
+func (*FuryaStakeAuthorization_Validators) ProtoMessage()    {}
+func (*FuryaStakeAuthorization_Validators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2f7e296d99447f0, []int{0, 0}
+}
+func (m *FuryaStakeAuthorization_Validators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaStakeAuthorization_Validators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaStakeAuthorization_Validators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FuryaStakeAuthorization_Validators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaStakeAuthorization_Validators.Merge(m, src)
+}
+func (m *FuryaStakeAuthorization_Validators) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaStakeAuthorization_Validators) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaStakeAuthorization_Validators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FuryaStakeAuthorization_Validators proto.InternalMessageInfo
+
+func (m *FuryaStakeAuthorization_Validators) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("furya.furya.FuryaAuthorizationType", FuryaAuthorizationType_name, FuryaAuthorizationType_value)
+	proto.RegisterType((*FuryaStakeAuthorization)(nil), "furya.furya.FuryaStakeAuthorization")
+	proto.RegisterType((*FuryaStakeAuthorization_Validators)(nil), "furya.furya.FuryaStakeAuthorization.Validators")
+}
+
+func init() { proto.RegisterFile("furya/authz.proto", fileDescriptor_f2f7e296d99447f0) }
+
+var fileDescriptor_f2f7e296d99447f0 = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc6, 0xed, 0xa6, 0xbc, 0xe4, 0x42, 0xab, 0xe6, 0x54, 0x41, 0x9a, 0xc1, 0x0d, 0x94, 0x0a,
+	0x0b, 0xc9, 0x36, 0x0d, 0x1b, 0x13, 0x4e, 0xe3, 0xb4, 0x91, 0xaa, 0x34, 0x72, 0x1c, 0xa4, 0x76,
+	0xb1, 0x2e, 0xf1, 0x25, 0x39, 0x62, 0xfb, 0x22, 0xdf, 0xa5, 0x24, 0xdd, 0xd9, 0xf9, 0x1c, 0xcc,
+	0xdd, 0xf8, 0x02, 0x1d, 0xab, 0x4e, 0x4c, 0x80, 0x92, 0x2f, 0x82, 0x7c, 0x36, 0x21, 0x05, 0xda,
+	0x81, 0xc5, 0xe7, 0xff, 0xf3, 0x3c, 0xfe, 0xdd, 0xdb, 0xdf, 0x20, 0xdf, 0x1b, 0x47, 0x53, 0x64,
+	0xa0, 0x31, 0x1f, 0x9c, 0xeb, 0xa3, 0x88, 0x72, 0x0a, 0x73, 0x42, 0xd2, 0xc5, 0xb3, 0xb8, 0xd9,
+	0xa7, 0x7d, 0x2a, 0x74, 0x23, 0x7e, 0x4b, 0x22, 0xc5, 0xad, 0x2e, 0x65, 0x01, 0x65, 0x6e, 0x62,
+	0x24, 0x45, 0x6a, 0x29, 0x49, 0x65, 0x74, 0x10, 0xc3, 0xc6, 0xd9, 0x5e, 0x07, 0x73, 0xb4, 0x67,
+	0x74, 0x29, 0x09, 0x13, 0xff, 0xd9, 0xc7, 0x55, 0xf0, 0xa4, 0x16, 0xa3, 0x5b, 0x1c, 0x0d, 0xb1,
+	0x39, 0xe6, 0x03, 0x1a, 0x91, 0x73, 0xc4, 0x09, 0x0d, 0xe1, 0x7b, 0x00, 0x02, 0x34, 0x71, 0x39,
+	0x1d, 0xe2, 0x90, 0x15, 0xe4, 0x52, 0x46, 0xcd, 0x95, 0xb7, 0xf4, 0x14, 0x1f, 0x03, 0xf5, 0x14,
+	0xa8, 0xef, 0x53, 0x12, 0x56, 0x5e, 0x5d, 0x7e, 0xdb, 0x96, 0x3e, 0x7f, 0xdf, 0x56, 0xfb, 0x84,
+	0x0f, 0xc6, 0x1d, 0xbd, 0x4b, 0x83, 0x74, 0x2d, 0xe9, 0xa0, 0x31, 0x6f, 0x68, 0xf0, 0xe9, 0x08,
+	0x33, 0xf1, 0x01, 0xb3, 0xb3, 0x01, 0x9a, 0x38, 0x82, 0x0e, 0x77, 0xc1, 0x3a, 0xf2, 0x7d, 0xfa,
+	0x01, 0x7b, 0xae, 0x87, 0x43, 0x1a, 0xb0, 0xc2, 0x4a, 0x29, 0xa3, 0x66, 0xed, 0xb5, 0x54, 0xad,
+	0x0a, 0x11, 0x36, 0x01, 0x10, 0x82, 0xeb, 0x13, 0xc6, 0x0b, 0x99, 0x92, 0xac, 0xe6, 0xca, 0x86,
+	0xbe, 0x74, 0x42, 0xfa, 0x2d, 0x9b, 0xd1, 0xdf, 0x21, 0x9f, 0x78, 0x88, 0xd3, 0x88, 0x1d, 0x4a,
+	0x76, 0x56, 0x40, 0x8e, 0x08, 0xe3, 0xb0, 0x01, 0xb2, 0x1e, 0x0e, 0xa7, 0x09, 0x70, 0xf5, 0x7f,
+	0x81, 0x0f, 0x63, 0x86, 0xe0, 0xd9, 0x00, 0xa2, 0xe5, 0x9c, 0x1b, 0x6f, 0xb8, 0x70, 0xaf, 0x24,
+	0xab, 0xeb, 0xe5, 0x9d, 0xbf, 0xc1, 0x37, 0x98, 0xce, 0x74, 0x84, 0xed, 0x3c, 0xfa, 0x53, 0x2a,
+	0xbe, 0x05, 0xe0, 0xf7, 0x6c, 0xb0, 0x0c, 0x1e, 0x20, 0xcf, 0x8b, 0x30, 0x4b, 0xee, 0x24, 0x5b,
+	0x29, 0x5c, 0x5f, 0x68, 0x9b, 0xe9, 0xb5, 0x98, 0x89, 0xd3, 0xe2, 0x11, 0x09, 0xfb, 0xf6, 0xaf,
+	0xe0, 0x9b, 0xfc, 0xf5, 0x85, 0xb6, 0x76, 0x63, 0xae, 0xca, 0x23, 0x00, 0xce, 0x16, 0xd0, 0x97,
+	0x5f, 0x64, 0xf0, 0xf8, 0xdf, 0x0b, 0x82, 0x2a, 0x78, 0x5e, 0x6b, 0xdb, 0x27, 0xa6, 0x6b, 0xb6,
+	0x9d, 0xc3, 0x63, 0xbb, 0x7e, 0x6a, 0x3a, 0xf5, 0xe3, 0x86, 0xeb, 0x9c, 0x34, 0x2d, 0xb7, 0xdd,
+	0x68, 0x35, 0xad, 0xfd, 0x7a, 0xad, 0x6e, 0x55, 0x37, 0x24, 0xb8, 0x0b, 0x9e, 0xde, 0x9a, 0xac,
+	0x5a, 0x47, 0xd6, 0x81, 0xe9, 0x58, 0x1b, 0x32, 0x7c, 0x01, 0x76, 0xee, 0x00, 0x2e, 0x82, 0x2b,
+	0x77, 0x06, 0x6d, 0x6b, 0x11, 0xcc, 0x54, 0x0e, 0x2e, 0x67, 0x8a, 0x7c, 0x35, 0x53, 0xe4, 0x1f,
+	0x33, 0x45, 0xfe, 0x34, 0x57, 0xa4, 0xab, 0xb9, 0x22, 0x7d, 0x9d, 0x2b, 0xd2, 0xa9, 0xb6, 0xd4,
+	0x8c, 0xe2, 0xd8, 0x35, 0xda, 0xeb, 0x91, 0x2e, 0x41, 0x7e, 0x52, 0x1a, 0x93, 0x74, 0x14, 0x7d,
+	0xd9, 0xb9, 0x2f, 0xfe, 0x8a, 0xd7, 0x3f, 0x07, 0x00, 0x72, 0x26, 0x5f, 0xd5, 0x88, 0x03, 0x00,
+	0x00,
+}
+
+func (m *FuryaStakeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaStakeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaStakeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Validators != nil {
+		{
+			size := m.Validators.Size()
+			i -= size
+			if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MaxTokens) > 0 {
+		for iNdEx := len(m.MaxTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FuryaStakeAuthorization_AllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaStakeAuthorization_AllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AllowList != nil {
+		{
+			size, err := m.AllowList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FuryaStakeAuthorization_DenyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaStakeAuthorization_DenyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DenyList != nil {
+		{
+			size, err := m.DenyList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FuryaStakeAuthorization_Validators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaStakeAuthorization_Validators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaStakeAuthorization_Validators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for iNdEx := len(m.Address) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Address[iNdEx])
+			copy(dAtA[i:], m.Address[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Address[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FuryaStakeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxTokens) > 0 {
+		for _, e := range m.MaxTokens {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Validators != nil {
+		n += m.Validators.Size()
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	return n
+}
+
+func (m *FuryaStakeAuthorization_AllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowList != nil {
+		l = m.AllowList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *FuryaStakeAuthorization_DenyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenyList != nil {
+		l = m.DenyList.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+func (m *FuryaStakeAuthorization_Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		for _, s := range m.Address {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FuryaStakeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FuryaStakeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FuryaStakeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTokens = append(m.MaxTokens, types.Coin{})
+			if err := m.MaxTokens[len(m.MaxTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FuryaStakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &FuryaStakeAuthorization_AllowList{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FuryaStakeAuthorization_Validators{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Validators = &FuryaStakeAuthorization_DenyList{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= FuryaAuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FuryaStakeAuthorization_Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
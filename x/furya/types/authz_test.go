@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/furya-official/furya/x/furya/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestFuryaStakeAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, delAddr := testdata.KeyTestPubAddr()
+	val1 := sdk.ValAddress(addr1)
+	val2 := sdk.ValAddress(addr2)
+
+	// Both lists cannot be set
+	_, err := types.NewFuryaStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{val2}, nil, nil, types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_DELEGATE)
+	require.Error(t, err)
+
+	// Unspecified authorization type is invalid
+	a, err := types.NewFuryaStakeAuthorization(nil, nil, nil, nil, types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.ErrorIs(t, a.ValidateBasic(), authz.ErrUnknownAuthorizationType)
+
+	a, err = types.NewFuryaStakeAuthorization(
+		[]sdk.ValAddress{val1},
+		nil,
+		[]string{"aaa", "bbb"},
+		sdk.NewCoins(sdk.NewInt64Coin("aaa", 100), sdk.NewInt64Coin("bbb", 50)),
+		types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_DELEGATE,
+	)
+	require.NoError(t, err)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgDelegate{}), a.MsgTypeURL())
+
+	// Validator is not in the allow list
+	_, err = a.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val2.String(), Amount: sdk.NewInt64Coin("aaa", 10)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Denom is not allowed
+	_, err = a.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: sdk.NewInt64Coin("ccc", 10)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Amount exceeds the max tokens
+	_, err = a.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: sdk.NewInt64Coin("aaa", 101)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// Max tokens are decreased
+	res, err := a.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: sdk.NewInt64Coin("aaa", 100)})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	updated := res.Updated.(*types.FuryaStakeAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bbb", 50)), updated.MaxTokens)
+
+	// Used up denoms cannot be delegated anymore
+	_, err = updated.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: sdk.NewInt64Coin("aaa", 1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// The authorization is deleted once all max tokens are used up
+	res, err = updated.Accept(ctx, &types.MsgDelegate{DelegatorAddress: delAddr.String(), ValidatorAddress: val1.String(), Amount: sdk.NewInt64Coin("bbb", 50)})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	// Redelegations are checked against the destination validator in the deny list
+	a, err = types.NewFuryaStakeAuthorization(nil, []sdk.ValAddress{val2}, nil, nil, types.FuryaAuthorizationType_FURYA_AUTHORIZATION_TYPE_REDELEGATE)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgRedelegate{}), a.MsgTypeURL())
+	_, err = a.Accept(ctx, &types.MsgRedelegate{DelegatorAddress: delAddr.String(), ValidatorSrcAddress: val1.String(), ValidatorDstAddress: val2.String(), Amount: sdk.NewInt64Coin("aaa", 10)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	res, err = a.Accept(ctx, &types.MsgRedelegate{DelegatorAddress: delAddr.String(), ValidatorSrcAddress: val2.String(), ValidatorDstAddress: val1.String(), Amount: sdk.NewInt64Coin("aaa", 10)})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -15,6 +16,27 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegate{},
 		&MsgRedelegate{},
 		&MsgUndelegate{},
+		&MsgClaimDelegationRewards{},
+		&MsgClaimAllDelegationRewards{},
+		&MsgCancelUndelegation{},
+		&MsgSetFuryaWithdrawAddress{},
+		&MsgSetAutoCompound{},
+		&MsgMultiDelegate{},
+		&MsgMultiUndelegate{},
+		&MsgTransferDelegation{},
+		&MsgTokenizeFuryaDelegation{},
+		&MsgRedeemFuryaTokens{},
+		&MsgClaimFuryaTokenRewards{},
+		&MsgSetValidatorFuryaPreferences{},
+		&MsgSetValidatorFuryaCommission{},
+		&MsgWithdrawValidatorFuryaCommission{},
+		&MsgSettleFuryaRewards{},
+		&MsgPostFuryaPrices{},
+		&MsgCreateFuryaIncentive{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&FuryaStakeAuthorization{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -37,13 +38,17 @@ type Delegation struct {
 	Shares                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
 	RewardHistory         []RewardHistory                        `protobuf:"bytes,5,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
 	LastRewardClaimHeight uint64                                 `protobuf:"varint,6,opt,name=last_reward_claim_height,json=lastRewardClaimHeight,proto3" json:"last_reward_claim_height,omitempty"`
+	// Rewards settled by the reward settlement crank that have not been claimed by the delegator yet
+	PendingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_rewards"`
+	// Reward indices of the incentives of the delegated asset at the last claim
+	IncentiveRewardHistory []RewardHistory `protobuf:"bytes,8,rep,name=incentive_reward_history,json=incentiveRewardHistory,proto3" json:"incentive_reward_history"`
 }
 
 func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{0}
+	return fileDescriptor_21006a3e5bdff3c0, []int{0}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +88,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{1}
+	return fileDescriptor_21006a3e5bdff3c0, []int{1}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +125,7 @@ func (m *QueuedRedelegation) Reset()         { *m = QueuedRedelegation{} }
 func (m *QueuedRedelegation) String() string { return proto.CompactTextString(m) }
 func (*QueuedRedelegation) ProtoMessage()    {}
 func (*QueuedRedelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{2}
+	return fileDescriptor_21006a3e5bdff3c0, []int{2}
 }
 func (m *QueuedRedelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +164,7 @@ func (m *Undelegation) Reset()         { *m = Undelegation{} }
 func (m *Undelegation) String() string { return proto.CompactTextString(m) }
 func (*Undelegation) ProtoMessage()    {}
 func (*Undelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{3}
+	return fileDescriptor_21006a3e5bdff3c0, []int{3}
 }
 func (m *Undelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +201,7 @@ func (m *QueuedUndelegation) Reset()         { *m = QueuedUndelegation{} }
 func (m *QueuedUndelegation) String() string { return proto.CompactTextString(m) }
 func (*QueuedUndelegation) ProtoMessage()    {}
 func (*QueuedUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{4}
+	return fileDescriptor_21006a3e5bdff3c0, []int{4}
 }
 func (m *QueuedUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,13 +234,18 @@ type FuryaValidatorInfo struct {
 	GlobalRewardHistory  []RewardHistory `protobuf:"bytes,1,rep,name=global_reward_history,json=globalRewardHistory,proto3" json:"global_reward_history"`
 	TotalDelegatorShares []types.DecCoin `protobuf:"bytes,2,rep,name=total_delegator_shares,json=totalDelegatorShares,proto3" json:"total_delegator_shares"`
 	ValidatorShares      []types.DecCoin `protobuf:"bytes,3,rep,name=validator_shares,json=validatorShares,proto3" json:"validator_shares"`
+	// Reward indices of the incentives of each furya asset delegated to the validator
+	IncentiveRewardHistories []AssetRewardHistory `protobuf:"bytes,4,rep,name=incentive_reward_histories,json=incentiveRewardHistories,proto3" json:"incentive_reward_histories"`
+	// Rewards withdrawn while the validator had no furya delegations.
+	// They are added to the next reward index update of the validator.
+	UnallocatedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=unallocated_rewards,json=unallocatedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unallocated_rewards"`
 }
 
 func (m *FuryaValidatorInfo) Reset()         { *m = FuryaValidatorInfo{} }
 func (m *FuryaValidatorInfo) String() string { return proto.CompactTextString(m) }
 func (*FuryaValidatorInfo) ProtoMessage()    {}
 func (*FuryaValidatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8303368cab785f76, []int{5}
+	return fileDescriptor_21006a3e5bdff3c0, []int{5}
 }
 func (m *FuryaValidatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,6 +274,269 @@ func (m *FuryaValidatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FuryaValidatorInfo proto.InternalMessageInfo
 
+// RewardDust tracks the fractions of rewards that are left in the rewards pool when claimed rewards are truncated
+type RewardDust struct {
+	// Cumulative truncation dust per reward denom
+	Dust github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"dust"`
+	// Whole coins of the truncation dust that were swept to the community pool
+	Swept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept"`
+}
+
+func (m *RewardDust) Reset()         { *m = RewardDust{} }
+func (m *RewardDust) String() string { return proto.CompactTextString(m) }
+func (*RewardDust) ProtoMessage()    {}
+func (*RewardDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{6}
+}
+func (m *RewardDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardDust.Merge(m, src)
+}
+func (m *RewardDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardDust proto.InternalMessageInfo
+
+// TokenizedDelegation tracks delegation shares owned by the furya module that are represented by a bank token
+type TokenizedDelegation struct {
+	// denom of the token that represents the tokenized shares
+	TokenDenom       string `protobuf:"bytes,1,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denom of the furya asset that is delegated
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Cumulative rewards per token claimed for the tokenized shares
+	RewardHistory []RewardHistory `protobuf:"bytes,4,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
+}
+
+func (m *TokenizedDelegation) Reset()         { *m = TokenizedDelegation{} }
+func (m *TokenizedDelegation) String() string { return proto.CompactTextString(m) }
+func (*TokenizedDelegation) ProtoMessage()    {}
+func (*TokenizedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{7}
+}
+func (m *TokenizedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizedDelegation.Merge(m, src)
+}
+func (m *TokenizedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizedDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizedDelegation proto.InternalMessageInfo
+
+// TokenHolderRewardHistory is the reward index of a token holder when it last claimed rewards of tokenized shares
+type TokenHolderRewardHistory struct {
+	HolderAddress string          `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	TokenDenom    string          `protobuf:"bytes,2,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"`
+	RewardHistory []RewardHistory `protobuf:"bytes,3,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
+}
+
+func (m *TokenHolderRewardHistory) Reset()         { *m = TokenHolderRewardHistory{} }
+func (m *TokenHolderRewardHistory) String() string { return proto.CompactTextString(m) }
+func (*TokenHolderRewardHistory) ProtoMessage()    {}
+func (*TokenHolderRewardHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{8}
+}
+func (m *TokenHolderRewardHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenHolderRewardHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenHolderRewardHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenHolderRewardHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolderRewardHistory.Merge(m, src)
+}
+func (m *TokenHolderRewardHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenHolderRewardHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolderRewardHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolderRewardHistory proto.InternalMessageInfo
+
+// ValidatorFuryaPreferences restricts which furya assets can be delegated to a validator
+type ValidatorFuryaPreferences struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// When not empty, only these denoms can be delegated to the validator
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Denoms that can never be delegated to the validator
+	BlockedDenoms []string `protobuf:"bytes,3,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+}
+
+func (m *ValidatorFuryaPreferences) Reset()         { *m = ValidatorFuryaPreferences{} }
+func (m *ValidatorFuryaPreferences) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFuryaPreferences) ProtoMessage()    {}
+func (*ValidatorFuryaPreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{9}
+}
+func (m *ValidatorFuryaPreferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFuryaPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFuryaPreferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorFuryaPreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFuryaPreferences.Merge(m, src)
+}
+func (m *ValidatorFuryaPreferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFuryaPreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFuryaPreferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFuryaPreferences proto.InternalMessageInfo
+
+// ValidatorFuryaCommission is the commission a validator takes from the furya rewards of its delegators
+type ValidatorFuryaCommission struct {
+	ValidatorAddress string            `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Commission       types1.Commission `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission"`
+	// Commission taken from the rewards that has not been withdrawn yet
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued"`
+}
+
+func (m *ValidatorFuryaCommission) Reset()         { *m = ValidatorFuryaCommission{} }
+func (m *ValidatorFuryaCommission) String() string { return proto.CompactTextString(m) }
+func (*ValidatorFuryaCommission) ProtoMessage()    {}
+func (*ValidatorFuryaCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{10}
+}
+func (m *ValidatorFuryaCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorFuryaCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorFuryaCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorFuryaCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorFuryaCommission.Merge(m, src)
+}
+func (m *ValidatorFuryaCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorFuryaCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorFuryaCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorFuryaCommission proto.InternalMessageInfo
+
+// RewardSettlementCursor tracks the progress of the reward settlement crank through all delegations
+type RewardSettlementCursor struct {
+	// Key of the next delegation to settle
+	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	// Height at which the current sweep over all delegations started
+	SweepStartHeight uint64 `protobuf:"varint,2,opt,name=sweep_start_height,json=sweepStartHeight,proto3" json:"sweep_start_height,omitempty"`
+}
+
+func (m *RewardSettlementCursor) Reset()         { *m = RewardSettlementCursor{} }
+func (m *RewardSettlementCursor) String() string { return proto.CompactTextString(m) }
+func (*RewardSettlementCursor) ProtoMessage()    {}
+func (*RewardSettlementCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21006a3e5bdff3c0, []int{11}
+}
+func (m *RewardSettlementCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSettlementCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSettlementCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSettlementCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSettlementCursor.Merge(m, src)
+}
+func (m *RewardSettlementCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSettlementCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSettlementCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSettlementCursor proto.InternalMessageInfo
+
+func (m *RewardSettlementCursor) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *RewardSettlementCursor) GetSweepStartHeight() uint64 {
+	if m != nil {
+		return m.SweepStartHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Delegation)(nil), "furya.furya.Delegation")
 	proto.RegisterType((*Redelegation)(nil), "furya.furya.Redelegation")
@@ -271,52 +544,84 @@ func init() {
 	proto.RegisterType((*Undelegation)(nil), "furya.furya.Undelegation")
 	proto.RegisterType((*QueuedUndelegation)(nil), "furya.furya.QueuedUndelegation")
 	proto.RegisterType((*FuryaValidatorInfo)(nil), "furya.furya.FuryaValidatorInfo")
+	proto.RegisterType((*RewardDust)(nil), "furya.furya.RewardDust")
+	proto.RegisterType((*TokenizedDelegation)(nil), "furya.furya.TokenizedDelegation")
+	proto.RegisterType((*TokenHolderRewardHistory)(nil), "furya.furya.TokenHolderRewardHistory")
+	proto.RegisterType((*ValidatorFuryaPreferences)(nil), "furya.furya.ValidatorFuryaPreferences")
+	proto.RegisterType((*ValidatorFuryaCommission)(nil), "furya.furya.ValidatorFuryaCommission")
+	proto.RegisterType((*RewardSettlementCursor)(nil), "furya.furya.RewardSettlementCursor")
 }
 
-func init() { proto.RegisterFile("furya/delegations.proto", fileDescriptor_8303368cab785f76) }
-
-var fileDescriptor_8303368cab785f76 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xed, 0xb8, 0x7f, 0xe0, 0x5a, 0xfe, 0xd4, 0x8d, 0x91, 0x1b, 0x21, 0x27, 0xea, 0x80,
-	0xb2, 0xc4, 0xa6, 0x65, 0x40, 0x45, 0x2c, 0x4d, 0x83, 0x54, 0x50, 0x19, 0x70, 0x01, 0x41, 0x17,
-	0xeb, 0xec, 0x3b, 0x1c, 0x0b, 0xdb, 0x17, 0xdd, 0x5d, 0x0a, 0xf9, 0x06, 0x8c, 0x8c, 0x8c, 0x9d,
-	0xf8, 0x04, 0x15, 0x9f, 0xa1, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x90, 0x2c, 0x7c, 0x0c, 0x64, 0xfb,
-	0xec, 0x38, 0x6d, 0xa4, 0xb6, 0x82, 0x81, 0x29, 0x97, 0x7b, 0xde, 0xf7, 0x77, 0xf6, 0xf3, 0xf8,
-	0x5e, 0x50, 0x83, 0x61, 0x18, 0xc0, 0xd8, 0xc3, 0x16, 0xc2, 0x21, 0xf6, 0x21, 0x0f, 0x48, 0xcc,
-	0xcc, 0x1e, 0x25, 0x9c, 0xa8, 0x4b, 0xb9, 0x66, 0xe6, 0x8b, 0x5a, 0xd5, 0x27, 0x3e, 0x49, 0x55,
-	0x2b, 0x59, 0x65, 0x85, 0x35, 0xc3, 0x23, 0x2c, 0x22, 0xcc, 0x72, 0x21, 0xc3, 0xd6, 0xfe, 0x9a,
-	0x8b, 0x39, 0x5c, 0xb3, 0x3c, 0x12, 0xc4, 0x42, 0x5f, 0xc9, 0x74, 0x27, 0x6b, 0xcc, 0xfe, 0x08,
-	0x49, 0x2b, 0xce, 0xef, 0x41, 0x0a, 0x23, 0xb1, 0xbd, 0xfa, 0x45, 0x01, 0xa0, 0x53, 0x3c, 0x90,
-	0xfa, 0x04, 0x2c, 0x89, 0xc7, 0x23, 0xd4, 0x81, 0x08, 0x51, 0xcc, 0x98, 0x2e, 0x37, 0xe4, 0xe6,
-	0xf5, 0xb6, 0x7e, 0x72, 0xd8, 0xaa, 0x0a, 0xe4, 0x66, 0xa6, 0xec, 0x72, 0x1a, 0xc4, 0xbe, 0x7d,
-	0xbb, 0x68, 0x11, 0xfb, 0x09, 0x66, 0x1f, 0x86, 0x01, 0x9a, 0xc0, 0x54, 0x2e, 0xc2, 0x14, 0x2d,
-	0x39, 0xa6, 0x0a, 0x66, 0x11, 0x8e, 0x49, 0xa4, 0x2b, 0x49, 0xab, 0x9d, 0xfd, 0x51, 0x5f, 0x82,
-	0x39, 0xd6, 0x85, 0x14, 0x33, 0x7d, 0x26, 0x25, 0x3e, 0x3e, 0x3a, 0xad, 0x4b, 0x3f, 0x4e, 0xeb,
-	0xf7, 0xfc, 0x80, 0x77, 0xfb, 0xae, 0xe9, 0x91, 0x48, 0xbc, 0xba, 0xf8, 0x69, 0x31, 0xf4, 0xde,
-	0xe2, 0x83, 0x1e, 0x66, 0x66, 0x07, 0x7b, 0x27, 0x87, 0x2d, 0x20, 0xce, 0xef, 0x60, 0xcf, 0x16,
-	0x2c, 0xf5, 0x39, 0xb8, 0x49, 0xf1, 0x07, 0x48, 0x91, 0xd3, 0x0d, 0x18, 0x27, 0x74, 0xa0, 0xcf,
-	0x36, 0x94, 0xe6, 0xc2, 0x7a, 0xc3, 0x3c, 0x17, 0x8e, 0x69, 0xa7, 0x85, 0xdb, 0x59, 0x5d, 0x7b,
-	0x26, 0x39, 0xdf, 0xbe, 0x41, 0xcb, 0x9b, 0xea, 0x43, 0xa0, 0x87, 0x90, 0x71, 0x47, 0x30, 0xbd,
-	0x10, 0x06, 0x91, 0xd3, 0xc5, 0x81, 0xdf, 0xe5, 0xfa, 0x5c, 0x43, 0x6e, 0xce, 0xd8, 0x5a, 0xa2,
-	0x67, 0xa4, 0xad, 0x44, 0xdd, 0x4e, 0xc5, 0x47, 0xd7, 0x3e, 0x1d, 0xd4, 0xa5, 0xdf, 0x07, 0x75,
-	0x69, 0xf5, 0x5b, 0x05, 0x2c, 0xda, 0x18, 0xfd, 0xf3, 0x70, 0x76, 0x80, 0xc6, 0xa8, 0xe7, 0x5c,
-	0x3d, 0xa0, 0x65, 0x46, 0xbd, 0xd7, 0x67, 0x33, 0xda, 0x01, 0x1a, 0x62, 0x7c, 0x0a, 0x4d, 0xb9,
-	0x88, 0x86, 0x18, 0x3f, 0x47, 0xdb, 0x00, 0xf3, 0x2e, 0x0c, 0x13, 0x93, 0xd3, 0x70, 0x17, 0xd6,
-	0x57, 0x4c, 0xd1, 0x9c, 0x7c, 0xf2, 0xa6, 0xf8, 0xe4, 0xcd, 0x2d, 0x12, 0xc4, 0xc2, 0xf7, 0xbc,
-	0xbe, 0x64, 0xdc, 0x5b, 0xa0, 0xbe, 0xe8, 0xe3, 0x3e, 0x46, 0x13, 0xee, 0x6d, 0x80, 0x79, 0x1c,
-	0x73, 0x1a, 0xe0, 0xc4, 0xb3, 0x24, 0xd9, 0xfa, 0xd4, 0x64, 0xc7, 0x1d, 0x76, 0x5e, 0x5f, 0x42,
-	0xff, 0x92, 0xc1, 0xe2, 0xab, 0x18, 0xfd, 0xaf, 0x17, 0xa6, 0x64, 0x9f, 0xf2, 0xf7, 0xf6, 0x4d,
-	0xbc, 0xe8, 0xa5, 0xec, 0x2b, 0x77, 0x4c, 0xb3, 0xef, 0x6b, 0x05, 0x68, 0x9b, 0xa2, 0xb8, 0xc8,
-	0xfe, 0x69, 0xfc, 0x8e, 0xa8, 0x7b, 0x40, 0xf3, 0x43, 0xe2, 0xc2, 0xd0, 0x39, 0x73, 0x0b, 0xe5,
-	0x2b, 0xdd, 0xc2, 0xe5, 0x0c, 0x32, 0x21, 0xa9, 0x6f, 0xc0, 0x1d, 0x4e, 0x38, 0x0c, 0x9d, 0x71,
-	0x52, 0x62, 0x80, 0x54, 0x52, 0xf8, 0xdd, 0xa9, 0x26, 0x75, 0xb0, 0x57, 0xf2, 0xa9, 0x9a, 0x12,
-	0x3a, 0x39, 0x60, 0x37, 0x1f, 0x1a, 0xe3, 0x0c, 0x72, 0xa6, 0x72, 0x69, 0xe6, 0xad, 0xa2, 0x37,
-	0xc3, 0x8d, 0x8d, 0x6a, 0x3f, 0x3b, 0x1a, 0x1a, 0xf2, 0xf1, 0xd0, 0x90, 0x7f, 0x0e, 0x0d, 0xf9,
-	0xf3, 0xc8, 0x90, 0x8e, 0x47, 0x86, 0xf4, 0x7d, 0x64, 0x48, 0x7b, 0xf7, 0x4b, 0x53, 0x8e, 0x63,
-	0x4a, 0x61, 0x2b, 0x22, 0x31, 0x1e, 0x58, 0xc5, 0x78, 0xff, 0x38, 0x5e, 0xa6, 0x33, 0xcf, 0x9d,
-	0x4b, 0x27, 0xfd, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x8b, 0x33, 0xd9, 0x82, 0x06,
-	0x00, 0x00,
+func init() { proto.RegisterFile("furya/delegations.proto", fileDescriptor_21006a3e5bdff3c0) }
+
+var fileDescriptor_21006a3e5bdff3c0 = []byte{
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0xce, 0x47, 0x5f, 0x3e, 0x5a, 0x36, 0x1f, 0x6c, 0x22, 0x64, 0x47, 0x11, 0xa0,
+	0x48, 0x10, 0x9b, 0x36, 0x07, 0x04, 0x42, 0x42, 0x4d, 0x0c, 0x0d, 0xa2, 0x48, 0xb0, 0x49, 0x10,
+	0xea, 0x65, 0x35, 0xde, 0x7d, 0xb1, 0x47, 0x59, 0xcf, 0x58, 0x33, 0xe3, 0xa4, 0x46, 0x1c, 0x38,
+	0x72, 0xe4, 0x0f, 0xe0, 0xd0, 0x33, 0xe7, 0x8a, 0x03, 0x7f, 0x41, 0x6f, 0x54, 0x3d, 0x21, 0x0e,
+	0xa5, 0x24, 0x08, 0xf1, 0x67, 0xa0, 0x9d, 0x99, 0x5d, 0xaf, 0x93, 0xb4, 0x75, 0xa9, 0x91, 0xb8,
+	0x64, 0x33, 0xef, 0xe3, 0x37, 0xef, 0xfd, 0xe6, 0xbd, 0x37, 0x63, 0x78, 0xf5, 0xb0, 0x27, 0xfa,
+	0xa4, 0x1e, 0x61, 0x8c, 0x2d, 0xa2, 0x28, 0x67, 0xb2, 0xd6, 0x15, 0x5c, 0x71, 0x77, 0x46, 0x2b,
+	0x6a, 0xfa, 0xef, 0xea, 0x62, 0x8b, 0xb7, 0xb8, 0x96, 0xd7, 0x93, 0xff, 0x8c, 0xc9, 0x6a, 0x25,
+	0xe4, 0xb2, 0xc3, 0x65, 0xbd, 0x49, 0x24, 0xd6, 0x8f, 0xaf, 0x37, 0x51, 0x91, 0xeb, 0xf5, 0x90,
+	0x53, 0x66, 0xf5, 0x2b, 0x46, 0x1f, 0x18, 0x47, 0xb3, 0xb0, 0x2a, 0xd7, 0x6c, 0xdb, 0x25, 0x82,
+	0x74, 0x52, 0xd9, 0x92, 0x91, 0x51, 0x16, 0x22, 0x53, 0xf4, 0x18, 0xad, 0xf8, 0x75, 0xbb, 0x8b,
+	0x54, 0xe4, 0x88, 0xb2, 0x56, 0xb6, 0x91, 0x5d, 0x1b, 0xab, 0xf5, 0x3f, 0xcb, 0x00, 0x8d, 0x2c,
+	0x09, 0xf7, 0x23, 0x78, 0xc5, 0xa6, 0xc4, 0x45, 0x40, 0xa2, 0x48, 0xa0, 0x94, 0x9e, 0xb3, 0xe6,
+	0x6c, 0x5c, 0xd9, 0xf6, 0x1e, 0xdd, 0xdf, 0x5c, 0xb4, 0xc1, 0xdc, 0x34, 0x9a, 0x3d, 0x25, 0x28,
+	0x6b, 0xf9, 0xd7, 0x32, 0x17, 0x2b, 0x4f, 0x60, 0x8e, 0x49, 0x4c, 0xa3, 0x21, 0x98, 0xe2, 0xf3,
+	0x60, 0x32, 0x97, 0x14, 0x66, 0x11, 0x26, 0x22, 0x64, 0xbc, 0xe3, 0x95, 0x12, 0x57, 0xdf, 0x2c,
+	0xdc, 0x7d, 0x98, 0x94, 0x6d, 0x22, 0x50, 0x7a, 0x65, 0x8d, 0xf8, 0xc1, 0x83, 0xc7, 0xd5, 0xc2,
+	0x6f, 0x8f, 0xab, 0x6f, 0xb6, 0xa8, 0x6a, 0xf7, 0x9a, 0xb5, 0x90, 0x77, 0x2c, 0x69, 0xf6, 0xb3,
+	0x29, 0xa3, 0xa3, 0xba, 0xea, 0x77, 0x51, 0xd6, 0x1a, 0x18, 0x3e, 0xba, 0xbf, 0x09, 0x76, 0xff,
+	0x06, 0x86, 0xbe, 0xc5, 0x72, 0x6f, 0xc1, 0xbc, 0xc0, 0x13, 0x22, 0xa2, 0xa0, 0x4d, 0xa5, 0xe2,
+	0xa2, 0xef, 0x4d, 0xac, 0x95, 0x36, 0x66, 0x6e, 0xac, 0xd6, 0x72, 0x07, 0x5a, 0xf3, 0xb5, 0xc9,
+	0xae, 0xb1, 0xd8, 0x2e, 0x27, 0x3b, 0xfb, 0x73, 0x22, 0x2f, 0x74, 0xdf, 0x05, 0x2f, 0x26, 0x52,
+	0x05, 0x16, 0x2d, 0x8c, 0x09, 0xed, 0x04, 0x6d, 0xa4, 0xad, 0xb6, 0xf2, 0x26, 0xd7, 0x9c, 0x8d,
+	0xb2, 0xbf, 0x94, 0xe8, 0x0d, 0xd2, 0x4e, 0xa2, 0xdd, 0xd5, 0x4a, 0x57, 0xc1, 0xd5, 0x2e, 0xb2,
+	0x88, 0xb2, 0x96, 0xf5, 0x95, 0xde, 0x94, 0x0e, 0x61, 0xa5, 0x66, 0xe3, 0x4d, 0x0a, 0xa6, 0x66,
+	0xcf, 0xb1, 0xb6, 0xc3, 0x29, 0xdb, 0x7e, 0x27, 0x89, 0xe0, 0xc7, 0xdf, 0xab, 0x1b, 0x23, 0xe4,
+	0x9e, 0x38, 0x48, 0x7f, 0xde, 0xee, 0x61, 0xf6, 0x97, 0xee, 0x1d, 0xf0, 0xb2, 0xca, 0x09, 0xce,
+	0x31, 0x30, 0x3d, 0x22, 0x03, 0xcb, 0x19, 0xc2, 0x90, 0xf6, 0xfd, 0xe9, 0xef, 0xee, 0x55, 0x0b,
+	0x7f, 0xdf, 0xab, 0x16, 0xd6, 0x7f, 0x2a, 0xc2, 0xac, 0x8f, 0xd1, 0xd8, 0x0b, 0xed, 0x36, 0x2c,
+	0x49, 0x11, 0x06, 0x2f, 0x5e, 0x6c, 0x0b, 0x52, 0x84, 0x5f, 0x9e, 0xaf, 0xb7, 0xdb, 0xb0, 0x14,
+	0x49, 0x75, 0x09, 0x5a, 0xe9, 0x79, 0x68, 0x91, 0x54, 0x17, 0xd0, 0xde, 0x83, 0xa9, 0x26, 0x89,
+	0x09, 0x0b, 0x51, 0x17, 0xea, 0x33, 0xcf, 0xd1, 0xf0, 0x98, 0xda, 0xe7, 0x88, 0xdb, 0x03, 0xf7,
+	0x8b, 0x1e, 0xf6, 0x30, 0x1a, 0x62, 0x6f, 0x0b, 0xa6, 0x90, 0x29, 0x41, 0x31, 0xe1, 0xcc, 0x94,
+	0xc8, 0xf0, 0x19, 0x0d, 0x6c, 0xfd, 0xd4, 0x32, 0x07, 0xfa, 0x87, 0x03, 0xb3, 0x07, 0x2c, 0xfa,
+	0xbf, 0xb6, 0x7d, 0x8e, 0xb8, 0xd2, 0xcb, 0x13, 0x77, 0xc0, 0x46, 0x27, 0xee, 0x80, 0x3d, 0x9b,
+	0xb8, 0x6f, 0xcb, 0xe0, 0x7e, 0x9c, 0x58, 0x66, 0x87, 0xfd, 0x09, 0x3b, 0xe4, 0xee, 0x3e, 0x2c,
+	0xb5, 0x62, 0xde, 0x24, 0xf1, 0xf9, 0x06, 0x72, 0x46, 0x6c, 0xa0, 0x05, 0xe3, 0x3e, 0xa4, 0x72,
+	0xbf, 0x82, 0x65, 0xc5, 0x15, 0x89, 0x83, 0xc1, 0xd1, 0xd8, 0xb9, 0x57, 0xd4, 0xb0, 0xaf, 0x5d,
+	0xca, 0x4a, 0x03, 0xc3, 0x1c, 0x31, 0x8b, 0x1a, 0xa1, 0x91, 0x02, 0xec, 0x99, 0x59, 0xf7, 0x19,
+	0x0c, 0x48, 0x4f, 0x31, 0x4b, 0x23, 0x63, 0x5e, 0xcd, 0x7c, 0x2d, 0x5c, 0x08, 0xab, 0x4f, 0x19,
+	0x21, 0x54, 0x0f, 0xe9, 0x04, 0xb8, 0x3a, 0xc4, 0xc1, 0x4d, 0x29, 0x51, 0x5d, 0x46, 0x84, 0x77,
+	0xe9, 0x24, 0xa1, 0x28, 0xdd, 0x6f, 0x60, 0xa1, 0xc7, 0x48, 0x1c, 0xf3, 0x90, 0x28, 0x8c, 0xb2,
+	0x09, 0x39, 0x31, 0xfe, 0x09, 0xe9, 0xe6, 0xf6, 0xb1, 0x53, 0x32, 0x57, 0x02, 0x4f, 0x1c, 0x00,
+	0x23, 0x6d, 0xf4, 0xa4, 0x72, 0x11, 0xca, 0x51, 0x4f, 0x2a, 0xcf, 0x19, 0x81, 0xbe, 0x2d, 0x1b,
+	0xca, 0x5b, 0xa3, 0x5d, 0x54, 0x26, 0x1a, 0x0d, 0xef, 0x12, 0x98, 0x90, 0x27, 0xd8, 0x55, 0x5e,
+	0x71, 0xfc, 0xf9, 0x1a, 0xe4, 0x5c, 0x8a, 0x7f, 0x39, 0xb0, 0xb0, 0xcf, 0x8f, 0x90, 0xd1, 0xaf,
+	0x31, 0xca, 0x3d, 0x0e, 0xaa, 0x30, 0xa3, 0x12, 0x71, 0x60, 0x2e, 0x65, 0x3d, 0x1f, 0x7c, 0xd0,
+	0xa2, 0x46, 0x22, 0xf9, 0x6f, 0xaf, 0xfd, 0x8b, 0x17, 0x74, 0xf9, 0x5f, 0x5d, 0xd0, 0xb9, 0x44,
+	0x7f, 0x71, 0xc0, 0xd3, 0x89, 0xee, 0xf2, 0x38, 0x42, 0x31, 0xdc, 0x7e, 0x1f, 0xc2, 0x7c, 0x5b,
+	0x8b, 0x47, 0x1e, 0x88, 0x73, 0xc6, 0x3e, 0x4d, 0xe3, 0x1c, 0x5d, 0xc5, 0x0b, 0x74, 0x5d, 0xcc,
+	0xa8, 0xf4, 0xb2, 0x19, 0xfd, 0xec, 0xc0, 0x4a, 0x36, 0x9b, 0xf4, 0xa4, 0xfa, 0x5c, 0xe0, 0x21,
+	0x0a, 0x64, 0x21, 0x3e, 0x65, 0x3e, 0x3b, 0x2f, 0x7c, 0x3e, 0x6f, 0xc0, 0x7c, 0xd2, 0x20, 0x27,
+	0x18, 0x99, 0xd4, 0xcc, 0x40, 0xba, 0xe2, 0xcf, 0x59, 0xa9, 0xce, 0x4e, 0x9b, 0x35, 0x63, 0x1e,
+	0x1e, 0x0d, 0xcc, 0x4a, 0xc6, 0xcc, 0x4a, 0x8d, 0x59, 0x2e, 0xf8, 0x1f, 0x8a, 0xe0, 0x0d, 0x07,
+	0xbf, 0xc3, 0x3b, 0x1d, 0x2a, 0xa5, 0xbd, 0xa2, 0xc6, 0x11, 0xfb, 0x2e, 0x40, 0x98, 0x81, 0xea,
+	0x33, 0x99, 0xb9, 0xb1, 0x9e, 0x76, 0x53, 0xfa, 0x34, 0x1e, 0x34, 0x54, 0x6a, 0x69, 0x79, 0xcf,
+	0xf9, 0xba, 0x08, 0x53, 0x24, 0x0c, 0x45, 0x0f, 0x23, 0xaf, 0x34, 0xfe, 0xa6, 0x4c, 0xb1, 0x73,
+	0xf4, 0x10, 0x58, 0x36, 0xb5, 0xb0, 0x87, 0x4a, 0xc5, 0xd8, 0x41, 0xa6, 0x76, 0x7a, 0x42, 0x72,
+	0xe1, 0xae, 0xc0, 0x34, 0xc3, 0xbb, 0x2a, 0x38, 0xc2, 0xbe, 0xa6, 0x64, 0xd6, 0x9f, 0x4a, 0xd6,
+	0x9f, 0x62, 0xdf, 0x7d, 0x1b, 0x5c, 0x79, 0x82, 0xd8, 0x0d, 0xa4, 0x22, 0x42, 0xa5, 0xef, 0xd0,
+	0xa2, 0x7e, 0x87, 0x5e, 0xd3, 0x9a, 0xbd, 0x44, 0x61, 0x9e, 0xa0, 0xdb, 0xb7, 0x1e, 0x9c, 0x56,
+	0x9c, 0x87, 0xa7, 0x15, 0xe7, 0xc9, 0x69, 0xc5, 0xf9, 0xfe, 0xac, 0x52, 0x78, 0x78, 0x56, 0x29,
+	0xfc, 0x7a, 0x56, 0x29, 0xdc, 0xd9, 0xcc, 0x45, 0xae, 0xeb, 0x72, 0x93, 0x1f, 0x1e, 0xd2, 0x90,
+	0x92, 0xd8, 0x2c, 0xeb, 0x77, 0xed, 0x57, 0x27, 0xd1, 0x9c, 0xd4, 0xbf, 0x2e, 0xb6, 0xfe, 0x19,
+	0x00, 0x73, 0xc9, 0xb5, 0xd9, 0x27, 0x0d, 0x00, 0x00,
 }
 
 func (m *Delegation) Marshal() (dAtA []byte, err error) {
@@ -339,6 +644,34 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IncentiveRewardHistory) > 0 {
+		for iNdEx := len(m.IncentiveRewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.LastRewardClaimHeight != 0 {
 		i = encodeVarintDelegations(dAtA, i, uint64(m.LastRewardClaimHeight))
 		i--
@@ -587,6 +920,34 @@ func (m *FuryaValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnallocatedRewards) > 0 {
+		for iNdEx := len(m.UnallocatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnallocatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IncentiveRewardHistories) > 0 {
+		for iNdEx := len(m.IncentiveRewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ValidatorShares) > 0 {
 		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -632,57 +993,366 @@ func (m *FuryaValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegations(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegations(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RewardDust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RewardDust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = m.Shares.Size()
-	n += 1 + l + sovDelegations(uint64(l))
-	if len(m.RewardHistory) > 0 {
-		for _, e := range m.RewardHistory {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.LastRewardClaimHeight != 0 {
-		n += 1 + sovDelegations(uint64(m.LastRewardClaimHeight))
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenizedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *TokenizedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenDenom) > 0 {
+		i -= len(m.TokenDenom)
+		copy(dAtA[i:], m.TokenDenom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.TokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenHolderRewardHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenHolderRewardHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenHolderRewardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TokenDenom) > 0 {
+		i -= len(m.TokenDenom)
+		copy(dAtA[i:], m.TokenDenom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.TokenDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorFuryaPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorFuryaPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorFuryaPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintDelegations(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintDelegations(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorFuryaCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorFuryaCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorFuryaCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSettlementCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSettlementCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSettlementCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SweepStartHeight != 0 {
+		i = encodeVarintDelegations(dAtA, i, uint64(m.SweepStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegations(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegations(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if m.LastRewardClaimHeight != 0 {
+		n += 1 + sovDelegations(uint64(m.LastRewardClaimHeight))
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.IncentiveRewardHistory) > 0 {
+		for _, e := range m.IncentiveRewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
 		n += 1 + l + sovDelegations(uint64(l))
 	}
 	l = len(m.SrcValidatorAddress)
@@ -759,28 +1429,968 @@ func (m *FuryaValidatorInfo) Size() (n int) {
 			n += 1 + l + sovDelegations(uint64(l))
 		}
 	}
-	if len(m.TotalDelegatorShares) > 0 {
-		for _, e := range m.TotalDelegatorShares {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
-		}
+	if len(m.TotalDelegatorShares) > 0 {
+		for _, e := range m.TotalDelegatorShares {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.ValidatorShares) > 0 {
+		for _, e := range m.ValidatorShares {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.IncentiveRewardHistories) > 0 {
+		for _, e := range m.IncentiveRewardHistories {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.UnallocatedRewards) > 0 {
+		for _, e := range m.UnallocatedRewards {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenDenom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenHolderRewardHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.TokenDenom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorFuryaPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorFuryaCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardSettlementCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if m.SweepStartHeight != 0 {
+		n += 1 + sovDelegations(uint64(m.SweepStartHeight))
+	}
+	return n
+}
+
+func sovDelegations(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegations(x uint64) (n int) {
+	return sovDelegations(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardClaimHeight", wireType)
+			}
+			m.LastRewardClaimHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardClaimHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.Coin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRewardHistory = append(m.IncentiveRewardHistory, RewardHistory{})
+			if err := m.IncentiveRewardHistory[len(m.IncentiveRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Redelegation{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Undelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Undelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Undelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.ValidatorShares) > 0 {
-		for _, e := range m.ValidatorShares {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
+	return nil
+}
+func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Undelegation{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovDelegations(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDelegations(x uint64) (n int) {
-	return sovDelegations(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *FuryaValidatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -803,17 +2413,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: FuryaValidatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FuryaValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRewardHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -823,29 +2433,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.GlobalRewardHistory = append(m.GlobalRewardHistory, RewardHistory{})
+			if err := m.GlobalRewardHistory[len(m.GlobalRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -855,29 +2467,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TotalDelegatorShares = append(m.TotalDelegatorShares, types.DecCoin{})
+			if err := m.TotalDelegatorShares[len(m.TotalDelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -887,29 +2501,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
+			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRewardHistories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -919,29 +2535,29 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentiveRewardHistories = append(m.IncentiveRewardHistories, AssetRewardHistory{})
+			if err := m.IncentiveRewardHistories[len(m.IncentiveRewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnallocatedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -968,16 +2584,66 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
-			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnallocatedRewards = append(m.UnallocatedRewards, types.Coin{})
+			if err := m.UnallocatedRewards[len(m.UnallocatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardClaimHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastRewardClaimHeight = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardDust) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardDust: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardDust: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -987,11 +2653,60 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRewardClaimHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, types.DecCoin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swept = append(m.Swept, types.Coin{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegations(dAtA[iNdEx:])
@@ -1013,7 +2728,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *TokenizedDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,15 +2751,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizedDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1072,11 +2787,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1104,11 +2819,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1136,11 +2851,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1167,7 +2882,8 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1192,7 +2908,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
+func (m *TokenHolderRewardHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1215,15 +2931,79 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedRedelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenHolderRewardHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenHolderRewardHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1250,8 +3030,8 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &Redelegation{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1276,7 +3056,7 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Undelegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorFuryaPreferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,15 +3079,15 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Undelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorFuryaPreferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Undelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorFuryaPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1335,11 +3115,11 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1367,13 +3147,13 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -1383,24 +3163,23 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1423,7 +3202,7 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorFuryaCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1446,15 +3225,47 @@ func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorFuryaCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorFuryaCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1481,8 +3292,41 @@ func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &Undelegation{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1507,7 +3351,7 @@ func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FuryaValidatorInfo) Unmarshal(dAtA []byte) error {
+func (m *RewardSettlementCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1530,17 +3374,17 @@ func (m *FuryaValidatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FuryaValidatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardSettlementCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FuryaValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardSettlementCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRewardHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -1550,65 +3394,31 @@ func (m *FuryaValidatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalRewardHistory = append(m.GlobalRewardHistory, RewardHistory{})
-			if err := m.GlobalRewardHistory[len(m.GlobalRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorShares", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDelegations
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDelegations
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegations
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalDelegatorShares = append(m.TotalDelegatorShares, types.DecCoin{})
-			if err := m.TotalDelegatorShares[len(m.TotalDelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepStartHeight", wireType)
 			}
-			var msglen int
+			m.SweepStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -1618,26 +3428,11 @@ func (m *FuryaValidatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SweepStartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDelegations
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDelegations
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
-			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegations(dAtA[iNdEx:])
@@ -5,10 +5,16 @@ import sdkerrors "cosmossdk.io/errors"
 var (
 	ErrInvalidGenesisState = sdkerrors.Register(ModuleName, 0, "invalid genesis state")
 
-	ErrEmptyValidatorAddr = sdkerrors.Register(ModuleName, 10, "empty validator address")
-	ErrValidatorNotFound  = sdkerrors.Register(ModuleName, 11, "validator not found")
+	ErrEmptyValidatorAddr  = sdkerrors.Register(ModuleName, 10, "empty validator address")
+	ErrValidatorNotFound   = sdkerrors.Register(ModuleName, 11, "validator not found")
+	ErrAssetNotAllowed     = sdkerrors.Register(ModuleName, 12, "furya asset is not allowed by the validator")
+	ErrCommissionImmutable = sdkerrors.Register(ModuleName, 13, "furya commission max rate and max change rate cannot be changed")
 
 	ErrZeroDelegations = sdkerrors.Register(ModuleName, 20, "there are no delegations yet")
 
-	ErrUnknownAsset = sdkerrors.Register(ModuleName, 30, "furya asset is not whitelisted")
+	ErrUnknownAsset          = sdkerrors.Register(ModuleName, 30, "furya asset is not whitelisted")
+	ErrBelowMinDelegation    = sdkerrors.Register(ModuleName, 31, "amount is below the minimum delegation amount of the furya asset")
+	ErrAssetCapacityExceeded = sdkerrors.Register(ModuleName, 32, "amount exceeds the remaining capacity of the furya asset")
+
+	ErrUnauthorizedPriceFeeder = sdkerrors.Register(ModuleName, 40, "address is not a furya price feeder")
 )
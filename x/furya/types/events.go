@@ -1,14 +1,50 @@
 package types
 
 const (
-	EventTypeDelegate               = "delegate"
-	EventTypeUndelegate             = "undelegate"
-	EventTypeRedelegate             = "redelegate"
-	EventTypeClaimDelegationRewards = "claim_delegation_rewards"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUndelegate                  = "undelegate"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeClaimDelegationRewards      = "claim_delegation_rewards"
+	EventTypeCancelUndelegation          = "cancel_undelegation"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeTransferDelegation          = "transfer_delegation"
+	EventTypeTokenizeDelegation          = "tokenize_delegation"
+	EventTypeRedeemTokens                = "redeem_tokens"
+	EventTypeClaimTokenRewards           = "claim_token_rewards"
+	EventTypeSetValidatorPreferences     = "set_validator_furya_preferences"
+	EventTypeForceUndelegate             = "force_undelegate"
+	EventTypeSetValidatorCommission      = "set_validator_furya_commission"
+	EventTypeWithdrawValidatorCommission = "withdraw_validator_furya_commission"
+	EventTypeSettleRewards               = "settle_furya_rewards"
+	EventTypePostPrices                  = "post_furya_prices"
+	EventTypeCreateIncentive             = "create_furya_incentive"
+	EventTypeFinishIncentive             = "finish_furya_incentive"
+	EventTypeSweepUnallocatedRewards     = "sweep_furya_unallocated_rewards"
+	EventTypeSweepRewardDust             = "sweep_furya_reward_dust"
 
-	AttributeKeyValidator      = "validator"
-	AttributeKeySrcValidator   = "source_validator"
-	AttributeKeyDstValidator   = "destination_validator"
-	AttributeKeyCompletionTime = "completion_time"
-	AttributeKeyNewShares      = "new_shares"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeySrcValidator    = "source_validator"
+	AttributeKeyDstValidator    = "destination_validator"
+	AttributeKeyCompletionTime  = "completion_time"
+	AttributeKeyNewShares       = "new_shares"
+	AttributeKeyWithdrawAddress = "withdraw_address"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyShares          = "shares"
+	AttributeKeyToken           = "token"
+	AttributeKeyAllowedDenoms   = "allowed_denoms"
+	AttributeKeyBlockedDenoms   = "blocked_denoms"
+	AttributeKeyCommissionRate  = "commission_rate"
+	AttributeKeySettled         = "settled"
+	AttributeKeyPrunedSnapshots = "pruned_snapshots"
+	AttributeKeyFeeder          = "feeder"
+	AttributeKeyPrices          = "prices"
+	AttributeKeyIncentiveID     = "incentive_id"
+	AttributeKeyFunder          = "funder"
+	AttributeKeyStartTime       = "start_time"
+	AttributeKeyEndTime         = "end_time"
+	AttributeKeyRefund          = "refund"
 )
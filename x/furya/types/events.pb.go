@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFuryaTakeRate is emitted when the take rate is deducted from the furya assets
+type EventFuryaTakeRate struct {
+	// Tokens deducted from each asset
+	Deducted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deducted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deducted"`
+	Payouts  []TakeRatePayout                         `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts"`
+}
+
+func (m *EventFuryaTakeRate) Reset()         { *m = EventFuryaTakeRate{} }
+func (m *EventFuryaTakeRate) String() string { return proto.CompactTextString(m) }
+func (*EventFuryaTakeRate) ProtoMessage()    {}
+func (*EventFuryaTakeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81b1fd98399a9ba4, []int{0}
+}
+func (m *EventFuryaTakeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFuryaTakeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFuryaTakeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFuryaTakeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFuryaTakeRate.Merge(m, src)
+}
+func (m *EventFuryaTakeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFuryaTakeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFuryaTakeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFuryaTakeRate proto.InternalMessageInfo
+
+func (m *EventFuryaTakeRate) GetDeducted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deducted
+	}
+	return nil
+}
+
+func (m *EventFuryaTakeRate) GetPayouts() []TakeRatePayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// TakeRatePayout is the share of the deducted tokens sent to a take rate destination
+type TakeRatePayout struct {
+	Type   TakeRateDestinationType                  `protobuf:"varint,1,opt,name=type,proto3,enum=furya.furya.TakeRateDestinationType" json:"type,omitempty"`
+	Target string                                   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *TakeRatePayout) Reset()         { *m = TakeRatePayout{} }
+func (m *TakeRatePayout) String() string { return proto.CompactTextString(m) }
+func (*TakeRatePayout) ProtoMessage()    {}
+func (*TakeRatePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81b1fd98399a9ba4, []int{1}
+}
+func (m *TakeRatePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakeRatePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakeRatePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakeRatePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeRatePayout.Merge(m, src)
+}
+func (m *TakeRatePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakeRatePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeRatePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakeRatePayout proto.InternalMessageInfo
+
+func (m *TakeRatePayout) GetType() TakeRateDestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (m *TakeRatePayout) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TakeRatePayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventFuryaSlash is emitted when a slashing event of a validator is applied to its furya delegations
+type EventFuryaSlash struct {
+	Slash FuryaSlash `protobuf:"bytes,1,opt,name=slash,proto3" json:"slash"`
+}
+
+func (m *EventFuryaSlash) Reset()         { *m = EventFuryaSlash{} }
+func (m *EventFuryaSlash) String() string { return proto.CompactTextString(m) }
+func (*EventFuryaSlash) ProtoMessage()    {}
+func (*EventFuryaSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81b1fd98399a9ba4, []int{2}
+}
+func (m *EventFuryaSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFuryaSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFuryaSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFuryaSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFuryaSlash.Merge(m, src)
+}
+func (m *EventFuryaSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFuryaSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFuryaSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFuryaSlash proto.InternalMessageInfo
+
+func (m *EventFuryaSlash) GetSlash() FuryaSlash {
+	if m != nil {
+		return m.Slash
+	}
+	return FuryaSlash{}
+}
+
+func init() {
+	proto.RegisterType((*EventFuryaTakeRate)(nil), "furya.furya.EventFuryaTakeRate")
+	proto.RegisterType((*TakeRatePayout)(nil), "furya.furya.TakeRatePayout")
+	proto.RegisterType((*EventFuryaSlash)(nil), "furya.furya.EventFuryaSlash")
+}
+
+func init() { proto.RegisterFile("furya/events.proto", fileDescriptor_81b1fd98399a9ba4) }
+
+var fileDescriptor_81b1fd98399a9ba4 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0x6e, 0xda, 0x30,
+	0x18, 0x8e, 0x81, 0xb1, 0xcd, 0x48, 0x4c, 0xb3, 0xa6, 0x2d, 0x63, 0x52, 0x40, 0x68, 0x87, 0x5c,
+	0xb0, 0x07, 0x5c, 0x26, 0xf5, 0x46, 0x5b, 0x7a, 0xad, 0x52, 0x4e, 0xbd, 0x39, 0x89, 0x09, 0x11,
+	0x24, 0x8e, 0x62, 0x07, 0x35, 0x6f, 0xd1, 0xe7, 0xe8, 0x23, 0xf4, 0x05, 0xca, 0x91, 0x63, 0x4f,
+	0x6d, 0x05, 0x2f, 0x52, 0xc5, 0x31, 0x05, 0xa4, 0x1e, 0x7b, 0x89, 0xe3, 0xcf, 0xdf, 0xff, 0x7d,
+	0xdf, 0xef, 0xdf, 0x10, 0x4d, 0xb3, 0x34, 0xa7, 0x84, 0x2d, 0x59, 0x2c, 0x05, 0x4e, 0x52, 0x2e,
+	0x39, 0x6a, 0x28, 0x0c, 0xab, 0x6f, 0xeb, 0x47, 0xc0, 0x03, 0xae, 0x70, 0x52, 0xfc, 0x95, 0x94,
+	0x96, 0xe5, 0x71, 0x11, 0x71, 0x41, 0x5c, 0x2a, 0x18, 0x59, 0xf6, 0x5d, 0x26, 0x69, 0x9f, 0x78,
+	0x3c, 0x8c, 0xf5, 0xb9, 0x96, 0x4d, 0x68, 0x4a, 0x23, 0x2d, 0xdb, 0xfa, 0x5e, 0x62, 0x62, 0x41,
+	0xc5, 0xac, 0x84, 0xba, 0xf7, 0x00, 0xa2, 0xf3, 0xc2, 0x7a, 0x5c, 0x1c, 0x4d, 0xe8, 0x9c, 0x39,
+	0x54, 0x32, 0x14, 0xc0, 0x2f, 0x3e, 0xf3, 0x33, 0x4f, 0x32, 0xdf, 0x04, 0x9d, 0xaa, 0xdd, 0x18,
+	0xfc, 0xc6, 0xa5, 0x21, 0x2e, 0x0c, 0xb1, 0x36, 0xc4, 0xa7, 0x3c, 0x8c, 0x47, 0xff, 0x56, 0x4f,
+	0x6d, 0xe3, 0xee, 0xb9, 0x6d, 0x07, 0xa1, 0x9c, 0x65, 0x2e, 0xf6, 0x78, 0x44, 0x74, 0xba, 0x72,
+	0xe9, 0x09, 0x7f, 0x4e, 0x64, 0x9e, 0x30, 0xa1, 0x0a, 0x84, 0xf3, 0x26, 0x8e, 0x4e, 0xe0, 0xe7,
+	0x84, 0xe6, 0x3c, 0x93, 0xc2, 0xac, 0x28, 0x9f, 0x3f, 0xf8, 0xa0, 0x77, 0xbc, 0x0b, 0x74, 0xa9,
+	0x38, 0xa3, 0x5a, 0xe1, 0xe4, 0xec, 0x2a, 0xba, 0x0f, 0x00, 0x36, 0x8f, 0x19, 0xe8, 0x3f, 0xac,
+	0x15, 0x46, 0x26, 0xe8, 0x00, 0xbb, 0x39, 0xf8, 0xfb, 0xae, 0xd8, 0x19, 0x13, 0x32, 0x8c, 0xa9,
+	0x0c, 0x79, 0x3c, 0xc9, 0x13, 0xe6, 0xa8, 0x0a, 0xf4, 0x13, 0xd6, 0x25, 0x4d, 0x03, 0x26, 0xcd,
+	0x4a, 0x07, 0xd8, 0x5f, 0x1d, 0xbd, 0x43, 0x1e, 0xac, 0xd3, 0x88, 0x67, 0xb1, 0x34, 0xab, 0x1f,
+	0x7f, 0x11, 0x5a, 0xba, 0x3b, 0x86, 0xdf, 0xf6, 0x53, 0xb8, 0x2a, 0xe6, 0x83, 0x86, 0xf0, 0x93,
+	0x1a, 0x94, 0x6a, 0xa5, 0x31, 0xf8, 0x75, 0xd4, 0xca, 0x9e, 0xa7, 0xef, 0xa4, 0xe4, 0x8e, 0x2e,
+	0x56, 0x1b, 0x0b, 0xac, 0x37, 0x16, 0x78, 0xd9, 0x58, 0xe0, 0x76, 0x6b, 0x19, 0xeb, 0xad, 0x65,
+	0x3c, 0x6e, 0x2d, 0xe3, 0xba, 0x77, 0x90, 0x49, 0x69, 0xf4, 0xf8, 0x74, 0x1a, 0x7a, 0x21, 0x5d,
+	0x94, 0x5b, 0x72, 0xa3, 0x57, 0x15, 0xcf, 0xad, 0xab, 0xe7, 0x31, 0x7c, 0x1d, 0x00, 0xe0, 0xa0,
+	0x17, 0xde, 0x9e, 0x02, 0x00, 0x00,
+}
+
+func (m *EventFuryaTakeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFuryaTakeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFuryaTakeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Deducted) > 0 {
+		for iNdEx := len(m.Deducted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deducted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakeRatePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakeRatePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakeRatePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFuryaSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFuryaSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFuryaSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slash.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFuryaTakeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deducted) > 0 {
+		for _, e := range m.Deducted {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakeRatePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventFuryaSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Slash.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFuryaTakeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFuryaTakeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFuryaTakeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deducted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deducted = append(m.Deducted, types.Coin{})
+			if err := m.Deducted[len(m.Deducted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, TakeRatePayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakeRatePayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeRatePayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeRatePayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TakeRateDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFuryaSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFuryaSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFuryaSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
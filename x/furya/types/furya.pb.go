@@ -30,6 +30,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardWeightInterpolation defines how the reward weight moves between two breakpoints
+type RewardWeightInterpolation int32
+
+const (
+	RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_UNSPECIFIED RewardWeightInterpolation = 0
+	// REWARD_WEIGHT_INTERPOLATION_STEP keeps the weight of a breakpoint until the next breakpoint
+	RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_STEP RewardWeightInterpolation = 1
+	// REWARD_WEIGHT_INTERPOLATION_LINEAR moves the weight linearly from a breakpoint to the next one
+	RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_LINEAR RewardWeightInterpolation = 2
+)
+
+var RewardWeightInterpolation_name = map[int32]string{
+	0: "REWARD_WEIGHT_INTERPOLATION_UNSPECIFIED",
+	1: "REWARD_WEIGHT_INTERPOLATION_STEP",
+	2: "REWARD_WEIGHT_INTERPOLATION_LINEAR",
+}
+
+var RewardWeightInterpolation_value = map[string]int32{
+	"REWARD_WEIGHT_INTERPOLATION_UNSPECIFIED": 0,
+	"REWARD_WEIGHT_INTERPOLATION_STEP":        1,
+	"REWARD_WEIGHT_INTERPOLATION_LINEAR":      2,
+}
+
+func (x RewardWeightInterpolation) String() string {
+	return proto.EnumName(RewardWeightInterpolation_name, int32(x))
+}
+
+func (RewardWeightInterpolation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{0}
+}
+
 // key: denom value: FuryaAsset
 type FuryaAsset struct {
 	// Denom of the asset. It could either be a native token or an IBC token
@@ -47,13 +78,30 @@ type FuryaAsset struct {
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,8,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
 	LastRewardChangeTime time.Time                              `protobuf:"bytes,9,opt,name=last_reward_change_time,json=lastRewardChangeTime,proto3,stdtime" json:"last_reward_change_time"`
+	// Smallest amount that can be delegated or redelegated at once. Unset means no minimum
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// Maximum amount of tokens that can be delegated in total. Unset means no cap
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Derives the reward weight from the prices posted by the price feeders. Unset means the reward weight is only
+	// changed by governance and the reward change rate
+	PricePeg *RewardWeightPricePeg `protobuf:"bytes,12,opt,name=price_peg,json=pricePeg,proto3" json:"price_peg,omitempty"`
+	// Lowest reward weight the reward change rate can decay the asset to. Unset means no floor
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Highest reward weight the reward change rate can inflate the asset to. Unset means no ceiling
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Sets the reward weight from governance defined breakpoints. Unset means the reward weight is only
+	// changed by governance and the reward change rate
+	RewardWeightSchedule *RewardWeightSchedule `protobuf:"bytes,15,opt,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule,omitempty"`
+	// Multiplies the fraction delegations of the asset are slashed by, slashing at most all of them. Unset means a
+	// multiplier of 1
+	SlashMultiplier *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_multiplier,json=slashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_multiplier,omitempty"`
 }
 
 func (m *FuryaAsset) Reset()         { *m = FuryaAsset{} }
 func (m *FuryaAsset) String() string { return proto.CompactTextString(m) }
 func (*FuryaAsset) ProtoMessage()    {}
 func (*FuryaAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7dbf17f28cd0f90, []int{0}
+	return fileDescriptor_1d089745b6dc3a29, []int{0}
 }
 func (m *FuryaAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -82,6 +130,90 @@ func (m *FuryaAsset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FuryaAsset proto.InternalMessageInfo
 
+// RewardWeightPricePeg sets the reward weight of an asset to target_value_ratio * asset price / native price
+// so that the weight follows the value of the asset relative to the native token
+type RewardWeightPricePeg struct {
+	TargetValueRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_value_ratio,json=targetValueRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_value_ratio"`
+	// Lowest reward weight that can be set by the peg
+	MinWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_weight,json=minWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_weight"`
+	// Highest reward weight that can be set by the peg
+	MaxWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_weight,json=maxWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_weight"`
+}
+
+func (m *RewardWeightPricePeg) Reset()         { *m = RewardWeightPricePeg{} }
+func (m *RewardWeightPricePeg) String() string { return proto.CompactTextString(m) }
+func (*RewardWeightPricePeg) ProtoMessage()    {}
+func (*RewardWeightPricePeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{1}
+}
+func (m *RewardWeightPricePeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeightPricePeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeightPricePeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeightPricePeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeightPricePeg.Merge(m, src)
+}
+func (m *RewardWeightPricePeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeightPricePeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeightPricePeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeightPricePeg proto.InternalMessageInfo
+
+// FuryaPrice is the latest price of a denom posted by a price feeder
+type FuryaPrice struct {
+	Denom         string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Price         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	FeederAddress string                                 `protobuf:"bytes,3,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
+	UpdateTime    time.Time                              `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time"`
+}
+
+func (m *FuryaPrice) Reset()         { *m = FuryaPrice{} }
+func (m *FuryaPrice) String() string { return proto.CompactTextString(m) }
+func (*FuryaPrice) ProtoMessage()    {}
+func (*FuryaPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{2}
+}
+func (m *FuryaPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FuryaPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaPrice.Merge(m, src)
+}
+func (m *FuryaPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FuryaPrice proto.InternalMessageInfo
+
 type RewardWeightChangeSnapshot struct {
 	PrevRewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=prev_reward_weight,json=prevRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"prev_reward_weight"`
 	RewardHistories  []RewardHistory                        `protobuf:"bytes,2,rep,name=reward_histories,json=rewardHistories,proto3" json:"reward_histories"`
@@ -91,7 +223,7 @@ func (m *RewardWeightChangeSnapshot) Reset()         { *m = RewardWeightChangeSn
 func (m *RewardWeightChangeSnapshot) String() string { return proto.CompactTextString(m) }
 func (*RewardWeightChangeSnapshot) ProtoMessage()    {}
 func (*RewardWeightChangeSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7dbf17f28cd0f90, []int{1}
+	return fileDescriptor_1d089745b6dc3a29, []int{3}
 }
 func (m *RewardWeightChangeSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,55 +252,376 @@ func (m *RewardWeightChangeSnapshot) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RewardWeightChangeSnapshot proto.InternalMessageInfo
 
+// RewardIndexSample is an entry of the ring buffer of reward indices kept per validator to estimate the furya APR
+type RewardIndexSample struct {
+	Time            time.Time       `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	RewardHistories []RewardHistory `protobuf:"bytes,2,rep,name=reward_histories,json=rewardHistories,proto3" json:"reward_histories"`
+}
+
+func (m *RewardIndexSample) Reset()         { *m = RewardIndexSample{} }
+func (m *RewardIndexSample) String() string { return proto.CompactTextString(m) }
+func (*RewardIndexSample) ProtoMessage()    {}
+func (*RewardIndexSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{4}
+}
+func (m *RewardIndexSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndexSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndexSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndexSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndexSample.Merge(m, src)
+}
+func (m *RewardIndexSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndexSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndexSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndexSample proto.InternalMessageInfo
+
+// RewardIndexSampleHead points to the slot of the ring buffer of reward index samples that is written next
+type RewardIndexSampleHead struct {
+	NextSlot       uint64    `protobuf:"varint,1,opt,name=next_slot,json=nextSlot,proto3" json:"next_slot,omitempty"`
+	LastSampleTime time.Time `protobuf:"bytes,2,opt,name=last_sample_time,json=lastSampleTime,proto3,stdtime" json:"last_sample_time"`
+}
+
+func (m *RewardIndexSampleHead) Reset()         { *m = RewardIndexSampleHead{} }
+func (m *RewardIndexSampleHead) String() string { return proto.CompactTextString(m) }
+func (*RewardIndexSampleHead) ProtoMessage()    {}
+func (*RewardIndexSampleHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{5}
+}
+func (m *RewardIndexSampleHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndexSampleHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndexSampleHead.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndexSampleHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndexSampleHead.Merge(m, src)
+}
+func (m *RewardIndexSampleHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndexSampleHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndexSampleHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndexSampleHead proto.InternalMessageInfo
+
+// RewardWeightSchedule moves the reward weight of an asset through a list of breakpoints
+type RewardWeightSchedule struct {
+	// Breakpoints ordered by strictly increasing time
+	Breakpoints   []RewardWeightBreakpoint  `protobuf:"bytes,1,rep,name=breakpoints,proto3" json:"breakpoints"`
+	Interpolation RewardWeightInterpolation `protobuf:"varint,2,opt,name=interpolation,proto3,enum=furya.furya.RewardWeightInterpolation" json:"interpolation,omitempty"`
+	// How often the reward weight is updated between two breakpoints of a linear schedule
+	UpdateInterval time.Duration `protobuf:"bytes,3,opt,name=update_interval,json=updateInterval,proto3,stdduration" json:"update_interval"`
+}
+
+func (m *RewardWeightSchedule) Reset()         { *m = RewardWeightSchedule{} }
+func (m *RewardWeightSchedule) String() string { return proto.CompactTextString(m) }
+func (*RewardWeightSchedule) ProtoMessage()    {}
+func (*RewardWeightSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{6}
+}
+func (m *RewardWeightSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeightSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeightSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeightSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeightSchedule.Merge(m, src)
+}
+func (m *RewardWeightSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeightSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeightSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeightSchedule proto.InternalMessageInfo
+
+func (m *RewardWeightSchedule) GetBreakpoints() []RewardWeightBreakpoint {
+	if m != nil {
+		return m.Breakpoints
+	}
+	return nil
+}
+
+func (m *RewardWeightSchedule) GetInterpolation() RewardWeightInterpolation {
+	if m != nil {
+		return m.Interpolation
+	}
+	return RewardWeightInterpolation_REWARD_WEIGHT_INTERPOLATION_UNSPECIFIED
+}
+
+func (m *RewardWeightSchedule) GetUpdateInterval() time.Duration {
+	if m != nil {
+		return m.UpdateInterval
+	}
+	return 0
+}
+
+// RewardWeightBreakpoint is the reward weight an asset has at a given time
+type RewardWeightBreakpoint struct {
+	Time         time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+}
+
+func (m *RewardWeightBreakpoint) Reset()         { *m = RewardWeightBreakpoint{} }
+func (m *RewardWeightBreakpoint) String() string { return proto.CompactTextString(m) }
+func (*RewardWeightBreakpoint) ProtoMessage()    {}
+func (*RewardWeightBreakpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d089745b6dc3a29, []int{7}
+}
+func (m *RewardWeightBreakpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeightBreakpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeightBreakpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeightBreakpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeightBreakpoint.Merge(m, src)
+}
+func (m *RewardWeightBreakpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeightBreakpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeightBreakpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeightBreakpoint proto.InternalMessageInfo
+
+func (m *RewardWeightBreakpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("furya.furya.RewardWeightInterpolation", RewardWeightInterpolation_name, RewardWeightInterpolation_value)
 	proto.RegisterType((*FuryaAsset)(nil), "furya.furya.FuryaAsset")
+	proto.RegisterType((*RewardWeightPricePeg)(nil), "furya.furya.RewardWeightPricePeg")
+	proto.RegisterType((*FuryaPrice)(nil), "furya.furya.FuryaPrice")
 	proto.RegisterType((*RewardWeightChangeSnapshot)(nil), "furya.furya.RewardWeightChangeSnapshot")
+	proto.RegisterType((*RewardIndexSample)(nil), "furya.furya.RewardIndexSample")
+	proto.RegisterType((*RewardIndexSampleHead)(nil), "furya.furya.RewardIndexSampleHead")
+	proto.RegisterType((*RewardWeightSchedule)(nil), "furya.furya.RewardWeightSchedule")
+	proto.RegisterType((*RewardWeightBreakpoint)(nil), "furya.furya.RewardWeightBreakpoint")
 }
 
-func init() { proto.RegisterFile("furya/furya.proto", fileDescriptor_f7dbf17f28cd0f90) }
-
-var fileDescriptor_f7dbf17f28cd0f90 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3d, 0x4f, 0xdb, 0x5c,
-	0x14, 0xc7, 0x6d, 0xde, 0x9e, 0x70, 0x01, 0x3d, 0x60, 0xa5, 0x60, 0x32, 0xd8, 0x51, 0x06, 0xc4,
-	0x82, 0x5d, 0xd1, 0x0d, 0x75, 0x21, 0x65, 0x80, 0x76, 0x69, 0x1d, 0xd4, 0xaa, 0x2f, 0x92, 0x75,
-	0x89, 0x6f, 0x6d, 0x0b, 0xdb, 0xd7, 0xba, 0xf7, 0x00, 0xcd, 0x37, 0xe8, 0x88, 0x3a, 0x75, 0xe4,
-	0x43, 0xf4, 0x43, 0x30, 0xa2, 0x2e, 0xad, 0x3a, 0xa4, 0x55, 0xb2, 0x74, 0xee, 0x27, 0xa8, 0xee,
-	0x8b, 0x43, 0x52, 0x26, 0x32, 0xf9, 0x5c, 0x9f, 0x73, 0x7e, 0xe7, 0xef, 0xff, 0xb9, 0x32, 0xda,
-	0xc0, 0x59, 0x96, 0xe2, 0xa2, 0x4b, 0xfc, 0x2a, 0xf0, 0x4a, 0x46, 0x81, 0x5a, 0x6b, 0xa3, 0x73,
-	0x15, 0x34, 0xea, 0x31, 0x8d, 0xa9, 0xcc, 0xfa, 0x22, 0x52, 0x85, 0x8d, 0xcd, 0x2e, 0xe5, 0x39,
-	0xe5, 0xa1, 0x4a, 0xa8, 0x83, 0x4e, 0x3d, 0x18, 0xc1, 0x4b, 0xcc, 0x70, 0x5e, 0xbd, 0x76, 0x62,
-	0x4a, 0xe3, 0x8c, 0xf8, 0xf2, 0x74, 0x72, 0xf6, 0xde, 0x8f, 0xce, 0x18, 0x86, 0x94, 0x16, 0x3a,
-	0xef, 0xfe, 0x9b, 0x87, 0x34, 0x27, 0x1c, 0x70, 0x5e, 0xaa, 0x82, 0xd6, 0xa7, 0x05, 0xb4, 0xb2,
-	0xaf, 0xd1, 0xfb, 0x9c, 0x13, 0xb0, 0xb6, 0xd0, 0x7c, 0x44, 0x0a, 0x9a, 0xdb, 0x66, 0xd3, 0xdc,
-	0x5e, 0x6c, 0xaf, 0xfe, 0xe9, 0xbb, 0xcb, 0x3d, 0x9c, 0x67, 0x7b, 0x2d, 0xf9, 0xba, 0x15, 0xa8,
-	0xb4, 0xd5, 0x41, 0x2b, 0x8c, 0x5c, 0x60, 0x16, 0x85, 0x17, 0x24, 0x8d, 0x13, 0xb0, 0x67, 0x64,
-	0xbd, 0x77, 0xdd, 0x77, 0x8d, 0x1f, 0x7d, 0x77, 0x2b, 0x4e, 0x21, 0x39, 0x3b, 0xf1, 0xba, 0x34,
-	0xd7, 0x5f, 0xa2, 0x1f, 0x3b, 0x3c, 0x3a, 0xf5, 0xa1, 0x57, 0x12, 0xee, 0x1d, 0x90, 0x6e, 0xb0,
-	0xac, 0x20, 0xaf, 0x24, 0xc3, 0x7a, 0x86, 0x16, 0x01, 0x9f, 0x92, 0x90, 0x61, 0x20, 0xf6, 0xec,
-	0x54, 0xc0, 0x9a, 0x00, 0x04, 0x18, 0x88, 0x15, 0xa2, 0x65, 0xa0, 0x80, 0xb3, 0x10, 0xe8, 0x29,
-	0x29, 0xb8, 0x3d, 0x27, 0x79, 0x8f, 0xef, 0xc1, 0x3b, 0x2a, 0xe0, 0xeb, 0x97, 0x1d, 0xa4, 0x37,
-	0x71, 0x54, 0x40, 0xb0, 0x24, 0x89, 0xc7, 0x12, 0x68, 0x45, 0x68, 0x5d, 0x0d, 0x38, 0xc7, 0x59,
-	0x1a, 0x61, 0xa0, 0x2c, 0xe4, 0x09, 0x66, 0x84, 0xdb, 0xf3, 0x53, 0x49, 0xaf, 0x4b, 0xda, 0xcb,
-	0x0a, 0xd6, 0x91, 0x2c, 0xeb, 0x39, 0x5a, 0xd3, 0x46, 0x73, 0xc0, 0x0c, 0x42, 0xb1, 0x42, 0x7b,
-	0xa1, 0x69, 0x6e, 0x2f, 0xed, 0x36, 0x3c, 0xb5, 0x5f, 0xaf, 0xda, 0xaf, 0x77, 0x5c, 0xed, 0xb7,
-	0x5d, 0x13, 0xc3, 0x2f, 0x7f, 0xba, 0x66, 0xf0, 0xbf, 0x6a, 0xef, 0x88, 0x6e, 0x91, 0xb7, 0xde,
-	0x21, 0x4b, 0x13, 0xbb, 0x09, 0x2e, 0x62, 0x6d, 0xf7, 0x7f, 0x53, 0x69, 0x5e, 0x55, 0xa4, 0x27,
-	0x12, 0x24, 0x6d, 0x7f, 0x8d, 0xd6, 0x27, 0xe9, 0x69, 0x01, 0x84, 0x9d, 0xe3, 0xcc, 0xae, 0x49,
-	0xd1, 0x9b, 0x77, 0x44, 0x1f, 0xe8, 0x4b, 0xab, 0x34, 0x7f, 0x16, 0x9a, 0xeb, 0xe3, 0xd8, 0x23,
-	0x0d, 0xb0, 0xde, 0xa2, 0x8d, 0x0c, 0x73, 0x08, 0x27, 0xf9, 0xd2, 0x90, 0xc5, 0x7b, 0x18, 0x52,
-	0x17, 0x90, 0x60, 0x6c, 0x80, 0x28, 0xda, 0xab, 0x7d, 0xbc, 0x72, 0x8d, 0xdf, 0x57, 0xae, 0xd1,
-	0xfa, 0x66, 0xa2, 0x46, 0x30, 0x76, 0x2d, 0x55, 0x51, 0xa7, 0xc0, 0x25, 0x4f, 0x28, 0x08, 0xfb,
-	0x4a, 0x46, 0xce, 0xc3, 0xc9, 0xeb, 0x6f, 0x4e, 0x67, 0x9f, 0x20, 0x8d, 0xcf, 0xb2, 0x5e, 0x20,
-	0x6d, 0x69, 0x98, 0xa4, 0x1c, 0x28, 0x4b, 0x09, 0xb7, 0x67, 0x9a, 0xb3, 0xdb, 0x4b, 0xbb, 0x4d,
-	0xef, 0xce, 0x8f, 0xc4, 0x53, 0xad, 0x87, 0xb2, 0xb2, 0xd7, 0x9e, 0x13, 0xd3, 0xab, 0x7d, 0x1f,
-	0x56, 0xed, 0xb7, 0x5f, 0xd6, 0x7e, 0x7a, 0x3d, 0x70, 0xcc, 0x9b, 0x81, 0x63, 0xfe, 0x1a, 0x38,
-	0xe6, 0xe5, 0xd0, 0x31, 0x6e, 0x86, 0x8e, 0xf1, 0x7d, 0xe8, 0x18, 0x6f, 0x1e, 0x8e, 0x09, 0x06,
-	0xc2, 0x18, 0xde, 0xc9, 0x69, 0x41, 0x7a, 0xa3, 0x7f, 0x99, 0xff, 0xe1, 0x36, 0x94, 0xf2, 0x4f,
-	0x16, 0xa4, 0xc7, 0x8f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xce, 0x41, 0x7c, 0xfa, 0xf8, 0x04,
+func init() { proto.RegisterFile("furya/furya.proto", fileDescriptor_1d089745b6dc3a29) }
+
+var fileDescriptor_1d089745b6dc3a29 = []byte{
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0xbd, 0x8e, 0x5b, 0xec, 0x71, 0xe2, 0x38, 0x83, 0x1b, 0x36, 0x41, 0xb2, 0x83, 0x41,
+	0xa1, 0x02, 0xc5, 0x96, 0xca, 0xa5, 0x8a, 0x10, 0xc8, 0xa9, 0xdd, 0x66, 0xd5, 0x90, 0x5a, 0x6b,
+	0xd3, 0x08, 0x8a, 0xb4, 0x9a, 0x78, 0x27, 0xeb, 0x25, 0xbb, 0x3b, 0xab, 0x9d, 0x71, 0xea, 0x7c,
+	0x00, 0xa4, 0x9e, 0x50, 0x4f, 0x88, 0x63, 0x0e, 0xdc, 0xb8, 0xf6, 0xc6, 0x8d, 0x53, 0x8f, 0xa5,
+	0x27, 0xc4, 0x21, 0xa0, 0xe4, 0xd2, 0x33, 0x9f, 0x00, 0xcd, 0x1f, 0x47, 0xbb, 0xf9, 0x53, 0xb0,
+	0x5d, 0x2e, 0xf6, 0xce, 0xcc, 0x7b, 0xbf, 0xf7, 0xe6, 0xcd, 0x9b, 0xf7, 0x06, 0x2c, 0xec, 0x0d,
+	0xa2, 0x43, 0x54, 0x17, 0xbf, 0xb5, 0x30, 0x22, 0x8c, 0xc0, 0xbc, 0x1c, 0x88, 0xdf, 0xe5, 0x92,
+	0x43, 0x1c, 0x22, 0xe6, 0xeb, 0xfc, 0x4b, 0x8a, 0x2c, 0x2f, 0xf5, 0x08, 0xf5, 0x09, 0xb5, 0xe4,
+	0x82, 0x1c, 0xa8, 0x25, 0x28, 0x81, 0x21, 0x8a, 0x90, 0x3f, 0x9a, 0x2b, 0x3b, 0x84, 0x38, 0x1e,
+	0xae, 0x8b, 0xd1, 0xee, 0x60, 0xaf, 0x6e, 0x0f, 0x22, 0xc4, 0x5c, 0x12, 0xa8, 0xf5, 0xca, 0xf9,
+	0x75, 0xe6, 0xfa, 0x98, 0x32, 0xe4, 0x87, 0x52, 0xa0, 0xfa, 0x73, 0x1e, 0x80, 0xbb, 0x9c, 0xdb,
+	0xa0, 0x14, 0x33, 0xb8, 0x0a, 0xae, 0xd9, 0x38, 0x20, 0xbe, 0xae, 0xad, 0x68, 0x37, 0x73, 0x1b,
+	0xc5, 0xbf, 0x8f, 0x2b, 0xb3, 0x87, 0xc8, 0xf7, 0xd6, 0xab, 0x62, 0xba, 0x6a, 0xca, 0x65, 0xd8,
+	0x01, 0x73, 0x11, 0x7e, 0x8c, 0x22, 0xdb, 0x7a, 0x8c, 0x5d, 0xa7, 0xcf, 0xf4, 0xb4, 0x90, 0xaf,
+	0x3d, 0x3f, 0xae, 0xa4, 0xfe, 0x38, 0xae, 0xac, 0x3a, 0x2e, 0xeb, 0x0f, 0x76, 0x6b, 0x3d, 0xe2,
+	0xab, 0x3d, 0xa8, 0xbf, 0x35, 0x6a, 0xef, 0xd7, 0xd9, 0x61, 0x88, 0x69, 0xad, 0x89, 0x7b, 0xe6,
+	0xac, 0x84, 0xec, 0x08, 0x06, 0xbc, 0x0f, 0x72, 0x0c, 0xed, 0x63, 0x2b, 0x42, 0x0c, 0xeb, 0x33,
+	0x13, 0x01, 0xb3, 0x1c, 0x60, 0x22, 0x86, 0xa1, 0x05, 0x66, 0x19, 0x61, 0xc8, 0xb3, 0x18, 0xd9,
+	0xc7, 0x01, 0xd5, 0x33, 0x82, 0xf7, 0xe9, 0x18, 0x3c, 0x23, 0x60, 0x2f, 0x9f, 0xad, 0x01, 0x75,
+	0x06, 0x46, 0xc0, 0xcc, 0xbc, 0x20, 0x76, 0x05, 0x10, 0xda, 0x60, 0x51, 0x1a, 0x38, 0x40, 0x9e,
+	0x6b, 0x23, 0x46, 0x22, 0x8b, 0xf6, 0x51, 0x84, 0xa9, 0x7e, 0x6d, 0x22, 0xd7, 0x4b, 0x82, 0xf6,
+	0x70, 0x04, 0xeb, 0x08, 0x16, 0x6c, 0x83, 0x05, 0x15, 0x68, 0xca, 0x50, 0xc4, 0x2c, 0x7e, 0x7e,
+	0xfa, 0xf5, 0x15, 0xed, 0x66, 0xfe, 0xd6, 0x72, 0x4d, 0x1e, 0x6e, 0x6d, 0x74, 0xb8, 0xb5, 0xee,
+	0xe8, 0x70, 0x37, 0xb2, 0xdc, 0xf8, 0xd3, 0x3f, 0x2b, 0x9a, 0x39, 0x2f, 0xd5, 0x3b, 0x5c, 0x9b,
+	0xaf, 0xc3, 0x6f, 0x00, 0x54, 0xc4, 0x5e, 0x1f, 0x05, 0x8e, 0x0a, 0xf7, 0x5b, 0x13, 0xf9, 0x5c,
+	0x94, 0xa4, 0x3b, 0x02, 0x24, 0xc2, 0xfe, 0x15, 0x58, 0x4c, 0xd2, 0xdd, 0x80, 0xe1, 0xe8, 0x00,
+	0x79, 0x7a, 0x56, 0x38, 0xbd, 0x74, 0xc1, 0xe9, 0xa6, 0xca, 0x58, 0xe9, 0xf3, 0x8f, 0xdc, 0xe7,
+	0x52, 0x1c, 0x6b, 0x28, 0x00, 0x7c, 0x04, 0xde, 0xf1, 0x10, 0x65, 0x56, 0x92, 0x2f, 0x02, 0x92,
+	0x1b, 0x23, 0x20, 0x25, 0x0e, 0x31, 0x63, 0x06, 0x44, 0x54, 0x3c, 0x70, 0xc3, 0x77, 0x03, 0xcb,
+	0xc6, 0x1e, 0x76, 0x84, 0x3b, 0x16, 0xf2, 0xc9, 0x20, 0x60, 0x3a, 0x10, 0x81, 0xb9, 0x3d, 0x71,
+	0xce, 0xbc, 0xed, 0xbb, 0x41, 0xf3, 0x8c, 0xda, 0x10, 0x50, 0xb8, 0x0b, 0x8a, 0x3e, 0x1a, 0x5a,
+	0x89, 0x04, 0xcd, 0x4f, 0x69, 0xa8, 0xe0, 0xa3, 0x61, 0x37, 0x96, 0x9f, 0x9f, 0x81, 0x5c, 0x18,
+	0xb9, 0x3d, 0x6c, 0x85, 0xd8, 0xd1, 0x67, 0x45, 0x80, 0xde, 0xab, 0xc5, 0x0a, 0x50, 0xcd, 0x8c,
+	0xdd, 0xbd, 0x36, 0x97, 0x6c, 0x63, 0xc7, 0xcc, 0x86, 0xea, 0x0b, 0xda, 0x60, 0x81, 0x47, 0x24,
+	0x79, 0xcd, 0xe7, 0xc6, 0x72, 0xb2, 0x89, 0x7b, 0x31, 0x27, 0x79, 0xc2, 0xcc, 0xfb, 0x6e, 0x10,
+	0xb7, 0x2b, 0xac, 0xa0, 0xe1, 0x39, 0x2b, 0x85, 0xa9, 0xad, 0xa0, 0x61, 0xc2, 0xca, 0x0e, 0x58,
+	0x4c, 0x58, 0xb0, 0x68, 0xaf, 0x8f, 0xed, 0x81, 0x87, 0xf5, 0xf9, 0x7f, 0x09, 0x4c, 0x47, 0x09,
+	0x8e, 0x72, 0x32, 0x39, 0x0b, 0x7b, 0xa0, 0x48, 0x3d, 0x44, 0xfb, 0x96, 0x3f, 0xf0, 0x98, 0x1b,
+	0x7a, 0x2e, 0x8e, 0xf4, 0xe2, 0xb4, 0xde, 0x0b, 0xe2, 0x17, 0x67, 0xc0, 0xf5, 0xec, 0x93, 0xa3,
+	0x4a, 0xea, 0xd5, 0x51, 0x25, 0x55, 0xfd, 0x35, 0x0d, 0x4a, 0x97, 0x1d, 0x1b, 0xfc, 0x16, 0x40,
+	0x86, 0x22, 0x07, 0x33, 0x5e, 0x8d, 0x06, 0xe2, 0x4e, 0xbb, 0x44, 0xd7, 0xc6, 0xae, 0x79, 0x17,
+	0xbd, 0x29, 0x4a, 0xee, 0x43, 0x8e, 0x35, 0x39, 0x15, 0x3e, 0x02, 0x80, 0x27, 0x46, 0xa2, 0xf0,
+	0x4f, 0x67, 0x23, 0xe7, 0xbb, 0x81, 0x3a, 0x29, 0x0e, 0x47, 0xc3, 0x11, 0x7c, 0xe6, 0x8d, 0xc0,
+	0xd1, 0x50, 0xc2, 0xd7, 0x33, 0xaf, 0x8e, 0x2a, 0x5a, 0xf5, 0x49, 0x5a, 0xb5, 0x3c, 0x11, 0x3d,
+	0x58, 0x4a, 0xb4, 0xbc, 0x51, 0x83, 0x33, 0xc1, 0x35, 0x71, 0x13, 0xde, 0xc8, 0xfe, 0x24, 0x0a,
+	0x7e, 0x0e, 0x0a, 0x7b, 0x18, 0xdb, 0x38, 0xb2, 0x90, 0x6d, 0x47, 0x98, 0x52, 0xb5, 0x3f, 0xfd,
+	0xe5, 0xb3, 0xb5, 0x92, 0x12, 0x6f, 0xc8, 0x95, 0x0e, 0x8b, 0xdc, 0xc0, 0x31, 0xe7, 0xa4, 0xbc,
+	0x9a, 0x84, 0x2d, 0x90, 0x1f, 0x84, 0x36, 0x62, 0xaa, 0xea, 0x65, 0xc6, 0xa8, 0x7a, 0x40, 0x2a,
+	0xf2, 0xa5, 0x58, 0x3e, 0xfd, 0xa6, 0x81, 0xe5, 0x78, 0x3e, 0xc9, 0x82, 0xd8, 0x09, 0x50, 0x48,
+	0xfb, 0x84, 0xf1, 0x56, 0x11, 0x46, 0xf8, 0xe0, 0xdc, 0xed, 0xd4, 0x26, 0x6b, 0x15, 0x9c, 0x64,
+	0x26, 0xdb, 0xbd, 0x6a, 0x1f, 0x56, 0xdf, 0xa5, 0x8c, 0x44, 0x2e, 0xa6, 0x7a, 0x7a, 0x65, 0x46,
+	0x6c, 0xe9, 0xe2, 0x75, 0xdc, 0x14, 0x32, 0x87, 0x1b, 0x19, 0x6e, 0x77, 0xd4, 0xd5, 0x36, 0x47,
+	0x8a, 0xb1, 0x3d, 0xfd, 0xa4, 0x81, 0x05, 0xa9, 0x62, 0x04, 0x36, 0x1e, 0x76, 0x90, 0x1f, 0x7a,
+	0x18, 0xde, 0x06, 0x19, 0x11, 0x33, 0x6d, 0x8c, 0x98, 0x09, 0x8d, 0xff, 0xcb, 0xcd, 0xef, 0x35,
+	0x70, 0xe3, 0x82, 0x9b, 0x9b, 0x18, 0xd9, 0xf0, 0x5d, 0x90, 0x0b, 0xf0, 0x90, 0x59, 0xd4, 0x23,
+	0x32, 0xd8, 0x19, 0x33, 0xcb, 0x27, 0x3a, 0x1e, 0x61, 0x70, 0x1b, 0x14, 0x45, 0x13, 0xa4, 0x42,
+	0x5e, 0xe6, 0x41, 0x7a, 0x8c, 0x3d, 0x15, 0xb8, 0xb6, 0x34, 0x76, 0x2e, 0x17, 0xbe, 0x3b, 0x57,
+	0x5b, 0xce, 0x6a, 0xdc, 0x7d, 0x90, 0xdf, 0x8d, 0x30, 0xda, 0x0f, 0x89, 0x1b, 0x30, 0xaa, 0x6b,
+	0x62, 0xef, 0xef, 0x5f, 0x59, 0x31, 0x37, 0xce, 0x64, 0x55, 0x10, 0xe2, 0xda, 0x70, 0x0b, 0xcc,
+	0x89, 0x17, 0x41, 0x48, 0x3c, 0xd1, 0x10, 0x85, 0xf3, 0x85, 0x5b, 0xab, 0x57, 0xe2, 0x8c, 0xb8,
+	0xb4, 0x99, 0x54, 0x86, 0x5b, 0x60, 0x5e, 0x5d, 0x88, 0xb3, 0x67, 0xc6, 0xcc, 0x7f, 0x7f, 0x66,
+	0x14, 0xa4, 0xee, 0xe8, 0x81, 0xa1, 0xca, 0xc3, 0x2f, 0x1a, 0x58, 0xbc, 0x7c, 0x3f, 0x53, 0x24,
+	0x11, 0xba, 0xfc, 0xbd, 0x3c, 0x5d, 0x59, 0x49, 0xbc, 0x9e, 0xa5, 0xf7, 0x1f, 0xfd, 0xa0, 0x81,
+	0xa5, 0x2b, 0xc3, 0x07, 0x3f, 0x06, 0x1f, 0x9a, 0xad, 0x9d, 0x86, 0xd9, 0xb4, 0x76, 0x5a, 0xc6,
+	0xbd, 0xcd, 0xae, 0x65, 0x6c, 0x77, 0x5b, 0x66, 0xfb, 0xc1, 0x56, 0xa3, 0x6b, 0x3c, 0xd8, 0xb6,
+	0xbe, 0xdc, 0xee, 0xb4, 0x5b, 0x77, 0x8c, 0xbb, 0x46, 0xab, 0x59, 0x4c, 0xc1, 0x0f, 0xc0, 0xca,
+	0xeb, 0x84, 0x3b, 0xdd, 0x56, 0xbb, 0xa8, 0xc1, 0x55, 0x50, 0x7d, 0x9d, 0xd4, 0x96, 0xb1, 0xdd,
+	0x6a, 0x98, 0xc5, 0xf4, 0xc6, 0xbd, 0xe7, 0x27, 0x65, 0xed, 0xc5, 0x49, 0x59, 0xfb, 0xeb, 0xa4,
+	0xac, 0x3d, 0x3d, 0x2d, 0xa7, 0x5e, 0x9c, 0x96, 0x53, 0xbf, 0x9f, 0x96, 0x53, 0x5f, 0xaf, 0xc5,
+	0x36, 0x2f, 0xce, 0x7f, 0x8d, 0xec, 0xed, 0xb9, 0x3d, 0x17, 0x79, 0x72, 0x58, 0x1f, 0xaa, 0x7f,
+	0x11, 0x87, 0xdd, 0xeb, 0x22, 0xdc, 0x9f, 0xfc, 0x33, 0x00, 0x78, 0x5f, 0x6c, 0x0c, 0x60, 0x0d,
 	0x00, 0x00,
 }
 
+func (this *RewardWeightPricePeg) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardWeightPricePeg)
+	if !ok {
+		that2, ok := that.(RewardWeightPricePeg)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TargetValueRatio.Equal(that1.TargetValueRatio) {
+		return false
+	}
+	if !this.MinWeight.Equal(that1.MinWeight) {
+		return false
+	}
+	if !this.MaxWeight.Equal(that1.MaxWeight) {
+		return false
+	}
+	return true
+}
+func (this *RewardWeightSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardWeightSchedule)
+	if !ok {
+		that2, ok := that.(RewardWeightSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Breakpoints) != len(that1.Breakpoints) {
+		return false
+	}
+	for i := range this.Breakpoints {
+		if !this.Breakpoints[i].Equal(&that1.Breakpoints[i]) {
+			return false
+		}
+	}
+	if this.Interpolation != that1.Interpolation {
+		return false
+	}
+	if this.UpdateInterval != that1.UpdateInterval {
+		return false
+	}
+	return true
+}
+func (this *RewardWeightBreakpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardWeightBreakpoint)
+	if !ok {
+		that2, ok := that.(RewardWeightBreakpoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.RewardWeight.Equal(that1.RewardWeight) {
+		return false
+	}
+	return true
+}
 func (m *FuryaAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -189,20 +642,106 @@ func (m *FuryaAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRewardChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime):])
-	if err1 != nil {
-		return 0, err1
+	if m.SlashMultiplier != nil {
+		{
+			size := m.SlashMultiplier.Size()
+			i -= size
+			if _, err := m.SlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RewardWeightSchedule != nil {
+		{
+			size, err := m.RewardWeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PricePeg != nil {
+		{
+			size, err := m.PricePeg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFurya(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRewardChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintFurya(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintFurya(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintFurya(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintFurya(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -215,12 +754,12 @@ func (m *FuryaAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RewardStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RewardStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintFurya(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintFurya(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -273,7 +812,7 @@ func (m *FuryaAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardWeightChangeSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *RewardWeightPricePeg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -283,34 +822,40 @@ func (m *RewardWeightChangeSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardWeightChangeSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardWeightPricePeg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardWeightChangeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardWeightPricePeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardHistories) > 0 {
-		for iNdEx := len(m.RewardHistories) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFurya(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.MaxWeight.Size()
+		i -= size
+		if _, err := m.MaxWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFurya(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	{
-		size := m.PrevRewardWeight.Size()
+		size := m.MinWeight.Size()
 		i -= size
-		if _, err := m.PrevRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFurya(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetValueRatio.Size()
+		i -= size
+		if _, err := m.TargetValueRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFurya(dAtA, i, uint64(size))
@@ -320,17 +865,291 @@ func (m *RewardWeightChangeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFurya(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFurya(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *FuryaPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFurya(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.FeederAddress) > 0 {
+		i -= len(m.FeederAddress)
+		copy(dAtA[i:], m.FeederAddress)
+		i = encodeVarintFurya(dAtA, i, uint64(len(m.FeederAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFurya(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFurya(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeightChangeSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeightChangeSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeightChangeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardHistories) > 0 {
+		for iNdEx := len(m.RewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFurya(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.PrevRewardWeight.Size()
+		i -= size
+		if _, err := m.PrevRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFurya(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardIndexSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndexSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndexSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardHistories) > 0 {
+		for iNdEx := len(m.RewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFurya(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFurya(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardIndexSampleHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardIndexSampleHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardIndexSampleHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastSampleTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFurya(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.NextSlot != 0 {
+		i = encodeVarintFurya(dAtA, i, uint64(m.NextSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeightSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeightSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeightSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UpdateInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateInterval):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintFurya(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.Interpolation != 0 {
+		i = encodeVarintFurya(dAtA, i, uint64(m.Interpolation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Breakpoints) > 0 {
+		for iNdEx := len(m.Breakpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFurya(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardWeightBreakpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardWeightBreakpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardWeightBreakpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFurya(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintFurya(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFurya(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFurya(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *FuryaAsset) Size() (n int) {
 	if m == nil {
 		return 0
@@ -357,33 +1176,916 @@ func (m *FuryaAsset) Size() (n int) {
 	n += 1 + l + sovFurya(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime)
 	n += 1 + l + sovFurya(uint64(l))
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.PricePeg != nil {
+		l = m.PricePeg.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.RewardWeightSchedule != nil {
+		l = m.RewardWeightSchedule.Size()
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	if m.SlashMultiplier != nil {
+		l = m.SlashMultiplier.Size()
+		n += 2 + l + sovFurya(uint64(l))
+	}
 	return n
 }
 
-func (m *RewardWeightChangeSnapshot) Size() (n int) {
+func (m *RewardWeightPricePeg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.PrevRewardWeight.Size()
+	l = m.TargetValueRatio.Size()
 	n += 1 + l + sovFurya(uint64(l))
-	if len(m.RewardHistories) > 0 {
-		for _, e := range m.RewardHistories {
-			l = e.Size()
-			n += 1 + l + sovFurya(uint64(l))
+	l = m.MinWeight.Size()
+	n += 1 + l + sovFurya(uint64(l))
+	l = m.MaxWeight.Size()
+	n += 1 + l + sovFurya(uint64(l))
+	return n
+}
+
+func (m *FuryaPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovFurya(uint64(l))
+	l = len(m.FeederAddress)
+	if l > 0 {
+		n += 1 + l + sovFurya(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovFurya(uint64(l))
+	return n
+}
+
+func (m *RewardWeightChangeSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrevRewardWeight.Size()
+	n += 1 + l + sovFurya(uint64(l))
+	if len(m.RewardHistories) > 0 {
+		for _, e := range m.RewardHistories {
+			l = e.Size()
+			n += 1 + l + sovFurya(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardIndexSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFurya(uint64(l))
+	if len(m.RewardHistories) > 0 {
+		for _, e := range m.RewardHistories {
+			l = e.Size()
+			n += 1 + l + sovFurya(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardIndexSampleHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextSlot != 0 {
+		n += 1 + sovFurya(uint64(m.NextSlot))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastSampleTime)
+	n += 1 + l + sovFurya(uint64(l))
+	return n
+}
+
+func (m *RewardWeightSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Breakpoints) > 0 {
+		for _, e := range m.Breakpoints {
+			l = e.Size()
+			n += 1 + l + sovFurya(uint64(l))
+		}
+	}
+	if m.Interpolation != 0 {
+		n += 1 + sovFurya(uint64(m.Interpolation))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpdateInterval)
+	n += 1 + l + sovFurya(uint64(l))
+	return n
+}
+
+func (m *RewardWeightBreakpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFurya(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovFurya(uint64(l))
+	return n
+}
+
+func sovFurya(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFurya(x uint64) (n int) {
+	return sovFurya(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FuryaAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FuryaAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValidatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RewardStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRewardChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePeg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PricePeg == nil {
+				m.PricePeg = &RewardWeightPricePeg{}
+			}
+			if err := m.PricePeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardWeightSchedule == nil {
+				m.RewardWeightSchedule = &RewardWeightSchedule{}
+			}
+			if err := m.RewardWeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashMultiplier = &v
+			if err := m.SlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeightPricePeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightPricePeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightPricePeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetValueRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetValueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovFurya(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFurya(x uint64) (n int) {
-	return sovFurya(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
+func (m *FuryaPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -406,10 +2108,10 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FuryaAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: FuryaPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FuryaAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FuryaPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -439,16 +2141,283 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFurya
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFurya
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
+			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardIndexSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndexSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndexSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -458,31 +2427,30 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -492,65 +2460,81 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
+			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokens", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFurya
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFurya
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardIndexSampleHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorShares", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardIndexSampleHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardIndexSampleHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlot", wireType)
 			}
-			var stringLen uint64
+			m.NextSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -560,29 +2544,14 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFurya
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFurya
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalValidatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSampleTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -609,15 +2578,65 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RewardStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastSampleTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFurya(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFurya
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeightSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFurya
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakpoints", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -627,31 +2646,31 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Breakpoints = append(m.Breakpoints, RewardWeightBreakpoint{})
+			if err := m.Breakpoints[len(m.Breakpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interpolation", wireType)
 			}
-			var msglen int
+			m.Interpolation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -661,28 +2680,14 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Interpolation |= RewardWeightInterpolation(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFurya
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFurya
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardChangeTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -709,7 +2714,7 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRewardChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UpdateInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -734,7 +2739,7 @@ func (m *FuryaAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
+func (m *RewardWeightBreakpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,17 +2762,17 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardWeightBreakpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardWeightBreakpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevRewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -777,31 +2782,30 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrevRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFurya
@@ -811,23 +2815,23 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFurya
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFurya
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
-			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -28,7 +30,7 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ValidatorInfoState struct {
-	ValidatorAddress string                `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	Validator        FuryaValidatorInfo `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
 }
 
@@ -36,7 +38,7 @@ func (m *ValidatorInfoState) Reset()         { *m = ValidatorInfoState{} }
 func (m *ValidatorInfoState) String() string { return proto.CompactTextString(m) }
 func (*ValidatorInfoState) ProtoMessage()    {}
 func (*ValidatorInfoState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e04f4ac99abd5245, []int{0}
+	return fileDescriptor_e5ddb5b327abfe4b, []int{0}
 }
 func (m *ValidatorInfoState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -88,7 +90,7 @@ func (m *RedelegationState) Reset()         { *m = RedelegationState{} }
 func (m *RedelegationState) String() string { return proto.CompactTextString(m) }
 func (*RedelegationState) ProtoMessage()    {}
 func (*RedelegationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e04f4ac99abd5245, []int{1}
+	return fileDescriptor_e5ddb5b327abfe4b, []int{1}
 }
 func (m *RedelegationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,7 +142,7 @@ func (m *UndelegationState) Reset()         { *m = UndelegationState{} }
 func (m *UndelegationState) String() string { return proto.CompactTextString(m) }
 func (*UndelegationState) ProtoMessage()    {}
 func (*UndelegationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e04f4ac99abd5245, []int{2}
+	return fileDescriptor_e5ddb5b327abfe4b, []int{2}
 }
 func (m *UndelegationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +196,7 @@ func (m *RewardWeightChangeSnapshotState) Reset()         { *m = RewardWeightCha
 func (m *RewardWeightChangeSnapshotState) String() string { return proto.CompactTextString(m) }
 func (*RewardWeightChangeSnapshotState) ProtoMessage()    {}
 func (*RewardWeightChangeSnapshotState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e04f4ac99abd5245, []int{3}
+	return fileDescriptor_e5ddb5b327abfe4b, []int{3}
 }
 func (m *RewardWeightChangeSnapshotState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,22 +253,209 @@ func (m *RewardWeightChangeSnapshotState) GetSnapshot() RewardWeightChangeSnapsh
 	return RewardWeightChangeSnapshot{}
 }
 
+type WithdrawAddressState struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	WithdrawAddress  string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *WithdrawAddressState) Reset()         { *m = WithdrawAddressState{} }
+func (m *WithdrawAddressState) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressState) ProtoMessage()    {}
+func (*WithdrawAddressState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5ddb5b327abfe4b, []int{4}
+}
+func (m *WithdrawAddressState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressState.Merge(m, src)
+}
+func (m *WithdrawAddressState) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressState) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressState proto.InternalMessageInfo
+
+func (m *WithdrawAddressState) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *WithdrawAddressState) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+type AutoCompoundState struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AutoCompoundState) Reset()         { *m = AutoCompoundState{} }
+func (m *AutoCompoundState) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundState) ProtoMessage()    {}
+func (*AutoCompoundState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5ddb5b327abfe4b, []int{5}
+}
+func (m *AutoCompoundState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundState.Merge(m, src)
+}
+func (m *AutoCompoundState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundState proto.InternalMessageInfo
+
+func (m *AutoCompoundState) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundState) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundState) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type ForceUndelegationState struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Store key of the next delegation to visit, empty if no delegation was visited yet
+	NextKey []byte `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *ForceUndelegationState) Reset()         { *m = ForceUndelegationState{} }
+func (m *ForceUndelegationState) String() string { return proto.CompactTextString(m) }
+func (*ForceUndelegationState) ProtoMessage()    {}
+func (*ForceUndelegationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5ddb5b327abfe4b, []int{6}
+}
+func (m *ForceUndelegationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceUndelegationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceUndelegationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceUndelegationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceUndelegationState.Merge(m, src)
+}
+func (m *ForceUndelegationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceUndelegationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceUndelegationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceUndelegationState proto.InternalMessageInfo
+
+func (m *ForceUndelegationState) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ForceUndelegationState) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ForceUndelegationState) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	Params                     Params                            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Assets                     []FuryaAsset                   `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
+	Assets                     []FuryaAsset                      `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
 	ValidatorInfos             []ValidatorInfoState              `protobuf:"bytes,3,rep,name=validator_infos,json=validatorInfos,proto3" json:"validator_infos"`
 	RewardWeightChangeSnaphots []RewardWeightChangeSnapshotState `protobuf:"bytes,4,rep,name=reward_weight_change_snaphots,json=rewardWeightChangeSnaphots,proto3" json:"reward_weight_change_snaphots"`
 	Delegations                []Delegation                      `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
 	Redelegations              []RedelegationState               `protobuf:"bytes,6,rep,name=redelegations,proto3" json:"redelegations"`
 	Undelegations              []UndelegationState               `protobuf:"bytes,7,rep,name=undelegations,proto3" json:"undelegations"`
+	WithdrawAddresses          []WithdrawAddressState            `protobuf:"bytes,8,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses"`
+	AutoCompounds              []AutoCompoundState               `protobuf:"bytes,9,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+	TokenizedDelegations       []TokenizedDelegation             `protobuf:"bytes,10,rep,name=tokenized_delegations,json=tokenizedDelegations,proto3" json:"tokenized_delegations"`
+	TokenHolderRewardHistories []TokenHolderRewardHistory        `protobuf:"bytes,11,rep,name=token_holder_reward_histories,json=tokenHolderRewardHistories,proto3" json:"token_holder_reward_histories"`
+	ValidatorPreferences       []ValidatorFuryaPreferences       `protobuf:"bytes,12,rep,name=validator_preferences,json=validatorPreferences,proto3" json:"validator_preferences"`
+	ForceUndelegations         []ForceUndelegationState          `protobuf:"bytes,13,rep,name=force_undelegations,json=forceUndelegations,proto3" json:"force_undelegations"`
+	ValidatorCommissions       []ValidatorFuryaCommission        `protobuf:"bytes,14,rep,name=validator_commissions,json=validatorCommissions,proto3" json:"validator_commissions"`
+	Prices                     []FuryaPrice                      `protobuf:"bytes,15,rep,name=prices,proto3" json:"prices"`
+	Incentives                 []FuryaIncentive                  `protobuf:"bytes,16,rep,name=incentives,proto3" json:"incentives"`
+	FinishedIncentives         []FuryaIncentive                  `protobuf:"bytes,17,rep,name=finished_incentives,json=finishedIncentives,proto3" json:"finished_incentives"`
+	// Cumulative take rate revenue per furya asset
+	TakeRateRevenues github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=take_rate_revenues,json=takeRateRevenues,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"take_rate_revenues"`
+	RewardDust       RewardDust                               `protobuf:"bytes,19,opt,name=reward_dust,json=rewardDust,proto3" json:"reward_dust"`
+	Slashes          []FuryaSlash                             `protobuf:"bytes,20,rep,name=slashes,proto3" json:"slashes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e04f4ac99abd5245, []int{4}
+	return fileDescriptor_e5ddb5b327abfe4b, []int{7}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,58 +533,182 @@ func (m *GenesisState) GetUndelegations() []UndelegationState {
 	return nil
 }
 
+func (m *GenesisState) GetWithdrawAddresses() []WithdrawAddressState {
+	if m != nil {
+		return m.WithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompounds() []AutoCompoundState {
+	if m != nil {
+		return m.AutoCompounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizedDelegations() []TokenizedDelegation {
+	if m != nil {
+		return m.TokenizedDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenHolderRewardHistories() []TokenHolderRewardHistory {
+	if m != nil {
+		return m.TokenHolderRewardHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorPreferences() []ValidatorFuryaPreferences {
+	if m != nil {
+		return m.ValidatorPreferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForceUndelegations() []ForceUndelegationState {
+	if m != nil {
+		return m.ForceUndelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorCommissions() []ValidatorFuryaCommission {
+	if m != nil {
+		return m.ValidatorCommissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrices() []FuryaPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentives() []FuryaIncentive {
+	if m != nil {
+		return m.Incentives
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFinishedIncentives() []FuryaIncentive {
+	if m != nil {
+		return m.FinishedIncentives
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakeRateRevenues() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakeRateRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardDust() RewardDust {
+	if m != nil {
+		return m.RewardDust
+	}
+	return RewardDust{}
+}
+
+func (m *GenesisState) GetSlashes() []FuryaSlash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorInfoState)(nil), "furya.furya.ValidatorInfoState")
 	proto.RegisterType((*RedelegationState)(nil), "furya.furya.RedelegationState")
 	proto.RegisterType((*UndelegationState)(nil), "furya.furya.UndelegationState")
 	proto.RegisterType((*RewardWeightChangeSnapshotState)(nil), "furya.furya.RewardWeightChangeSnapshotState")
+	proto.RegisterType((*WithdrawAddressState)(nil), "furya.furya.WithdrawAddressState")
+	proto.RegisterType((*AutoCompoundState)(nil), "furya.furya.AutoCompoundState")
+	proto.RegisterType((*ForceUndelegationState)(nil), "furya.furya.ForceUndelegationState")
 	proto.RegisterType((*GenesisState)(nil), "furya.furya.GenesisState")
 }
 
-func init() { proto.RegisterFile("furya/genesis.proto", fileDescriptor_e04f4ac99abd5245) }
-
-var fileDescriptor_e04f4ac99abd5245 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xad, 0x2b, 0xab, 0x5b, 0x36, 0x6a, 0x8d, 0x11, 0x22, 0x96, 0x56, 0x15, 0x48,
-	0x95, 0xd0, 0x12, 0x54, 0x0e, 0xdc, 0x90, 0x5a, 0x40, 0x68, 0x08, 0xb4, 0x91, 0x0d, 0x90, 0xb8,
-	0x54, 0x6e, 0xe3, 0x26, 0x91, 0x92, 0x38, 0x8a, 0xdd, 0x8d, 0x89, 0x3f, 0x82, 0xfd, 0x23, 0x9c,
-	0xb8, 0x73, 0x43, 0xda, 0x71, 0x47, 0x4e, 0x80, 0xda, 0x7f, 0x04, 0xc5, 0x4e, 0x52, 0x77, 0xcd,
-	0x40, 0x1c, 0xb8, 0x39, 0xef, 0xc7, 0xe7, 0x7d, 0xdf, 0xb3, 0x5f, 0xc0, 0x36, 0xf2, 0x7d, 0x0f,
-	0x85, 0x23, 0x6c, 0x3a, 0x38, 0xc4, 0xd4, 0xa3, 0x46, 0x14, 0x13, 0x46, 0x60, 0x23, 0xb3, 0x1b,
-	0xd9, 0x41, 0xdb, 0x72, 0x88, 0x43, 0xb8, 0xd7, 0x4c, 0x4e, 0x22, 0x50, 0xbb, 0x95, 0x03, 0xf2,
-	0x0c, 0xe1, 0xb8, 0x99, 0x3b, 0x22, 0x14, 0xa3, 0x20, 0x05, 0x6b, 0x5a, 0x6e, 0xb6, 0xb1, 0x8f,
-	0x1d, 0xc4, 0x3c, 0x12, 0x66, 0xbe, 0xa6, 0x43, 0x88, 0xe3, 0x63, 0x93, 0x7f, 0x0d, 0x27, 0x63,
-	0x93, 0x79, 0x01, 0xa6, 0x0c, 0x05, 0x91, 0x08, 0x68, 0x7f, 0x52, 0x00, 0x7c, 0x8b, 0x7c, 0xcf,
-	0x46, 0x8c, 0xc4, 0x7b, 0xe1, 0x98, 0x1c, 0x32, 0xc4, 0x30, 0xbc, 0x0f, 0x1a, 0xc7, 0x99, 0x75,
-	0x80, 0x6c, 0x3b, 0xc6, 0x94, 0xaa, 0x4a, 0x4b, 0xe9, 0x54, 0xad, 0x1b, 0xb9, 0xa3, 0x27, 0xec,
-	0xf0, 0x25, 0xa8, 0xe6, 0x36, 0x75, 0xa5, 0xa5, 0x74, 0x6a, 0xdd, 0x8e, 0xb1, 0xd4, 0xad, 0xd1,
-	0x4b, 0x0f, 0x0b, 0xe5, 0xfa, 0xe5, 0xf3, 0x1f, 0xcd, 0x92, 0x35, 0x07, 0xb4, 0x3f, 0x2b, 0xa0,
-	0x61, 0xe1, 0x79, 0x2b, 0x42, 0xd0, 0x2b, 0xb0, 0x39, 0x22, 0x41, 0xe4, 0xe3, 0xc4, 0x34, 0x48,
-	0xba, 0xe0, 0x72, 0x6a, 0x5d, 0xcd, 0x10, 0x2d, 0x1a, 0x59, 0x8b, 0xc6, 0x51, 0xd6, 0x62, 0x7f,
-	0x3d, 0x61, 0x9f, 0xfd, 0x6c, 0x2a, 0xd6, 0xc6, 0x3c, 0x39, 0x71, 0xc3, 0x3d, 0x50, 0x8f, 0xa5,
-	0x1a, 0xa9, 0xea, 0x66, 0x81, 0x6a, 0x59, 0x4a, 0x2a, 0x76, 0x21, 0xb5, 0xfd, 0x45, 0x01, 0x8d,
-	0x37, 0xe1, 0x7f, 0xd6, 0xbb, 0x0f, 0xea, 0x93, 0x70, 0x49, 0xef, 0xbd, 0x02, 0xbd, 0xaf, 0x27,
-	0x78, 0x82, 0x6d, 0x59, 0x50, 0xa6, 0x5a, 0x06, 0xb4, 0xbf, 0x2a, 0xa0, 0x69, 0xe1, 0x13, 0x14,
-	0xdb, 0xef, 0xb0, 0xe7, 0xb8, 0xec, 0x89, 0x8b, 0x42, 0x07, 0x1f, 0x86, 0x28, 0xa2, 0x2e, 0x61,
-	0xa2, 0x87, 0x6d, 0x50, 0x71, 0xb9, 0x93, 0x4b, 0x2f, 0x5b, 0xe9, 0x17, 0xbc, 0x73, 0xf9, 0xbe,
-	0xab, 0xd2, 0xfd, 0xc1, 0x2d, 0xb0, 0x66, 0xe3, 0x90, 0x04, 0xea, 0x2a, 0xf7, 0x88, 0x0f, 0xb8,
-	0x0f, 0xd6, 0x69, 0x0a, 0x57, 0xcb, 0x5c, 0xfc, 0x6e, 0xe1, 0xb0, 0xaf, 0x52, 0x94, 0x36, 0x91,
-	0x43, 0xda, 0xdf, 0xca, 0xa0, 0xfe, 0x5c, 0x2c, 0x98, 0x50, 0xfb, 0x08, 0x54, 0xc4, 0x5a, 0xa4,
-	0x83, 0xbe, 0x5d, 0xc0, 0x3f, 0xe0, 0x01, 0x29, 0x2b, 0x0d, 0x87, 0x8f, 0x41, 0x05, 0x51, 0x8a,
-	0x19, 0x55, 0x57, 0x5a, 0xab, 0x9d, 0x5a, 0xb7, 0xf5, 0x87, 0xb7, 0xdb, 0x4b, 0x02, 0xb3, 0x7c,
-	0x91, 0x05, 0x8f, 0xc0, 0xe6, 0x7c, 0x57, 0xbc, 0x70, 0x4c, 0xa8, 0xba, 0xca, 0x41, 0x45, 0xd7,
-	0xb3, 0xbc, 0x6b, 0x29, 0x6d, 0xe3, 0x58, 0xf6, 0x50, 0xf8, 0x11, 0xec, 0xc4, 0x7c, 0x1a, 0x83,
-	0x13, 0x3e, 0x8e, 0xc1, 0x88, 0xcf, 0x63, 0x90, 0x0c, 0xc0, 0x25, 0x8c, 0xaa, 0x65, 0x5e, 0xa3,
-	0xfb, 0x4f, 0x53, 0x94, 0x0b, 0x6a, 0x71, 0x61, 0x58, 0xc2, 0x86, 0xcf, 0x40, 0x4d, 0xfa, 0x97,
-	0xa8, 0x6b, 0xbc, 0xd4, 0x4e, 0x41, 0xa9, 0xa7, 0x97, 0x5f, 0x99, 0x9c, 0x07, 0x0f, 0xc0, 0x75,
-	0x79, 0x55, 0xa8, 0x5a, 0xe1, 0xa0, 0xbb, 0x7f, 0x59, 0x33, 0x59, 0xe5, 0x22, 0x20, 0x21, 0xca,
-	0xcf, 0x98, 0xaa, 0xd7, 0xae, 0x24, 0x2e, 0xed, 0x64, 0x46, 0x5c, 0x00, 0xf4, 0x5f, 0x9c, 0x4f,
-	0x75, 0xe5, 0x62, 0xaa, 0x2b, 0xbf, 0xa6, 0xba, 0x72, 0x36, 0xd3, 0x4b, 0x17, 0x33, 0xbd, 0xf4,
-	0x7d, 0xa6, 0x97, 0xde, 0x3f, 0x70, 0x3c, 0xe6, 0x4e, 0x86, 0xc6, 0x88, 0x04, 0x26, 0xc3, 0x71,
-	0x8c, 0x76, 0x03, 0x12, 0xe2, 0xd3, 0xfc, 0xaf, 0x6c, 0x7e, 0x98, 0x1f, 0xd9, 0x69, 0x84, 0xe9,
-	0xb0, 0xc2, 0x77, 0xfa, 0xe1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xde, 0x3f, 0x5f, 0x03,
-	0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("furya/genesis.proto", fileDescriptor_e5ddb5b327abfe4b) }
+
+var fileDescriptor_e5ddb5b327abfe4b = []byte{
+	// 1114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x36, 0x69, 0x9a, 0x8c, 0xdd, 0x24, 0x9e, 0x24, 0xed, 0x26, 0xdf, 0x6f, 0xed, 0x60,
+	0x04, 0x04, 0x41, 0xd6, 0x24, 0x08, 0x71, 0x03, 0x25, 0xae, 0xda, 0x86, 0x0a, 0x14, 0x9c, 0xa6,
+	0x95, 0xca, 0x61, 0x99, 0xec, 0x3e, 0x7b, 0x47, 0xb1, 0x67, 0xac, 0x9d, 0x59, 0xa7, 0xe6, 0x8a,
+	0xc4, 0xb9, 0xff, 0x05, 0x12, 0x12, 0x77, 0xfe, 0x84, 0x1e, 0x7b, 0xe4, 0x44, 0x51, 0x72, 0xe6,
+	0x7f, 0x40, 0x3b, 0x33, 0xbb, 0xde, 0xf5, 0xae, 0xa5, 0x82, 0xc4, 0xc5, 0xeb, 0x79, 0x3f, 0x3e,
+	0xef, 0xf3, 0xde, 0xbc, 0xf7, 0x34, 0x68, 0xbd, 0x1b, 0x85, 0x63, 0xd2, 0xea, 0x01, 0x03, 0x41,
+	0x85, 0x33, 0x0c, 0xb9, 0xe4, 0xb8, 0xa2, 0x84, 0x8e, 0xfa, 0xdd, 0xde, 0xe8, 0xf1, 0x1e, 0x57,
+	0xf2, 0x56, 0xfc, 0x4f, 0x9b, 0x6c, 0xd7, 0xb4, 0x9f, 0x36, 0xd4, 0x22, 0xac, 0x45, 0x43, 0x12,
+	0x92, 0x81, 0x41, 0xda, 0xbe, 0xab, 0x65, 0x3e, 0xf4, 0xa1, 0x47, 0x24, 0xe5, 0x2c, 0x51, 0x6c,
+	0x6a, 0x05, 0x65, 0x1e, 0x30, 0x49, 0x47, 0x90, 0x87, 0x15, 0x7d, 0x22, 0x02, 0x23, 0x6a, 0xf4,
+	0x38, 0xef, 0xf5, 0xa1, 0xa5, 0x4e, 0xe7, 0x51, 0xb7, 0x25, 0xe9, 0x00, 0x84, 0x24, 0x83, 0xa1,
+	0x31, 0xa8, 0x7b, 0x5c, 0x0c, 0xb8, 0x68, 0x9d, 0x13, 0x01, 0xad, 0xd1, 0xfe, 0x39, 0x48, 0xb2,
+	0xdf, 0xf2, 0x38, 0x65, 0x5a, 0xdf, 0xfc, 0xc9, 0x42, 0xf8, 0x29, 0xe9, 0x53, 0x9f, 0x48, 0x1e,
+	0x1e, 0xb3, 0x2e, 0x3f, 0x95, 0x44, 0x02, 0xfe, 0x08, 0xd5, 0x46, 0x89, 0xd4, 0x25, 0xbe, 0x1f,
+	0x82, 0x10, 0xb6, 0xb5, 0x63, 0xed, 0x2e, 0x77, 0xd6, 0x52, 0xc5, 0xa1, 0x96, 0xe3, 0x36, 0x5a,
+	0x4e, 0x65, 0xf6, 0x8d, 0x1d, 0x6b, 0xb7, 0x72, 0xd0, 0x70, 0x32, 0x55, 0x72, 0x1e, 0xc4, 0xbf,
+	0xb9, 0x28, 0x47, 0x0b, 0xaf, 0xfe, 0x68, 0xcc, 0x75, 0x26, 0x7e, 0xcd, 0x9f, 0x2d, 0x54, 0xeb,
+	0xc0, 0xa4, 0x16, 0x9a, 0xc7, 0xd7, 0x68, 0xd5, 0xe3, 0x83, 0x61, 0x1f, 0x62, 0x91, 0x1b, 0x27,
+	0xa7, 0x58, 0x54, 0x0e, 0xb6, 0x1d, 0x9d, 0xb9, 0x93, 0x64, 0xee, 0x3c, 0x49, 0x32, 0x3f, 0x5a,
+	0x8a, 0xb1, 0x5f, 0xbe, 0x69, 0x58, 0x9d, 0x95, 0x89, 0x73, 0xac, 0xc6, 0x6d, 0x54, 0x0d, 0x33,
+	0x31, 0x0c, 0xd9, 0xad, 0x1c, 0xd9, 0x2c, 0x09, 0x43, 0x33, 0xe7, 0xd4, 0xfc, 0xd5, 0x42, 0xb5,
+	0x33, 0xf6, 0x1f, 0x33, 0x3d, 0x46, 0xd5, 0x88, 0x15, 0x98, 0xe6, 0xcb, 0xfa, 0x6d, 0x04, 0x11,
+	0xf8, 0x67, 0xac, 0xc8, 0x37, 0xeb, 0xda, 0xfc, 0xcd, 0x42, 0x8d, 0x0e, 0x5c, 0x92, 0xd0, 0x7f,
+	0x06, 0xb4, 0x17, 0xc8, 0x76, 0x40, 0x58, 0x0f, 0x4e, 0x19, 0x19, 0x8a, 0x80, 0x4b, 0xcd, 0xfe,
+	0x0e, 0x5a, 0x0c, 0x94, 0x52, 0x91, 0x5e, 0xe8, 0x98, 0x13, 0xfe, 0xff, 0xf4, 0xd5, 0x2e, 0x67,
+	0xee, 0x0c, 0x6f, 0xa0, 0x9b, 0x3e, 0x30, 0x3e, 0xb0, 0xe7, 0x95, 0x46, 0x1f, 0xf0, 0x31, 0x5a,
+	0x12, 0x06, 0xdc, 0x5e, 0x50, 0xb4, 0x3f, 0x98, 0x2a, 0xf0, 0x2c, 0x2e, 0x86, 0x7e, 0xea, 0xde,
+	0x64, 0x68, 0xe3, 0x19, 0x95, 0x81, 0x1f, 0x92, 0x4b, 0xd3, 0x6c, 0x69, 0x7b, 0x9a, 0x04, 0x8b,
+	0xed, 0x99, 0x2a, 0x92, 0xf6, 0xfc, 0x10, 0xad, 0x5d, 0x1a, 0x90, 0xd4, 0x56, 0xa7, 0xb2, 0x7a,
+	0x99, 0x07, 0x6f, 0xfe, 0x68, 0xa1, 0xda, 0x61, 0x24, 0x79, 0x9b, 0x0f, 0x86, 0x3c, 0x62, 0xfe,
+	0xbf, 0x88, 0x56, 0x3a, 0x39, 0x37, 0x66, 0x4c, 0x4e, 0x69, 0x01, 0x9b, 0x23, 0x74, 0xe7, 0x01,
+	0x0f, 0x3d, 0x28, 0x36, 0xd9, 0x3f, 0x1a, 0xcb, 0x14, 0xfc, 0x46, 0xf6, 0x76, 0xb6, 0xd0, 0x12,
+	0x83, 0x17, 0xd2, 0xbd, 0x80, 0xb1, 0x8a, 0x5a, 0xed, 0xdc, 0x8a, 0xcf, 0x8f, 0x61, 0xdc, 0xfc,
+	0xab, 0x8a, 0xaa, 0x0f, 0xf5, 0xae, 0xd3, 0xe1, 0xf6, 0xd1, 0xa2, 0x5e, 0x58, 0xa6, 0x95, 0xd7,
+	0x73, 0xf7, 0x78, 0xa2, 0x54, 0xe6, 0xce, 0x8c, 0x21, 0xfe, 0x0c, 0x2d, 0x12, 0x21, 0x40, 0xc6,
+	0x39, 0xcf, 0xef, 0x56, 0x0e, 0xee, 0x16, 0x17, 0xc1, 0x61, 0xac, 0x4f, 0xdc, 0xb4, 0x31, 0xfe,
+	0x06, 0xad, 0x4e, 0x12, 0xa3, 0xac, 0xcb, 0x85, 0x3d, 0xbf, 0x33, 0x5f, 0xe8, 0xf8, 0xe2, 0xa6,
+	0x32, 0x38, 0x2b, 0xa3, 0xac, 0x46, 0xe0, 0x08, 0xdd, 0x0b, 0x55, 0x9b, 0xb9, 0x97, 0xaa, 0xcf,
+	0x5c, 0x4f, 0x35, 0x9a, 0x1b, 0x77, 0x56, 0xc0, 0xa5, 0xb0, 0x17, 0x14, 0xfa, 0xc7, 0x6f, 0xd9,
+	0x98, 0xd9, 0x50, 0xdb, 0x61, 0xa9, 0x59, 0x8c, 0x8a, 0xbf, 0x44, 0x95, 0xcc, 0x36, 0xb7, 0x6f,
+	0x96, 0x94, 0xe0, 0xfe, 0xf4, 0xb0, 0x66, 0x3d, 0xf0, 0x57, 0xe8, 0x76, 0x76, 0xd7, 0x08, 0x7b,
+	0x51, 0x41, 0xd4, 0x67, 0x6e, 0xa8, 0x2c, 0xb3, 0xbc, 0x6b, 0x8c, 0x95, 0xdd, 0x03, 0xc2, 0xbe,
+	0x55, 0x82, 0x75, 0xc6, 0x66, 0x60, 0xe5, 0x5c, 0xf1, 0x53, 0x84, 0xa7, 0x67, 0x08, 0x84, 0xbd,
+	0xa4, 0x00, 0xdf, 0xc9, 0x01, 0x96, 0xcd, 0xab, 0xc1, 0xac, 0x4d, 0x8d, 0x1b, 0x08, 0xfc, 0x18,
+	0xad, 0x90, 0x48, 0x72, 0xd7, 0x33, 0x03, 0x27, 0xec, 0xe5, 0x12, 0x92, 0x85, 0x91, 0x4c, 0x48,
+	0x92, 0x8c, 0x42, 0xe0, 0xef, 0xd0, 0xa6, 0xe4, 0x17, 0xc0, 0xe8, 0x0f, 0xe0, 0xbb, 0xd9, 0xc4,
+	0x91, 0xc2, 0xdc, 0xc9, 0x61, 0x3e, 0x49, 0x2c, 0x0b, 0x17, 0xb2, 0x21, 0x8b, 0x2a, 0x81, 0x19,
+	0xba, 0xa7, 0xe4, 0x6e, 0xc0, 0xfb, 0x3e, 0x84, 0xae, 0x69, 0xaf, 0x80, 0x0a, 0xc9, 0x43, 0x0a,
+	0xc2, 0xae, 0xa8, 0x20, 0xef, 0x15, 0x83, 0x3c, 0x52, 0x0e, 0xba, 0xb9, 0x1e, 0x29, 0xf3, 0x71,
+	0xd2, 0x4a, 0xb2, 0x5c, 0x4f, 0x41, 0x60, 0x82, 0x36, 0x27, 0x13, 0x31, 0x0c, 0xa1, 0x0b, 0x21,
+	0x30, 0x0f, 0x84, 0x5d, 0x55, 0x71, 0xde, 0x2f, 0x9f, 0x0b, 0x35, 0x60, 0x27, 0x13, 0xeb, 0x24,
+	0xa5, 0x14, 0x2a, 0xa3, 0xc3, 0xcf, 0xd1, 0x7a, 0x37, 0xde, 0x33, 0x6e, 0xbe, 0x4d, 0x6e, 0xab,
+	0x00, 0xef, 0xe6, 0x07, 0xb7, 0x74, 0x1f, 0x19, 0x74, 0xdc, 0x9d, 0xd6, 0x0a, 0xfc, 0x7d, 0x96,
+	0xbe, 0xc7, 0x07, 0x03, 0x2a, 0x84, 0x42, 0x5f, 0x29, 0x29, 0x53, 0x9e, 0x7e, 0x3b, 0xb5, 0x2e,
+	0xb0, 0x9f, 0xa8, 0xd4, 0xa6, 0x19, 0x86, 0x34, 0xae, 0xc8, 0xea, 0xac, 0x4d, 0x73, 0x12, 0xeb,
+	0xd3, 0x05, 0xa5, 0x8c, 0xf1, 0x21, 0x42, 0xe9, 0xbb, 0x4a, 0xd8, 0x6b, 0xca, 0xf5, 0x7f, 0x45,
+	0xd7, 0xe3, 0xc4, 0xc6, 0xb8, 0x67, 0x9c, 0x70, 0x07, 0xad, 0x77, 0x29, 0xa3, 0x22, 0x00, 0xdf,
+	0xcd, 0x60, 0xd5, 0xde, 0x16, 0x0b, 0x27, 0xde, 0xc7, 0x13, 0xcc, 0x31, 0xc2, 0x92, 0x5c, 0x80,
+	0x1b, 0x12, 0x09, 0x6e, 0x08, 0x23, 0x60, 0x11, 0x08, 0x1b, 0x2b, 0xc8, 0x2d, 0x47, 0x3f, 0xe2,
+	0x9c, 0xf8, 0x11, 0xe7, 0x98, 0x47, 0x9c, 0xd3, 0xe6, 0x94, 0x1d, 0x7d, 0x12, 0x03, 0xfe, 0xf2,
+	0xa6, 0xb1, 0xdb, 0xa3, 0x32, 0x88, 0xce, 0x1d, 0x8f, 0x0f, 0x5a, 0xe6, 0xc5, 0xa7, 0x3f, 0x7b,
+	0xc2, 0xbf, 0x68, 0xc9, 0xf1, 0x10, 0x84, 0x72, 0x10, 0x9d, 0xb5, 0x38, 0x4c, 0x87, 0x48, 0xe8,
+	0x98, 0x20, 0xf8, 0x0b, 0x54, 0x31, 0xcd, 0xec, 0x47, 0x42, 0xda, 0xeb, 0x3b, 0x56, 0xa1, 0x9a,
+	0xba, 0x39, 0xef, 0x47, 0x22, 0xd9, 0xdb, 0x28, 0x4c, 0x25, 0xf8, 0x73, 0x74, 0x4b, 0x3d, 0x49,
+	0x41, 0xd8, 0x1b, 0xb3, 0x6e, 0xe2, 0x34, 0x36, 0x30, 0xbe, 0x89, 0xf5, 0xd1, 0xc3, 0x57, 0x57,
+	0x75, 0xeb, 0xf5, 0x55, 0xdd, 0xfa, 0xf3, 0xaa, 0x6e, 0xbd, 0xbc, 0xae, 0xcf, 0xbd, 0xbe, 0xae,
+	0xcf, 0xfd, 0x7e, 0x5d, 0x9f, 0x7b, 0xbe, 0x97, 0x49, 0x47, 0xa1, 0xec, 0xf1, 0x6e, 0x97, 0x7a,
+	0x94, 0xf4, 0xf5, 0xb1, 0xf5, 0xc2, 0x7c, 0x55, 0x66, 0xe7, 0x8b, 0xea, 0x69, 0xf5, 0xe9, 0xdf,
+	0x03, 0x00, 0xca, 0x97, 0xa2, 0xcf, 0xb0, 0x0b, 0x00, 0x00,
 }
 
 func (m *ValidatorInfoState) Marshal() (dAtA []byte, err error) {
@@ -572,7 +885,7 @@ func (m *RewardWeightChangeSnapshotState) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawAddressState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -582,241 +895,1460 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawAddressState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawAddressState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Undelegations) > 0 {
-		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Redelegations) > 0 {
-		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RewardWeightChangeSnaphots) > 0 {
-		for iNdEx := len(m.RewardWeightChangeSnaphots) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardWeightChangeSnaphots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ValidatorInfos) > 0 {
-		for iNdEx := len(m.ValidatorInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ForceUndelegationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorInfoState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ForceUndelegationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceUndelegationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Validator.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Redelegation.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UndelegationState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Undelegation.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardWeightChangeSnapshotState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovGenesis(uint64(m.Height))
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.RewardDust.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.TakeRateRevenues) > 0 {
+		for iNdEx := len(m.TakeRateRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	l = m.Snapshot.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FinishedIncentives) > 0 {
+		for iNdEx := len(m.FinishedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinishedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Incentives) > 0 {
+		for iNdEx := len(m.Incentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Incentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.ValidatorInfos) > 0 {
-		for _, e := range m.ValidatorInfos {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
 	}
-	if len(m.RewardWeightChangeSnaphots) > 0 {
-		for _, e := range m.RewardWeightChangeSnaphots {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorCommissions) > 0 {
+		for iNdEx := len(m.ValidatorCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ForceUndelegations) > 0 {
+		for iNdEx := len(m.ForceUndelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForceUndelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.Redelegations) > 0 {
-		for _, e := range m.Redelegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorPreferences) > 0 {
+		for iNdEx := len(m.ValidatorPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.Undelegations) > 0 {
-		for _, e := range m.Undelegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TokenHolderRewardHistories) > 0 {
+		for iNdEx := len(m.TokenHolderRewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenHolderRewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TokenizedDelegations) > 0 {
+		for iNdEx := len(m.TokenizedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for iNdEx := len(m.WithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Undelegations) > 0 {
+		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardWeightChangeSnaphots) > 0 {
+		for iNdEx := len(m.RewardWeightChangeSnaphots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightChangeSnaphots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorInfos) > 0 {
+		for iNdEx := len(m.ValidatorInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorInfoState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RedelegationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Redelegation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UndelegationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Undelegation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RewardWeightChangeSnapshotState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *WithdrawAddressState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ForceUndelegationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorInfos) > 0 {
+		for _, e := range m.ValidatorInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardWeightChangeSnaphots) > 0 {
+		for _, e := range m.RewardWeightChangeSnaphots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for _, e := range m.WithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizedDelegations) > 0 {
+		for _, e := range m.TokenizedDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenHolderRewardHistories) > 0 {
+		for _, e := range m.TokenHolderRewardHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorPreferences) > 0 {
+		for _, e := range m.ValidatorPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForceUndelegations) > 0 {
+		for _, e := range m.ForceUndelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissions) > 0 {
+		for _, e := range m.ValidatorCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FinishedIncentives) > 0 {
+		for _, e := range m.FinishedIncentives {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakeRateRevenues) > 0 {
+		for _, e := range m.TakeRateRevenues {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardDust.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorInfoState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorInfoState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UndelegationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UndelegationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UndelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Undelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshotState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshotState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
+func (m *ForceUndelegationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,10 +2371,10 @@ func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorInfoState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceUndelegationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorInfoState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceUndelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -879,9 +2411,9 @@ func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -891,23 +2423,56 @@ func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -931,7 +2496,7 @@ func (m *ValidatorInfoState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationState) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -954,15 +2519,184 @@ func (m *RedelegationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, FuryaAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorInfos = append(m.ValidatorInfos, ValidatorInfoState{})
+			if err := m.ValidatorInfos[len(m.ValidatorInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightChangeSnaphots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightChangeSnaphots = append(m.RewardWeightChangeSnaphots, RewardWeightChangeSnapshotState{})
+			if err := m.RewardWeightChangeSnaphots[len(m.RewardWeightChangeSnaphots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -989,13 +2723,14 @@ func (m *RedelegationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, RedelegationState{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1022,63 +2757,14 @@ func (m *RedelegationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Undelegations = append(m.Undelegations, UndelegationState{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UndelegationState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1105,13 +2791,14 @@ func (m *UndelegationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawAddresses = append(m.WithdrawAddresses, WithdrawAddressState{})
+			if err := m.WithdrawAddresses[len(m.WithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Undelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1138,65 +2825,16 @@ func (m *UndelegationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Undelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompoundState{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshotState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshotState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedDelegations", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1206,16 +2844,31 @@ func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizedDelegations = append(m.TokenizedDelegations, TokenizedDelegation{})
+			if err := m.TokenizedDelegations[len(m.TokenizedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHolderRewardHistories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1225,29 +2878,31 @@ func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.TokenHolderRewardHistories = append(m.TokenHolderRewardHistories, TokenHolderRewardHistory{})
+			if err := m.TokenHolderRewardHistories[len(m.TokenHolderRewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPreferences", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1257,27 +2912,29 @@ func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorPreferences = append(m.ValidatorPreferences, ValidatorFuryaPreferences{})
+			if err := m.ValidatorPreferences[len(m.ValidatorPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUndelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1304,63 +2961,14 @@ func (m *RewardWeightChangeSnapshotState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForceUndelegations = append(m.ForceUndelegations, ForceUndelegationState{})
+			if err := m.ForceUndelegations[len(m.ForceUndelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1387,13 +2995,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorCommissions = append(m.ValidatorCommissions, ValidatorFuryaCommission{})
+			if err := m.ValidatorCommissions[len(m.ValidatorCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1420,14 +3029,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, FuryaAsset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, FuryaPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,14 +3063,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorInfos = append(m.ValidatorInfos, ValidatorInfoState{})
-			if err := m.ValidatorInfos[len(m.ValidatorInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Incentives = append(m.Incentives, FuryaIncentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightChangeSnaphots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedIncentives", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,14 +3097,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardWeightChangeSnaphots = append(m.RewardWeightChangeSnaphots, RewardWeightChangeSnapshotState{})
-			if err := m.RewardWeightChangeSnaphots[len(m.RewardWeightChangeSnaphots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinishedIncentives = append(m.FinishedIncentives, FuryaIncentive{})
+			if err := m.FinishedIncentives[len(m.FinishedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateRevenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1522,14 +3131,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakeRateRevenues = append(m.TakeRateRevenues, types.Coin{})
+			if err := m.TakeRateRevenues[len(m.TakeRateRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDust", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1556,14 +3165,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redelegations = append(m.Redelegations, RedelegationState{})
-			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardDust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1590,8 +3198,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Undelegations = append(m.Undelegations, UndelegationState{})
-			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, FuryaSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
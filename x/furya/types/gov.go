@@ -25,7 +25,7 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeUpdateFurya)
 	govtypes.RegisterProposalType(ProposalTypeDeleteFurya)
 }
-func NewMsgCreateFuryaProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration) govtypes.Content {
+func NewMsgCreateFuryaProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration, minDelegationAmount, maxTotalTokens *sdk.Int, pricePeg *RewardWeightPricePeg, minRewardWeight, maxRewardWeight *sdk.Dec, rewardWeightSchedule *RewardWeightSchedule, slashMultiplier *sdk.Dec) govtypes.Content {
 	return &MsgCreateFuryaProposal{
 		Title:                title,
 		Description:          description,
@@ -34,6 +34,13 @@ func NewMsgCreateFuryaProposal(title, description, denom string, rewardWeight, t
 		TakeRate:             takeRate,
 		RewardChangeRate:     rewardChangeRate,
 		RewardChangeInterval: rewardChangeInterval,
+		MinDelegationAmount:  minDelegationAmount,
+		MaxTotalTokens:       maxTotalTokens,
+		PricePeg:             pricePeg,
+		MinRewardWeight:      minRewardWeight,
+		MaxRewardWeight:      maxRewardWeight,
+		RewardWeightSchedule: rewardWeightSchedule,
+		SlashMultiplier:      slashMultiplier,
 	}
 }
 func (m *MsgCreateFuryaProposal) GetTitle() string       { return m.Title }
@@ -59,10 +66,33 @@ func (m *MsgCreateFuryaProposal) ValidateBasic() error {
 		return status.Errorf(codes.InvalidArgument, "Furya rewardChangeRate must be strictly a positive number")
 	}
 
-	return nil
+	if m.PricePeg != nil {
+		if err := m.PricePeg.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya pricePeg is invalid: %s", err)
+		}
+	}
+
+	if err := ValidateRewardWeightBounds(m.MinRewardWeight, m.MaxRewardWeight); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya reward weight bounds are invalid: %s", err)
+	}
+
+	if m.RewardWeightSchedule != nil {
+		if m.PricePeg != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya cannot have both a pricePeg and a rewardWeightSchedule")
+		}
+		if err := m.RewardWeightSchedule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya rewardWeightSchedule is invalid: %s", err)
+		}
+	}
+
+	if err := ValidateSlashMultiplier(m.SlashMultiplier); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya slashMultiplier is invalid: %s", err)
+	}
+
+	return validateDelegationLimits(m.MinDelegationAmount, m.MaxTotalTokens)
 }
 
-func NewMsgUpdateFuryaProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration) govtypes.Content {
+func NewMsgUpdateFuryaProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration, minDelegationAmount, maxTotalTokens *sdk.Int, pricePeg *RewardWeightPricePeg, minRewardWeight, maxRewardWeight *sdk.Dec, rewardWeightSchedule *RewardWeightSchedule, slashMultiplier *sdk.Dec) govtypes.Content {
 	return &MsgUpdateFuryaProposal{
 		Title:                title,
 		Description:          description,
@@ -71,6 +101,13 @@ func NewMsgUpdateFuryaProposal(title, description, denom string, rewardWeight, t
 		TakeRate:             takeRate,
 		RewardChangeRate:     rewardChangeRate,
 		RewardChangeInterval: rewardChangeInterval,
+		MinDelegationAmount:  minDelegationAmount,
+		MaxTotalTokens:       maxTotalTokens,
+		PricePeg:             pricePeg,
+		MinRewardWeight:      minRewardWeight,
+		MaxRewardWeight:      maxRewardWeight,
+		RewardWeightSchedule: rewardWeightSchedule,
+		SlashMultiplier:      slashMultiplier,
 	}
 }
 func (m *MsgUpdateFuryaProposal) GetTitle() string       { return m.Title }
@@ -95,7 +132,30 @@ func (m *MsgUpdateFuryaProposal) ValidateBasic() error {
 		return status.Errorf(codes.InvalidArgument, "Furya rewardChangeRate must be strictly a positive number")
 	}
 
-	return nil
+	if m.PricePeg != nil {
+		if err := m.PricePeg.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya pricePeg is invalid: %s", err)
+		}
+	}
+
+	if err := ValidateRewardWeightBounds(m.MinRewardWeight, m.MaxRewardWeight); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya reward weight bounds are invalid: %s", err)
+	}
+
+	if m.RewardWeightSchedule != nil {
+		if m.PricePeg != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya cannot have both a pricePeg and a rewardWeightSchedule")
+		}
+		if err := m.RewardWeightSchedule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya rewardWeightSchedule is invalid: %s", err)
+		}
+	}
+
+	if err := ValidateSlashMultiplier(m.SlashMultiplier); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya slashMultiplier is invalid: %s", err)
+	}
+
+	return validateDelegationLimits(m.MinDelegationAmount, m.MaxTotalTokens)
 }
 
 func NewMsgDeleteFuryaProposal(title, description, denom string) govtypes.Content {
@@ -116,3 +176,19 @@ func (m *MsgDeleteFuryaProposal) ValidateBasic() error {
 	}
 	return nil
 }
+
+func validateDelegationLimits(minDelegationAmount, maxTotalTokens *sdk.Int) error {
+	if minDelegationAmount != nil && minDelegationAmount.IsNegative() {
+		return status.Errorf(codes.InvalidArgument, "Furya minDelegationAmount must be more or equals to 0")
+	}
+
+	if maxTotalTokens != nil && !maxTotalTokens.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Furya maxTotalTokens must be strictly a positive number")
+	}
+
+	if minDelegationAmount != nil && maxTotalTokens != nil && minDelegationAmount.GT(*maxTotalTokens) {
+		return status.Errorf(codes.InvalidArgument, "Furya minDelegationAmount must be less or equals to maxTotalTokens")
+	}
+
+	return nil
+}
@@ -44,13 +44,27 @@ type MsgCreateFuryaProposal struct {
 	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,7,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Smallest amount that can be delegated or redelegated at once. Unset means no minimum
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// Maximum amount of tokens that can be delegated in total. Unset means no cap
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Derives the reward weight from the prices posted by the price feeders. Unset disables the peg
+	PricePeg *RewardWeightPricePeg `protobuf:"bytes,10,opt,name=price_peg,json=pricePeg,proto3" json:"price_peg,omitempty"`
+	// Lowest reward weight the reward change rate can decay the asset to. Unset means no floor
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Highest reward weight the reward change rate can inflate the asset to. Unset means no ceiling
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Sets the reward weight from governance defined breakpoints. Unset disables the schedule
+	RewardWeightSchedule *RewardWeightSchedule `protobuf:"bytes,13,opt,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule,omitempty"`
+	// Multiplies the fraction delegations of the asset are slashed by. Unset means a multiplier of 1
+	SlashMultiplier *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_multiplier,json=slashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_multiplier,omitempty"`
 }
 
 func (m *MsgCreateFuryaProposal) Reset()         { *m = MsgCreateFuryaProposal{} }
 func (m *MsgCreateFuryaProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateFuryaProposal) ProtoMessage()    {}
 func (*MsgCreateFuryaProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5518a6f5c90c8452, []int{0}
+	return fileDescriptor_35b740c76359f116, []int{0}
 }
 func (m *MsgCreateFuryaProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -93,13 +107,27 @@ type MsgUpdateFuryaProposal struct {
 	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,7,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Smallest amount that can be delegated or redelegated at once. Unset means no minimum
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// Maximum amount of tokens that can be delegated in total. Unset means no cap
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Derives the reward weight from the prices posted by the price feeders. Unset disables the peg
+	PricePeg *RewardWeightPricePeg `protobuf:"bytes,10,opt,name=price_peg,json=pricePeg,proto3" json:"price_peg,omitempty"`
+	// Lowest reward weight the reward change rate can decay the asset to. Unset means no floor
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Highest reward weight the reward change rate can inflate the asset to. Unset means no ceiling
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Sets the reward weight from governance defined breakpoints. Unset disables the schedule
+	RewardWeightSchedule *RewardWeightSchedule `protobuf:"bytes,13,opt,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule,omitempty"`
+	// Multiplies the fraction delegations of the asset are slashed by. Unset means a multiplier of 1
+	SlashMultiplier *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_multiplier,json=slashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_multiplier,omitempty"`
 }
 
 func (m *MsgUpdateFuryaProposal) Reset()         { *m = MsgUpdateFuryaProposal{} }
 func (m *MsgUpdateFuryaProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateFuryaProposal) ProtoMessage()    {}
 func (*MsgUpdateFuryaProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5518a6f5c90c8452, []int{1}
+	return fileDescriptor_35b740c76359f116, []int{1}
 }
 func (m *MsgUpdateFuryaProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,7 +168,7 @@ func (m *MsgDeleteFuryaProposal) Reset()         { *m = MsgDeleteFuryaProposal{}
 func (m *MsgDeleteFuryaProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteFuryaProposal) ProtoMessage()    {}
 func (*MsgDeleteFuryaProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5518a6f5c90c8452, []int{2}
+	return fileDescriptor_35b740c76359f116, []int{2}
 }
 func (m *MsgDeleteFuryaProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,38 +203,49 @@ func init() {
 	proto.RegisterType((*MsgDeleteFuryaProposal)(nil), "furya.furya.MsgDeleteFuryaProposal")
 }
 
-func init() { proto.RegisterFile("furya/gov.proto", fileDescriptor_5518a6f5c90c8452) }
+func init() { proto.RegisterFile("furya/gov.proto", fileDescriptor_35b740c76359f116) }
 
-var fileDescriptor_5518a6f5c90c8452 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x63, 0x8e, 0x1e, 0x3d, 0xdf, 0x21, 0x1d, 0x56, 0x85, 0x72, 0x37, 0x24, 0x55, 0x87,
-	0xd3, 0x2d, 0xe7, 0x20, 0xd8, 0xba, 0xd1, 0x76, 0x01, 0x84, 0x84, 0x82, 0x10, 0x02, 0x21, 0x55,
-	0x6e, 0xf2, 0x70, 0xa3, 0x3a, 0x71, 0xe4, 0xb8, 0x2d, 0xfd, 0x00, 0x48, 0x8c, 0x8c, 0x8c, 0xfd,
-	0x38, 0x1d, 0x3b, 0x22, 0x86, 0x52, 0xb5, 0x0b, 0x33, 0x9f, 0x00, 0xc5, 0x49, 0x68, 0x59, 0x3b,
-	0x30, 0x20, 0xa6, 0x3c, 0xbf, 0xbf, 0xf5, 0xcb, 0xd3, 0xfb, 0x49, 0xc6, 0x84, 0x09, 0x11, 0xb1,
-	0x24, 0x00, 0x8f, 0xcb, 0x09, 0x4d, 0x95, 0xd4, 0x92, 0xdc, 0xab, 0x7a, 0xb4, 0x2a, 0x2e, 0x1b,
-	0x5c, 0x72, 0x69, 0x52, 0x2f, 0xaf, 0x8a, 0x8b, 0x97, 0x0e, 0x97, 0x92, 0x0b, 0xf0, 0xcc, 0x69,
-	0x30, 0x7e, 0xef, 0x85, 0x63, 0xc5, 0x74, 0x24, 0x93, 0x22, 0x6f, 0xad, 0x8f, 0xf0, 0xc5, 0xf3,
-	0x8c, 0x77, 0x15, 0x30, 0x0d, 0x8f, 0x4b, 0xd6, 0x0b, 0x25, 0x53, 0x99, 0x31, 0x41, 0x1a, 0xb8,
-	0xa6, 0x23, 0x2d, 0xc0, 0x46, 0x4d, 0x74, 0x7d, 0xe2, 0x17, 0x07, 0xd2, 0xc4, 0xa7, 0x21, 0x64,
-	0x81, 0x8a, 0xd2, 0x1c, 0x64, 0xdf, 0x32, 0xd9, 0x7e, 0x8b, 0x5c, 0xe1, 0x5a, 0x08, 0x89, 0x8c,
-	0xed, 0xa3, 0x3c, 0xeb, 0x9c, 0xff, 0x5c, 0xb9, 0x67, 0x33, 0x16, 0x8b, 0x76, 0xcb, 0xb4, 0x5b,
-	0x7e, 0x11, 0x93, 0x97, 0xf8, 0xae, 0x82, 0x29, 0x53, 0x61, 0x7f, 0x0a, 0x11, 0x1f, 0x6a, 0xfb,
-	0xb6, 0xb9, 0x4f, 0x17, 0x2b, 0xd7, 0xfa, 0xb6, 0x72, 0xaf, 0x78, 0xa4, 0x87, 0xe3, 0x01, 0x0d,
-	0x64, 0xec, 0x05, 0x32, 0x8b, 0x65, 0x56, 0x7e, 0x6e, 0xb2, 0x70, 0xe4, 0xe9, 0x59, 0x0a, 0x19,
-	0xed, 0x41, 0xe0, 0x9f, 0x15, 0x90, 0xd7, 0x86, 0x41, 0x9e, 0xe1, 0x13, 0xcd, 0x46, 0xd0, 0x57,
-	0x4c, 0x83, 0x5d, 0x3b, 0x08, 0x58, 0xcf, 0x01, 0x3e, 0xd3, 0x40, 0xde, 0x61, 0x52, 0x4e, 0x18,
-	0x0c, 0x59, 0xc2, 0x4b, 0xea, 0xf1, 0x41, 0xd4, 0xf3, 0x82, 0xd4, 0x35, 0x20, 0x43, 0x7f, 0x83,
-	0xef, 0xff, 0x49, 0x8f, 0x12, 0x0d, 0x6a, 0xc2, 0x84, 0x7d, 0xa7, 0x89, 0xae, 0x4f, 0x1f, 0x5e,
-	0xd0, 0x42, 0x1f, 0xad, 0xf4, 0xd1, 0x5e, 0xa9, 0xaf, 0x53, 0xcf, 0x7f, 0xfe, 0xe5, 0xbb, 0x8b,
-	0xfc, 0xc6, 0x3e, 0xf6, 0x49, 0x09, 0x68, 0xd7, 0x3f, 0xcd, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0x2a,
-	0xc5, 0xaf, 0xd2, 0xf0, 0xbf, 0xe2, 0x7f, 0x54, 0xf1, 0x47, 0x64, 0x14, 0xf7, 0x40, 0xc0, 0xdf,
-	0x57, 0xbc, 0x9b, 0xa3, 0xf3, 0x74, 0xb1, 0x71, 0xd0, 0x72, 0xe3, 0xa0, 0xf5, 0xc6, 0x41, 0x9f,
-	0xb7, 0x8e, 0xb5, 0xdc, 0x3a, 0xd6, 0xd7, 0xad, 0x63, 0xbd, 0x7d, 0xb0, 0xb7, 0x40, 0x0d, 0x4a,
-	0xb1, 0x9b, 0x58, 0x26, 0x30, 0xf3, 0x7e, 0xbf, 0x6d, 0x1f, 0x76, 0xa5, 0x59, 0xe7, 0xe0, 0xd8,
-	0x2c, 0xe4, 0xd1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x28, 0xa4, 0x36, 0xff, 0x04, 0x00,
-	0x00,
+var fileDescriptor_35b740c76359f116 = []byte{
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0xdf, 0x8f, 0x16, 0xe7, 0xd2, 0x3f, 0xe9, 0x51, 0x2a, 0xd3, 0xc1, 0x2e, 0x1d,
+	0xaa, 0x0a, 0xa9, 0xb6, 0x04, 0x5b, 0x07, 0x24, 0xda, 0x0a, 0x54, 0xa1, 0x4a, 0x95, 0xdb, 0x52,
+	0x81, 0x10, 0xd6, 0xd5, 0xbe, 0x5c, 0x4e, 0x39, 0xfb, 0xac, 0xf3, 0xb9, 0x4d, 0x56, 0x26, 0x46,
+	0x46, 0xc6, 0xbe, 0x07, 0xde, 0x44, 0xc7, 0x8e, 0x88, 0x21, 0xa0, 0x64, 0x61, 0xe6, 0x15, 0x20,
+	0x9f, 0x9d, 0xe2, 0xb0, 0xd0, 0x64, 0x60, 0xca, 0x92, 0xbb, 0x7b, 0x9e, 0x27, 0x9f, 0x7b, 0x9e,
+	0x7b, 0x1e, 0x7f, 0xc1, 0x62, 0x33, 0x13, 0x5d, 0xe4, 0x12, 0x7e, 0xee, 0x24, 0x82, 0x4b, 0x0e,
+	0xeb, 0xca, 0xe0, 0xa8, 0xdf, 0xd5, 0x65, 0xc2, 0x09, 0x57, 0x76, 0x37, 0xdf, 0x15, 0x21, 0xab,
+	0x16, 0xe1, 0x9c, 0x30, 0xec, 0xaa, 0xd3, 0x59, 0xd6, 0x74, 0xc3, 0x4c, 0x20, 0x49, 0x79, 0x5c,
+	0xfa, 0x97, 0x0a, 0x66, 0x01, 0x52, 0xa6, 0xf5, 0xcf, 0x06, 0x58, 0x39, 0x48, 0xc9, 0xae, 0xc0,
+	0x48, 0xe2, 0xe7, 0xb9, 0xe3, 0x50, 0xf0, 0x84, 0xa7, 0x88, 0xc1, 0x65, 0x30, 0x23, 0xa9, 0x64,
+	0xd8, 0xd4, 0xd7, 0xf4, 0xcd, 0x9a, 0x57, 0x1c, 0xe0, 0x1a, 0xa8, 0x87, 0x38, 0x0d, 0x04, 0x4d,
+	0x72, 0xb0, 0xf9, 0x9f, 0xf2, 0x55, 0x4d, 0x70, 0x03, 0xcc, 0x84, 0x38, 0xe6, 0x91, 0xf9, 0x7f,
+	0xee, 0xdb, 0x69, 0xfc, 0xec, 0xd9, 0x73, 0x5d, 0x14, 0xb1, 0xed, 0x75, 0x65, 0x5e, 0xf7, 0x0a,
+	0x37, 0x3c, 0x02, 0xf3, 0x02, 0x5f, 0x20, 0x11, 0xfa, 0x17, 0x98, 0x92, 0x96, 0x34, 0xef, 0xa8,
+	0x78, 0xe7, 0xaa, 0x67, 0x6b, 0x5f, 0x7b, 0xf6, 0x06, 0xa1, 0xb2, 0x95, 0x9d, 0x39, 0x01, 0x8f,
+	0xdc, 0x80, 0xa7, 0x11, 0x4f, 0xcb, 0x65, 0x2b, 0x0d, 0xdb, 0xae, 0xec, 0x26, 0x38, 0x75, 0xf6,
+	0x70, 0xe0, 0xcd, 0x15, 0x90, 0x53, 0xc5, 0x80, 0x2f, 0x41, 0x4d, 0xa2, 0x36, 0xf6, 0x05, 0x92,
+	0xd8, 0x9c, 0x99, 0x08, 0x68, 0xe4, 0x00, 0x0f, 0x49, 0x0c, 0xdf, 0x02, 0x58, 0x66, 0x18, 0xb4,
+	0x50, 0x4c, 0x4a, 0xea, 0xec, 0x44, 0xd4, 0x46, 0x41, 0xda, 0x55, 0x20, 0x45, 0x7f, 0x0d, 0x56,
+	0x46, 0xe9, 0x34, 0x96, 0x58, 0x9c, 0x23, 0x66, 0xde, 0x5d, 0xd3, 0x37, 0xeb, 0x8f, 0x1f, 0x38,
+	0x45, 0x3b, 0x9d, 0x61, 0x3b, 0x9d, 0xbd, 0xb2, 0x9d, 0x3b, 0x46, 0x7e, 0xf9, 0xa7, 0x6f, 0xb6,
+	0xee, 0x2d, 0x57, 0xb1, 0xfb, 0x25, 0x00, 0xbe, 0x03, 0xf7, 0x23, 0x1a, 0xfb, 0x21, 0x66, 0x98,
+	0xa8, 0x7f, 0xf8, 0x28, 0xe2, 0x59, 0x2c, 0x4d, 0x43, 0xe5, 0xfe, 0xe8, 0x96, 0x79, 0xef, 0xc7,
+	0xd2, 0xbb, 0x17, 0xd1, 0x78, 0xef, 0x86, 0xf3, 0x4c, 0x61, 0xe0, 0x31, 0x68, 0x44, 0xa8, 0xe3,
+	0x4b, 0x2e, 0x11, 0xf3, 0x25, 0x6f, 0xe3, 0x38, 0x35, 0x6b, 0x63, 0xa3, 0x17, 0x22, 0xd4, 0x39,
+	0xce, 0x11, 0xc7, 0x8a, 0x00, 0x9f, 0x82, 0x5a, 0x22, 0x68, 0x80, 0xfd, 0x04, 0x13, 0x13, 0xa8,
+	0x37, 0x78, 0xe8, 0x54, 0xa6, 0xde, 0xf1, 0x2a, 0x9d, 0x3e, 0xcc, 0x23, 0x0f, 0x31, 0xf1, 0x8c,
+	0xa4, 0xdc, 0xc1, 0x57, 0x60, 0x29, 0xaf, 0x7a, 0x74, 0xa8, 0xea, 0x63, 0xa5, 0x95, 0x77, 0x6a,
+	0x31, 0xa2, 0x71, 0xf5, 0x26, 0xc5, 0x45, 0x9d, 0x3f, 0xb8, 0x73, 0x13, 0x70, 0x51, 0x67, 0x84,
+	0x7b, 0x0a, 0x56, 0x46, 0x98, 0x7e, 0x1a, 0xb4, 0x70, 0x98, 0x31, 0x6c, 0xce, 0xff, 0xa5, 0xf8,
+	0xa3, 0x32, 0x70, 0xd8, 0xfe, 0x51, 0x2b, 0x3c, 0x01, 0x8d, 0x94, 0xa1, 0xb4, 0xe5, 0x47, 0x19,
+	0x93, 0x34, 0x61, 0x14, 0x0b, 0x73, 0x61, 0xfc, 0x7c, 0x15, 0xe3, 0xe0, 0x06, 0xb1, 0x6d, 0x7c,
+	0xb8, 0xb4, 0xb5, 0x1f, 0x97, 0xb6, 0x36, 0x54, 0x8d, 0x93, 0x24, 0x9c, 0xaa, 0xc6, 0x54, 0x35,
+	0xa6, 0xaa, 0x31, 0x55, 0x8d, 0xdb, 0xa8, 0xc6, 0x7b, 0x5d, 0xa9, 0x46, 0x3e, 0x17, 0xff, 0x58,
+	0x35, 0x7e, 0x27, 0xb1, 0xf3, 0xe2, 0xaa, 0x6f, 0xe9, 0xd7, 0x7d, 0x4b, 0xff, 0xde, 0xb7, 0xf4,
+	0x8f, 0x03, 0x4b, 0xbb, 0x1e, 0x58, 0xda, 0x97, 0x81, 0xa5, 0xbd, 0xd9, 0xaa, 0x54, 0xa8, 0x1e,
+	0x6f, 0x8b, 0x37, 0x9b, 0x34, 0xa0, 0x88, 0x15, 0x47, 0xb7, 0x53, 0xae, 0xaa, 0xd8, 0xb3, 0x59,
+	0xf5, 0x81, 0x3d, 0xf9, 0x35, 0x00, 0x14, 0x7b, 0x51, 0x00, 0xa9, 0x09, 0x00, 0x00,
 }
 
 func (m *MsgCreateFuryaProposal) Marshal() (dAtA []byte, err error) {
@@ -229,12 +268,96 @@ func (m *MsgCreateFuryaProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
-	if err1 != nil {
-		return 0, err1
+	if m.SlashMultiplier != nil {
+		{
+			size := m.SlashMultiplier.Size()
+			i -= size
+			if _, err := m.SlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RewardWeightSchedule != nil {
+		{
+			size, err := m.RewardWeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PricePeg != nil {
+		{
+			size, err := m.PricePeg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	i -= n1
-	i = encodeVarintGov(dAtA, i, uint64(n1))
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -311,12 +434,96 @@ func (m *MsgUpdateFuryaProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
-	if err2 != nil {
-		return 0, err2
+	if m.SlashMultiplier != nil {
+		{
+			size := m.SlashMultiplier.Size()
+			i -= size
+			if _, err := m.SlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RewardWeightSchedule != nil {
+		{
+			size, err := m.RewardWeightSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	i -= n2
-	i = encodeVarintGov(dAtA, i, uint64(n2))
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PricePeg != nil {
+		{
+			size, err := m.PricePeg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGov(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x3a
 	{
@@ -454,6 +661,34 @@ func (m *MsgCreateFuryaProposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
 	n += 1 + l + sovGov(uint64(l))
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PricePeg != nil {
+		l = m.PricePeg.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.RewardWeightSchedule != nil {
+		l = m.RewardWeightSchedule.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SlashMultiplier != nil {
+		l = m.SlashMultiplier.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -483,6 +718,34 @@ func (m *MsgUpdateFuryaProposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
 	n += 1 + l + sovGov(uint64(l))
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PricePeg != nil {
+		l = m.PricePeg.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.RewardWeightSchedule != nil {
+		l = m.RewardWeightSchedule.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SlashMultiplier != nil {
+		l = m.SlashMultiplier.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -773,6 +1036,258 @@ func (m *MsgCreateFuryaProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePeg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PricePeg == nil {
+				m.PricePeg = &RewardWeightPricePeg{}
+			}
+			if err := m.PricePeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardWeightSchedule == nil {
+				m.RewardWeightSchedule = &RewardWeightSchedule{}
+			}
+			if err := m.RewardWeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashMultiplier = &v
+			if err := m.SlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1054,6 +1569,258 @@ func (m *MsgUpdateFuryaProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePeg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PricePeg == nil {
+				m.PricePeg = &RewardWeightPricePeg{}
+			}
+			if err := m.PricePeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardWeightSchedule == nil {
+				m.RewardWeightSchedule = &RewardWeightSchedule{}
+			}
+			if err := m.RewardWeightSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashMultiplier = &v
+			if err := m.SlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -0,0 +1,934 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/incentive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FuryaIncentive is an incentive program that streams escrowed rewards to the delegators of a furya asset
+// between start_time and end_time
+type FuryaIncentive struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// Denom of the furya asset whose delegators receive the rewards
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Limits the rewards to the delegators of a single validator. Empty means all validators
+	ValidatorAddress     string                                   `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	TotalRewards         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_rewards,json=totalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rewards"`
+	DistributedRewards   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=distributed_rewards,json=distributedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_rewards"`
+	StartTime            time.Time                                `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime              time.Time                                `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	LastDistributionTime time.Time                                `protobuf:"bytes,9,opt,name=last_distribution_time,json=lastDistributionTime,proto3,stdtime" json:"last_distribution_time"`
+}
+
+func (m *FuryaIncentive) Reset()         { *m = FuryaIncentive{} }
+func (m *FuryaIncentive) String() string { return proto.CompactTextString(m) }
+func (*FuryaIncentive) ProtoMessage()    {}
+func (*FuryaIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a0e1a67d721059, []int{0}
+}
+func (m *FuryaIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FuryaIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaIncentive.Merge(m, src)
+}
+func (m *FuryaIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FuryaIncentive proto.InternalMessageInfo
+
+// AssetRewardHistory holds the reward indices of the incentives of a single furya asset
+type AssetRewardHistory struct {
+	Denom         string          `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RewardHistory []RewardHistory `protobuf:"bytes,2,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
+}
+
+func (m *AssetRewardHistory) Reset()         { *m = AssetRewardHistory{} }
+func (m *AssetRewardHistory) String() string { return proto.CompactTextString(m) }
+func (*AssetRewardHistory) ProtoMessage()    {}
+func (*AssetRewardHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55a0e1a67d721059, []int{1}
+}
+func (m *AssetRewardHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRewardHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRewardHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRewardHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRewardHistory.Merge(m, src)
+}
+func (m *AssetRewardHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRewardHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRewardHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRewardHistory proto.InternalMessageInfo
+
+func (m *AssetRewardHistory) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetRewardHistory) GetRewardHistory() []RewardHistory {
+	if m != nil {
+		return m.RewardHistory
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FuryaIncentive)(nil), "furya.furya.FuryaIncentive")
+	proto.RegisterType((*AssetRewardHistory)(nil), "furya.furya.AssetRewardHistory")
+}
+
+func init() { proto.RegisterFile("furya/incentive.proto", fileDescriptor_55a0e1a67d721059) }
+
+var fileDescriptor_55a0e1a67d721059 = []byte{
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xb6, 0xd3, 0xb4, 0x4d, 0x2e, 0xbf, 0x46, 0x3f, 0x8e, 0x80, 0xdc, 0x0c, 0x76, 0xd4, 0x29,
+	0x4b, 0xce, 0x6d, 0xd9, 0xba, 0xa0, 0xa6, 0x40, 0x61, 0x35, 0x4c, 0x5d, 0xac, 0x73, 0xee, 0xe2,
+	0x9e, 0x88, 0x7d, 0xd1, 0xdd, 0x25, 0x10, 0x89, 0x0f, 0x80, 0xc4, 0xd2, 0x8f, 0xd0, 0x99, 0x99,
+	0x0f, 0xd1, 0xb1, 0x62, 0x62, 0xa2, 0x28, 0x59, 0xf8, 0x18, 0xe8, 0xfe, 0x24, 0x98, 0x09, 0x55,
+	0x62, 0xf1, 0xf9, 0xfd, 0xf3, 0x3c, 0xcf, 0xeb, 0xf7, 0x39, 0x83, 0x47, 0xe3, 0x99, 0x58, 0xe0,
+	0x98, 0x95, 0x23, 0x5a, 0x2a, 0x36, 0xa7, 0x68, 0x2a, 0xb8, 0xe2, 0xb0, 0x65, 0xd2, 0xc8, 0x3c,
+	0xbb, 0x9d, 0x9c, 0xe7, 0xdc, 0xe4, 0x63, 0xfd, 0x66, 0x5b, 0xba, 0xfb, 0x23, 0x2e, 0x0b, 0x2e,
+	0x53, 0x5b, 0xb0, 0x81, 0x2b, 0x85, 0x36, 0x8a, 0x33, 0x2c, 0x69, 0x3c, 0x3f, 0xca, 0xa8, 0xc2,
+	0x47, 0xf1, 0x88, 0xb3, 0xd2, 0xd5, 0xa3, 0x9c, 0xf3, 0x7c, 0x42, 0x63, 0x13, 0x65, 0xb3, 0x71,
+	0xac, 0x58, 0x41, 0xa5, 0xc2, 0xc5, 0xd4, 0x35, 0x40, 0x3b, 0xd5, 0x14, 0x0b, 0x5c, 0x38, 0xd2,
+	0x83, 0x4f, 0xdb, 0xa0, 0xfd, 0x42, 0xa7, 0x5f, 0xad, 0x67, 0x85, 0x6d, 0x50, 0x63, 0x24, 0xf0,
+	0x7b, 0x7e, 0xbf, 0x9e, 0xd4, 0x18, 0x81, 0x87, 0x60, 0x67, 0x3c, 0x2b, 0x09, 0x15, 0x41, 0xad,
+	0xe7, 0xf7, 0x9b, 0xc3, 0xe0, 0xeb, 0x97, 0x41, 0xc7, 0x4d, 0x76, 0x4a, 0x88, 0xa0, 0x52, 0xbe,
+	0x56, 0x82, 0x95, 0x79, 0xe2, 0xfa, 0x60, 0x07, 0x6c, 0x13, 0x5a, 0xf2, 0x22, 0xd8, 0xd2, 0x80,
+	0xc4, 0x06, 0xf0, 0x39, 0x78, 0x30, 0xc7, 0x13, 0x46, 0xb0, 0xe2, 0x22, 0xc5, 0x16, 0x18, 0xd4,
+	0xff, 0x42, 0xf9, 0xff, 0x06, 0xe2, 0xf2, 0x70, 0x0a, 0xf6, 0x14, 0x57, 0x78, 0x92, 0x0a, 0xfa,
+	0x0e, 0x0b, 0x22, 0x83, 0xed, 0xde, 0x56, 0xbf, 0x75, 0xbc, 0x8f, 0x1c, 0x5e, 0xaf, 0x07, 0xb9,
+	0xf5, 0xa0, 0x33, 0xce, 0xca, 0xe1, 0xe1, 0xcd, 0xf7, 0xc8, 0xfb, 0x7c, 0x17, 0xf5, 0x73, 0xa6,
+	0x2e, 0x67, 0x19, 0x1a, 0xf1, 0xc2, 0x6d, 0xd6, 0x1d, 0x03, 0x49, 0xde, 0xc6, 0x6a, 0x31, 0xa5,
+	0xd2, 0x00, 0x64, 0xf2, 0x9f, 0x51, 0x48, 0xac, 0x00, 0xfc, 0x00, 0x1e, 0x12, 0x26, 0x95, 0x60,
+	0xd9, 0x4c, 0x51, 0xb2, 0xd1, 0xdd, 0xf9, 0xf7, 0xba, 0xb0, 0xa2, 0xb3, 0x56, 0x3f, 0x03, 0x40,
+	0x2a, 0x2c, 0x54, 0xaa, 0xed, 0x0c, 0x76, 0x7b, 0x7e, 0xbf, 0x75, 0xdc, 0x45, 0xd6, 0x6b, 0xb4,
+	0xf6, 0x1a, 0xbd, 0x59, 0x7b, 0x3d, 0x6c, 0x68, 0xd5, 0xab, 0xbb, 0xc8, 0x4f, 0x9a, 0x06, 0xa7,
+	0x2b, 0xf0, 0x29, 0x68, 0xd0, 0x92, 0x58, 0x8a, 0xc6, 0x3d, 0x28, 0x76, 0x69, 0x49, 0x0c, 0xc1,
+	0x05, 0x78, 0x3c, 0xc1, 0x52, 0xa5, 0x9b, 0x01, 0x19, 0x2f, 0x2d, 0x5d, 0xf3, 0x1e, 0x74, 0x1d,
+	0xcd, 0xf1, 0xac, 0x42, 0xa1, 0x9b, 0x4e, 0x1a, 0x1f, 0xaf, 0x23, 0xef, 0xe7, 0x75, 0xe4, 0x1d,
+	0x2c, 0x00, 0x3c, 0x95, 0x92, 0x2a, 0xfb, 0xed, 0x2f, 0x99, 0x54, 0x5c, 0x2c, 0x7e, 0x5f, 0x27,
+	0xbf, 0x7a, 0x9d, 0xce, 0x41, 0xdb, 0x3a, 0x91, 0x5e, 0xda, 0xbe, 0xa0, 0x66, 0x0c, 0xe9, 0xa2,
+	0xca, 0x5f, 0x86, 0xfe, 0x60, 0x1a, 0xd6, 0xf5, 0x24, 0xc9, 0x9e, 0xa8, 0x26, 0x4f, 0xea, 0x5a,
+	0x7a, 0x78, 0x7e, 0xb3, 0x0c, 0xfd, 0xdb, 0x65, 0xe8, 0xff, 0x58, 0x86, 0xfe, 0xd5, 0x2a, 0xf4,
+	0x6e, 0x57, 0xa1, 0xf7, 0x6d, 0x15, 0x7a, 0x17, 0x83, 0x8a, 0x7d, 0x86, 0x74, 0xc0, 0xc7, 0x63,
+	0x36, 0x62, 0x78, 0x62, 0xc3, 0xf8, 0xbd, 0x3b, 0x8d, 0x93, 0xd9, 0x8e, 0xd9, 0xc0, 0x93, 0x5f,
+	0x03, 0x00, 0xfd, 0x2b, 0x68, 0xf7, 0x04, 0x04, 0x00, 0x00,
+}
+
+func (m *FuryaIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastDistributionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIncentive(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIncentive(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintIncentive(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DistributedRewards) > 0 {
+		for iNdEx := len(m.DistributedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRewardHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRewardHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRewardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FuryaIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentive(uint64(m.Id))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.DistributedRewards) > 0 {
+		for _, e := range m.DistributedRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIncentive(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIncentive(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastDistributionTime)
+	n += 1 + l + sovIncentive(uint64(l))
+	return n
+}
+
+func (m *AssetRewardHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FuryaIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FuryaIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FuryaIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedRewards = append(m.DistributedRewards, types.Coin{})
+			if err := m.DistributedRewards[len(m.DistributedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastDistributionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRewardHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRewardHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRewardHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -34,21 +34,59 @@ var (
 	AssetRebalanceQueueKey        = []byte{0x13}
 	RewardWeightChangeSnapshotKey = []byte{0x14}
 	RewardWeightDecayQueueKey     = []byte{0x15}
+	ValidatorPreferencesKey       = []byte{0x16}
+	ForceUndelegationQueueKey     = []byte{0x17}
+	ValidatorCommissionKey        = []byte{0x18}
+	RewardSettlementCursorKey     = []byte{0x19}
+	RewardIndexSampleKey          = []byte{0x1A}
+	RewardIndexSampleHeadKey      = []byte{0x1B}
+	FuryaPriceKey                 = []byte{0x1C}
+	FuryaIncentiveKey             = []byte{0x1D}
+	FinishedFuryaIncentiveKey     = []byte{0x1E}
+	NextFuryaIncentiveIDKey       = []byte{0x1F}
+	TakeRateRevenueKey            = []byte{0x20}
 
 	DelegationKey        = []byte{0x21}
 	RedelegationKey      = []byte{0x22}
 	RedelegationQueueKey = []byte{0x23}
 	UndelegationQueueKey = []byte{0x24}
+	WithdrawAddressKey   = []byte{0x25}
+	AutoCompoundKey      = []byte{0x26}
+
+	TokenizedDelegationKey      = []byte{0x27}
+	TokenHolderRewardHistoryKey = []byte{0x28}
+
+	RewardDustKey = []byte{0x29}
+
+	FuryaSlashKey       = []byte{0x2A}
+	NextFuryaSlashIDKey = []byte{0x2B}
+
+	AutoCompoundCursorKey = []byte{0x2C}
 
 	// Indexes for querying
 	RedelegationByValidatorIndexKey = []byte{0x31}
 	UndelegationByValidatorIndexKey = []byte{0x32}
+	DelegationClaimHeightIndexKey   = []byte{0x33}
+
+	// Set once the claim height index covers every delegation
+	DelegationClaimHeightIndexedKey = []byte{0x34}
 )
 
 func GetAssetKey(denom string) []byte {
 	return append(AssetKey, address.MustLengthPrefix([]byte(denom))...)
 }
 
+func GetTakeRateRevenueKey(denom string) []byte {
+	return append(TakeRateRevenueKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func ParseTakeRateRevenueKey(key []byte) string {
+	offset := len(TakeRateRevenueKey)
+	denomLen := int(key[offset])
+	offset += 1
+	return string(key[offset : offset+denomLen])
+}
+
 // GetDelegationKey key is in the format of delegator|validator|denom
 func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
 	return append(GetDelegationsKeyForAllDenoms(delAddr, valAddr), address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
@@ -64,6 +102,63 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
+func GetWithdrawAddressKey(delAddr sdk.AccAddress) []byte {
+	return append(WithdrawAddressKey, address.MustLengthPrefix(delAddr)...)
+}
+
+func ParseWithdrawAddressKey(key []byte) sdk.AccAddress {
+	offset := len(WithdrawAddressKey)
+	delAddrLen := int(key[offset])
+	offset += 1
+	return key[offset : offset+delAddrLen]
+}
+
+func GetTokenizedDelegationKey(tokenDenom string) []byte {
+	return append(TokenizedDelegationKey, address.MustLengthPrefix([]byte(tokenDenom))...)
+}
+
+// GetTokenHolderRewardHistoryKey key is in the format of token_denom|holder
+func GetTokenHolderRewardHistoryKey(tokenDenom string, holder sdk.AccAddress) []byte {
+	key := append(TokenHolderRewardHistoryKey, address.MustLengthPrefix([]byte(tokenDenom))...)
+	return append(key, address.MustLengthPrefix(holder)...)
+}
+
+// GetFuryaSlashKey key is in the format of validator|id
+func GetFuryaSlashKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetFuryaSlashesKey(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFuryaSlashesKey creates the prefix for the slashes of a validator
+func GetFuryaSlashesKey(valAddr sdk.ValAddress) []byte {
+	return append(FuryaSlashKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetAutoCompoundKey key is in the format of delegator|validator|denom
+func GetAutoCompoundKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	key := append(AutoCompoundKey, address.MustLengthPrefix(delAddr)...)
+	key = append(key, address.MustLengthPrefix(valAddr)...)
+	key = append(key, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+	return key
+}
+
+func ParseAutoCompoundKey(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) {
+	offset := len(AutoCompoundKey)
+	delAddrLen := int(key[offset])
+	offset += 1
+	delAddr = key[offset : offset+delAddrLen]
+	offset += delAddrLen
+
+	valAddrLen := int(key[offset])
+	offset += 1
+	valAddr = key[offset : offset+valAddrLen]
+	offset += valAddrLen
+
+	denomLen := int(key[offset])
+	offset += 1
+	denom = string(key[offset : offset+denomLen-1])
+	return
+}
+
 func GetRedelegationsKeyByDelegator(delAddr sdk.AccAddress) []byte {
 	return append(RedelegationKey, address.MustLengthPrefix(delAddr)...)
 }
@@ -263,6 +358,73 @@ func ParseRewardWeightChangeSnapshotKey(key []byte) (denom string, val sdk.ValAd
 	return
 }
 
+// GetDelegationClaimHeightIndexKey key is in the format of denom|validator|last_reward_claim_height|delegator
+func GetDelegationClaimHeightIndexKey(denom string, valAddr sdk.ValAddress, height uint64, delAddr sdk.AccAddress) []byte {
+	key := append(GetDelegationClaimHeightIndexPrefix(denom, valAddr), sdk.Uint64ToBigEndian(height)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+func GetDelegationClaimHeightIndexPrefix(denom string, valAddr sdk.ValAddress) []byte {
+	key := append(DelegationClaimHeightIndexKey, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+func ParseDelegationClaimHeightIndexKey(key []byte) (denom string, valAddr sdk.ValAddress, height uint64, delAddr sdk.AccAddress) {
+	offset := len(DelegationClaimHeightIndexKey)
+	denomLen := int(key[offset])
+	offset += 1
+	denom = string(key[offset : offset+denomLen-1])
+	offset += denomLen
+
+	valLen := int(key[offset])
+	offset += 1
+	valAddr = key[offset : offset+valLen]
+	offset += valLen
+
+	height = sdk.BigEndianToUint64(key[offset : offset+8])
+	offset += 8
+
+	delLen := int(key[offset])
+	offset += 1
+	delAddr = key[offset : offset+delLen]
+	return
+}
+
+// GetRewardIndexSampleKey key is in the format of validator|slot
+func GetRewardIndexSampleKey(valAddr sdk.ValAddress, slot uint64) []byte {
+	return append(GetRewardIndexSamplesKey(valAddr), sdk.Uint64ToBigEndian(slot)...)
+}
+
+func GetRewardIndexSamplesKey(valAddr sdk.ValAddress) []byte {
+	return append(RewardIndexSampleKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func ParseRewardIndexSampleKey(key []byte) (valAddr sdk.ValAddress, slot uint64) {
+	offset := len(RewardIndexSampleKey)
+	valLen := int(key[offset])
+	offset += 1
+	valAddr = key[offset : offset+valLen]
+	offset += valLen
+	slot = sdk.BigEndianToUint64(key[offset:])
+	return
+}
+
+func GetRewardIndexSampleHeadKey(valAddr sdk.ValAddress) []byte {
+	return append(RewardIndexSampleHeadKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func GetFuryaPriceKey(denom string) []byte {
+	return append(FuryaPriceKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func GetFuryaIncentiveKey(id uint64) []byte {
+	return append(FuryaIncentiveKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetFinishedFuryaIncentiveKey(id uint64) []byte {
+	return append(FinishedFuryaIncentiveKey, sdk.Uint64ToBigEndian(id)...)
+}
+
 func GetRewardWeightDecayQueueByTimestampKey(triggerTime time.Time) (key []byte) {
 	key = append(RewardWeightDecayQueueKey, address.MustLengthPrefix(sdk.FormatTimeBytes(triggerTime))...)
 	return
@@ -285,3 +447,30 @@ func ParseRewardWeightDecayQueueKeyForDenom(key []byte) (triggerTime time.Time,
 	offset += 1
 	return triggerTime, string(key[offset : offset+denomLen-1])
 }
+
+func GetValidatorPreferencesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorPreferencesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func GetValidatorCommissionKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorCommissionKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetForceUndelegationQueueKey key is in the format of validator|denom
+func GetForceUndelegationQueueKey(valAddr sdk.ValAddress, denom string) []byte {
+	key := append(ForceUndelegationQueueKey, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+func ParseForceUndelegationQueueKey(key []byte) (valAddr sdk.ValAddress, denom string) {
+	offset := len(ForceUndelegationQueueKey)
+	valAddrLen := int(key[offset])
+	offset += 1
+	valAddr = key[offset : offset+valAddrLen]
+	offset += valAddrLen
+
+	denomLen := int(key[offset])
+	offset += 1
+	denom = string(key[offset : offset+denomLen-1])
+	return
+}
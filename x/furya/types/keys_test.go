@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/furya-official/furya/x/furya/types"
@@ -87,4 +89,27 @@ func TestValidatorKey(t *testing.T) {
 
 	parseValAddr := types.ParseFuryaValidatorKey(key)
 	require.Equal(t, parseValAddr, valAddr)
-}
\ No newline at end of file
+}
+
+func TestForceUndelegationQueueKey(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromHex("bb")
+	require.NoError(t, err)
+	key := types.GetForceUndelegationQueueKey(valAddr, "denom")
+	parsedValAddr, parsedDenom := types.ParseForceUndelegationQueueKey(key)
+	require.Equal(t, valAddr, parsedValAddr)
+	require.Equal(t, "denom", parsedDenom)
+}
+
+func TestDelegationClaimHeightIndexKey(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromHex("bb")
+	require.NoError(t, err)
+	delAddr, err := sdk.AccAddressFromHexUnsafe("aa")
+	require.NoError(t, err)
+	key := types.GetDelegationClaimHeightIndexKey("denom", valAddr, 10, delAddr)
+	require.True(t, bytes.HasPrefix(key, types.GetDelegationClaimHeightIndexPrefix("denom", valAddr)))
+	parsedDenom, parsedValAddr, parsedHeight, parsedDelAddr := types.ParseDelegationClaimHeightIndexKey(key)
+	require.Equal(t, "denom", parsedDenom)
+	require.Equal(t, valAddr, parsedValAddr)
+	require.Equal(t, uint64(10), parsedHeight)
+	require.Equal(t, delAddr, parsedDelAddr)
+}
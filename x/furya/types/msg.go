@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -11,15 +12,51 @@ var (
 	_ sdk.Msg = &MsgRedelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgClaimDelegationRewards{}
+	_ sdk.Msg = &MsgClaimAllDelegationRewards{}
+	_ sdk.Msg = &MsgCancelUndelegation{}
+	_ sdk.Msg = &MsgSetFuryaWithdrawAddress{}
+	_ sdk.Msg = &MsgSetAutoCompound{}
+	_ sdk.Msg = &MsgMultiDelegate{}
+	_ sdk.Msg = &MsgMultiUndelegate{}
+	_ sdk.Msg = &MsgTransferDelegation{}
+	_ sdk.Msg = &MsgTokenizeFuryaDelegation{}
+	_ sdk.Msg = &MsgRedeemFuryaTokens{}
+	_ sdk.Msg = &MsgClaimFuryaTokenRewards{}
+	_ sdk.Msg = &MsgSetValidatorFuryaPreferences{}
+	_ sdk.Msg = &MsgSetValidatorFuryaCommission{}
+	_ sdk.Msg = &MsgWithdrawValidatorFuryaCommission{}
+	_ sdk.Msg = &MsgSettleFuryaRewards{}
+	_ sdk.Msg = &MsgPostFuryaPrices{}
+	_ sdk.Msg = &MsgCreateFuryaIncentive{}
 )
 
 var (
-	MsgDelegateType               = "msg_delegate"
-	MsgUndelegateType             = "msg_undelegate"
-	MsgRedelegateType             = "msg_redelegate"
-	MsgClaimDelegationRewardsType = "claim_delegation_rewards"
+	MsgDelegateType                  = "msg_delegate"
+	MsgUndelegateType                = "msg_undelegate"
+	MsgRedelegateType                = "msg_redelegate"
+	MsgClaimDelegationRewardsType    = "claim_delegation_rewards"
+	MsgClaimAllDelegationRewardsType = "claim_all_delegation_rewards"
+	MsgCancelUndelegationType        = "msg_cancel_undelegation"
+	MsgSetFuryaWithdrawAddressType   = "msg_set_furya_withdraw_address"
+	MsgSetAutoCompoundType           = "msg_set_auto_compound"
+	MsgMultiDelegateType             = "msg_multi_delegate"
+	MsgMultiUndelegateType           = "msg_multi_undelegate"
+	MsgTransferDelegationType        = "msg_transfer_delegation"
+	MsgTokenizeFuryaDelegationType   = "msg_tokenize_furya_delegation"
+	MsgRedeemFuryaTokensType         = "msg_redeem_furya_tokens"
+	MsgClaimFuryaTokenRewardsType    = "msg_claim_furya_token_rewards"
+
+	MsgSetValidatorFuryaPreferencesType     = "msg_set_validator_furya_preferences"
+	MsgSetValidatorFuryaCommissionType      = "msg_set_validator_furya_commission"
+	MsgWithdrawValidatorFuryaCommissionType = "msg_withdraw_validator_furya_commission"
+	MsgSettleFuryaRewardsType               = "msg_settle_furya_rewards"
+	MsgPostFuryaPricesType                  = "msg_post_furya_prices"
+	MsgCreateFuryaIncentiveType             = "msg_create_furya_incentive"
 )
 
+// MaxRewardSettlementBatchSize is the maximum number of delegations visited by a single MsgSettleFuryaRewards
+const MaxRewardSettlementBatchSize = 100
+
 func (m MsgDelegate) ValidateBasic() error {
 	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
 		return status.Errorf(codes.InvalidArgument, "Furya delegation amount must be more than zero")
@@ -87,3 +124,344 @@ func (m *MsgClaimDelegationRewards) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgClaimDelegationRewards) Type() string { return MsgClaimDelegationRewardsType }
+
+func (m *MsgClaimAllDelegationRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya delegator address is invalid: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgClaimAllDelegationRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgClaimAllDelegationRewards is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgClaimAllDelegationRewards) Type() string { return MsgClaimAllDelegationRewardsType }
+
+func (m MsgCancelUndelegation) ValidateBasic() error {
+	if m.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Furya cancel undelegation amount must be more than zero")
+	}
+	if m.CompletionTime.IsZero() {
+		return status.Errorf(codes.InvalidArgument, "Furya cancel undelegation completion time must have a value")
+	}
+	return nil
+}
+
+func (m MsgCancelUndelegation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgCancelUndelegation is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgCancelUndelegation) Type() string { return MsgCancelUndelegationType }
+
+func (m MsgSetFuryaWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya withdraw address is invalid: %s", err)
+	}
+	return nil
+}
+
+func (m MsgSetFuryaWithdrawAddress) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgSetFuryaWithdrawAddress is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSetFuryaWithdrawAddress) Type() string { return MsgSetFuryaWithdrawAddressType }
+
+func (m MsgSetAutoCompound) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya auto-compound denom is invalid: %s", err)
+	}
+	return nil
+}
+
+func (m MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgSetAutoCompound is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSetAutoCompound) Type() string { return MsgSetAutoCompoundType }
+
+func (m MsgMultiDelegate) ValidateBasic() error {
+	if len(m.Delegations) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Furya multi delegation must have at least one entry")
+	}
+	for _, entry := range m.Delegations {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MsgMultiDelegate) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgMultiDelegate is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgMultiDelegate) Type() string { return MsgMultiDelegateType }
+
+func (m MsgMultiUndelegate) ValidateBasic() error {
+	if len(m.Undelegations) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Furya multi undelegation must have at least one entry")
+	}
+	for _, entry := range m.Undelegations {
+		if err := entry.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MsgMultiUndelegate) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgMultiUndelegate is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgMultiUndelegate) Type() string { return MsgMultiUndelegateType }
+
+func (m MsgTransferDelegation) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Furya transfer delegation amount must be more than zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.RecipientAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya recipient address is invalid: %s", err)
+	}
+	if m.RecipientAddress == m.DelegatorAddress {
+		return status.Errorf(codes.InvalidArgument, "Furya delegation cannot be transferred to the delegator")
+	}
+	return nil
+}
+
+func (m MsgTransferDelegation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgTransferDelegation is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgTransferDelegation) Type() string { return MsgTransferDelegationType }
+
+func (m MsgTokenizeFuryaDelegation) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Furya tokenize amount must be more than zero")
+	}
+	return nil
+}
+
+func (m MsgTokenizeFuryaDelegation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgTokenizeFuryaDelegation is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgTokenizeFuryaDelegation) Type() string { return MsgTokenizeFuryaDelegationType }
+
+func (m MsgRedeemFuryaTokens) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Furya redeem amount must be more than zero")
+	}
+	if !IsTokenizedDenom(m.Amount.Denom) {
+		return status.Errorf(codes.InvalidArgument, "Denom %s is not a tokenized furya delegation", m.Amount.Denom)
+	}
+	return nil
+}
+
+func (m MsgRedeemFuryaTokens) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgRedeemFuryaTokens is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgRedeemFuryaTokens) Type() string { return MsgRedeemFuryaTokensType }
+
+func (m MsgClaimFuryaTokenRewards) ValidateBasic() error {
+	if !IsTokenizedDenom(m.TokenDenom) {
+		return status.Errorf(codes.InvalidArgument, "Denom %s is not a tokenized furya delegation", m.TokenDenom)
+	}
+	return nil
+}
+
+func (m MsgClaimFuryaTokenRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.HolderAddress)
+	if err != nil {
+		panic("HolderAddress signer from MsgClaimFuryaTokenRewards is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgClaimFuryaTokenRewards) Type() string { return MsgClaimFuryaTokenRewardsType }
+
+func (m MsgSetValidatorFuryaPreferences) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya validator address is invalid: %s", err)
+	}
+	seen := make(map[string]bool)
+	for _, denoms := range [][]string{m.AllowedDenoms, m.BlockedDenoms} {
+		for _, denom := range denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return status.Errorf(codes.InvalidArgument, "Furya denom is invalid: %s", err)
+			}
+			if seen[denom] {
+				return status.Errorf(codes.InvalidArgument, "Furya denom %s is listed more than once", denom)
+			}
+			seen[denom] = true
+		}
+	}
+	return nil
+}
+
+func (m MsgSetValidatorFuryaPreferences) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgSetValidatorFuryaPreferences is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgSetValidatorFuryaPreferences) Type() string { return MsgSetValidatorFuryaPreferencesType }
+
+func (m MsgSetValidatorFuryaCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya validator address is invalid: %s", err)
+	}
+	if m.Rate.IsNil() || m.MaxRate.IsNil() || m.MaxChangeRate.IsNil() {
+		return status.Errorf(codes.InvalidArgument, "Furya commission rates must be set")
+	}
+	if err := stakingtypes.NewCommissionRates(m.Rate, m.MaxRate, m.MaxChangeRate).Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya commission rates are invalid: %s", err)
+	}
+	return nil
+}
+
+func (m MsgSetValidatorFuryaCommission) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgSetValidatorFuryaCommission is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgSetValidatorFuryaCommission) Type() string { return MsgSetValidatorFuryaCommissionType }
+
+func (m MsgWithdrawValidatorFuryaCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya validator address is invalid: %s", err)
+	}
+	return nil
+}
+
+func (m MsgWithdrawValidatorFuryaCommission) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgWithdrawValidatorFuryaCommission is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgWithdrawValidatorFuryaCommission) Type() string {
+	return MsgWithdrawValidatorFuryaCommissionType
+}
+
+func (m MsgSettleFuryaRewards) ValidateBasic() error {
+	if m.Limit == 0 || m.Limit > MaxRewardSettlementBatchSize {
+		return status.Errorf(codes.InvalidArgument, "Furya reward settlement limit must be between 1 and %d", MaxRewardSettlementBatchSize)
+	}
+	return nil
+}
+
+func (m MsgSettleFuryaRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.SettlerAddress)
+	if err != nil {
+		panic("SettlerAddress signer from MsgSettleFuryaRewards is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSettleFuryaRewards) Type() string { return MsgSettleFuryaRewardsType }
+
+func (m MsgPostFuryaPrices) ValidateBasic() error {
+	if len(m.Prices) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Furya prices must not be empty")
+	}
+	if err := m.Prices.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya prices are invalid: %s", err)
+	}
+	return nil
+}
+
+func (m MsgPostFuryaPrices) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.FeederAddress)
+	if err != nil {
+		panic("FeederAddress signer from MsgPostFuryaPrices is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgPostFuryaPrices) Type() string { return MsgPostFuryaPricesType }
+
+func (m MsgCreateFuryaIncentive) ValidateBasic() error {
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Furya incentive denom must have a value")
+	}
+	if m.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Furya validator address is invalid: %s", err)
+		}
+	}
+	if m.Rewards.Empty() {
+		return status.Errorf(codes.InvalidArgument, "Furya incentive rewards must not be empty")
+	}
+	if err := m.Rewards.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya incentive rewards are invalid: %s", err)
+	}
+	if !m.EndTime.After(m.StartTime) {
+		return status.Errorf(codes.InvalidArgument, "Furya incentive end time must be after its start time")
+	}
+	return nil
+}
+
+func (m MsgCreateFuryaIncentive) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.FunderAddress)
+	if err != nil {
+		panic("FunderAddress signer from MsgCreateFuryaIncentive is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgCreateFuryaIncentive) Type() string { return MsgCreateFuryaIncentiveType }
+
+func (e MultiDelegationEntry) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(e.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Furya validator address is invalid: %s", err)
+	}
+	if !e.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Furya delegation amount must be more than zero")
+	}
+	return nil
+}
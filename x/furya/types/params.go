@@ -6,6 +6,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,6 +14,29 @@ var (
 	RewardDelayTime       = []byte("RewardDelayTime")
 	TakeRateClaimInterval = []byte("TakeRateClaimInterval")
 	LastTakeRateClaimTime = []byte("LastTakeRateClaimTime")
+	AutoCompoundInterval  = []byte("AutoCompoundInterval")
+	LastAutoCompoundTime  = []byte("LastAutoCompoundTime")
+
+	MaxValidatorFuryaPowerShare = []byte("MaxValidatorFuryaPowerShare")
+	MaxFuryaPowerShare          = []byte("MaxFuryaPowerShare")
+
+	RewardIndexSampleInterval = []byte("RewardIndexSampleInterval")
+	RewardIndexSampleSize     = []byte("RewardIndexSampleSize")
+
+	PriceFeeders = []byte("PriceFeeders")
+	MaxPriceAge  = []byte("MaxPriceAge")
+
+	IncentiveCreationFee     = []byte("IncentiveCreationFee")
+	MaxActiveIncentives      = []byte("MaxActiveIncentives")
+	MaxIncentiveRewardDenoms = []byte("MaxIncentiveRewardDenoms")
+
+	TakeRateDestinations    = []byte("TakeRateDestinations")
+	SweepUnallocatedRewards = []byte("SweepUnallocatedRewards")
+
+	RewardDustSweepInterval = []byte("RewardDustSweepInterval")
+	LastRewardDustSweepTime = []byte("LastRewardDustSweepTime")
+
+	SlashDestinationKey = []byte("SlashDestination")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -22,6 +46,22 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(RewardDelayTime, &p.RewardDelayTime, validatePositiveDuration),
 		paramtypes.NewParamSetPair(TakeRateClaimInterval, &p.TakeRateClaimInterval, validatePositiveDuration),
 		paramtypes.NewParamSetPair(LastTakeRateClaimTime, &p.LastTakeRateClaimTime, validateTime),
+		paramtypes.NewParamSetPair(AutoCompoundInterval, &p.AutoCompoundInterval, validatePositiveDuration),
+		paramtypes.NewParamSetPair(LastAutoCompoundTime, &p.LastAutoCompoundTime, validateTime),
+		paramtypes.NewParamSetPair(MaxValidatorFuryaPowerShare, &p.MaxValidatorFuryaPowerShare, validatePowerShare),
+		paramtypes.NewParamSetPair(MaxFuryaPowerShare, &p.MaxFuryaPowerShare, validatePowerShare),
+		paramtypes.NewParamSetPair(RewardIndexSampleInterval, &p.RewardIndexSampleInterval, validatePositiveDuration),
+		paramtypes.NewParamSetPair(RewardIndexSampleSize, &p.RewardIndexSampleSize, validateSampleSize),
+		paramtypes.NewParamSetPair(PriceFeeders, &p.PriceFeeders, validatePriceFeeders),
+		paramtypes.NewParamSetPair(MaxPriceAge, &p.MaxPriceAge, validatePositiveDuration),
+		paramtypes.NewParamSetPair(IncentiveCreationFee, &p.IncentiveCreationFee, validateCoins),
+		paramtypes.NewParamSetPair(MaxActiveIncentives, &p.MaxActiveIncentives, validateIncentiveLimit),
+		paramtypes.NewParamSetPair(MaxIncentiveRewardDenoms, &p.MaxIncentiveRewardDenoms, validateIncentiveLimit),
+		paramtypes.NewParamSetPair(TakeRateDestinations, &p.TakeRateDestinations, validateTakeRateDestinations),
+		paramtypes.NewParamSetPair(SweepUnallocatedRewards, &p.SweepUnallocatedRewards, validateBool),
+		paramtypes.NewParamSetPair(RewardDustSweepInterval, &p.RewardDustSweepInterval, validatePositiveDuration),
+		paramtypes.NewParamSetPair(LastRewardDustSweepTime, &p.LastRewardDustSweepTime, validateTime),
+		paramtypes.NewParamSetPair(SlashDestinationKey, &p.SlashDestination, validateSlashDestination),
 	}
 }
 
@@ -44,12 +84,163 @@ func validateTime(i interface{}) error {
 	return nil
 }
 
+func validatePowerShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// Unset values are stored as zero which disables the limit
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("power share must be more or equals to 0 but strictly less than 1: %s", v)
+	}
+	return nil
+}
+
+func validateSampleSize(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// validateIncentiveLimit accepts any limit, a limit of zero stops the creation of incentives
+func validateIncentiveLimit(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePriceFeeders(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, feeder := range v {
+		if _, err := sdk.AccAddressFromBech32(feeder); err != nil {
+			return fmt.Errorf("invalid price feeder address %s: %s", feeder, err)
+		}
+	}
+	return nil
+}
+
+func validateTakeRateDestinations(i interface{}) error {
+	v, ok := i.([]TakeRateDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateTakeRateDestinations(v)
+}
+
+// ValidateTakeRateDestinations checks that every destination is valid and that their weights add up to 1
+func ValidateTakeRateDestinations(destinations []TakeRateDestination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+	totalWeight := sdk.ZeroDec()
+	for _, destination := range destinations {
+		if err := destination.Validate(); err != nil {
+			return err
+		}
+		totalWeight = totalWeight.Add(destination.Weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("take rate destination weights must add up to 1: %s", totalWeight)
+	}
+	return nil
+}
+
+// Validate checks that the destination has a positive weight and a target only if its type requires one
+func (d TakeRateDestination) Validate() error {
+	if d.Weight.IsNil() || !d.Weight.IsPositive() {
+		return fmt.Errorf("take rate destination weight must be positive: %s", d.Weight)
+	}
+	switch d.Type {
+	case TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR,
+		TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL,
+		TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_BURN:
+		if d.Target != "" {
+			return fmt.Errorf("take rate destination %s does not have a target", d.Type)
+		}
+	case TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT:
+		if d.Target == "" {
+			return fmt.Errorf("take rate destination %s requires a module name", d.Type)
+		}
+	case TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS:
+		if _, err := sdk.AccAddressFromBech32(d.Target); err != nil {
+			return fmt.Errorf("invalid take rate destination address %s: %s", d.Target, err)
+		}
+	default:
+		return fmt.Errorf("invalid take rate destination type: %s", d.Type)
+	}
+	return nil
+}
+
+func validateSlashDestination(i interface{}) error {
+	v, ok := i.(SlashDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, found := SlashDestination_name[int32(v)]; !found {
+		return fmt.Errorf("invalid slash destination: %d", v)
+	}
+	return nil
+}
+
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
 		RewardDelayTime:       time.Hour,
 		TakeRateClaimInterval: time.Minute * 5,
 		LastTakeRateClaimTime: time.Now(),
+		AutoCompoundInterval:  time.Hour * 24,
+		LastAutoCompoundTime:  time.Time{},
+
+		MaxValidatorFuryaPowerShare: sdk.ZeroDec(),
+		MaxFuryaPowerShare:          sdk.ZeroDec(),
+
+		RewardIndexSampleInterval: time.Hour,
+		RewardIndexSampleSize:     24 * 7,
+
+		PriceFeeders: []string{},
+		MaxPriceAge:  time.Hour,
+
+		IncentiveCreationFee:     sdk.Coins{},
+		MaxActiveIncentives:      100,
+		MaxIncentiveRewardDenoms: 3,
+
+		TakeRateDestinations: []TakeRateDestination{
+			{
+				Type:   TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR,
+				Weight: sdk.OneDec(),
+			},
+		},
+
+		RewardDustSweepInterval: 0,
+		LastRewardDustSweepTime: time.Time{},
+
+		SlashDestination: SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR,
 	}
 }
 
@@ -74,3 +265,17 @@ func (r RewardHistories) GetIndexByDenom(denom string) (ri *RewardHistory, found
 		return &r[idx], true
 	}
 }
+
+// Equal returns true if both reward histories have the same index for every denom
+func (r RewardHistories) Equal(other RewardHistories) bool {
+	if len(r) != len(other) {
+		return false
+	}
+	for _, history := range r {
+		otherHistory, found := other.GetIndexByDenom(history.Denom)
+		if !found || !otherHistory.Index.Equal(history.Index) {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -30,19 +31,133 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TakeRateDestinationType int32
+
+const (
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_UNSPECIFIED TakeRateDestinationType = 0
+	// TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR redistributes the tokens to stakers
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR TakeRateDestinationType = 1
+	// TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL funds the community pool
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL TakeRateDestinationType = 2
+	// TAKE_RATE_DESTINATION_TYPE_BURN burns the tokens
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_BURN TakeRateDestinationType = 3
+	// TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT sends the tokens to the module account named by the target
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT TakeRateDestinationType = 4
+	// TAKE_RATE_DESTINATION_TYPE_ADDRESS sends the tokens to the address of the target
+	TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_ADDRESS TakeRateDestinationType = 5
+)
+
+var TakeRateDestinationType_name = map[int32]string{
+	0: "TAKE_RATE_DESTINATION_TYPE_UNSPECIFIED",
+	1: "TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR",
+	2: "TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL",
+	3: "TAKE_RATE_DESTINATION_TYPE_BURN",
+	4: "TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT",
+	5: "TAKE_RATE_DESTINATION_TYPE_ADDRESS",
+}
+
+var TakeRateDestinationType_value = map[string]int32{
+	"TAKE_RATE_DESTINATION_TYPE_UNSPECIFIED":    0,
+	"TAKE_RATE_DESTINATION_TYPE_FEE_COLLECTOR":  1,
+	"TAKE_RATE_DESTINATION_TYPE_COMMUNITY_POOL": 2,
+	"TAKE_RATE_DESTINATION_TYPE_BURN":           3,
+	"TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT": 4,
+	"TAKE_RATE_DESTINATION_TYPE_ADDRESS":        5,
+}
+
+func (x TakeRateDestinationType) String() string {
+	return proto.EnumName(TakeRateDestinationType_name, int32(x))
+}
+
+func (TakeRateDestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e816f2f20f762f6a, []int{0}
+}
+
+type SlashDestination int32
+
+const (
+	SlashDestination_SLASH_DESTINATION_UNSPECIFIED SlashDestination = 0
+	// SLASH_DESTINATION_FEE_COLLECTOR redistributes the tokens to stakers
+	SlashDestination_SLASH_DESTINATION_FEE_COLLECTOR SlashDestination = 1
+	// SLASH_DESTINATION_COMMUNITY_POOL funds the community pool
+	SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL SlashDestination = 2
+	// SLASH_DESTINATION_BURN burns the tokens
+	SlashDestination_SLASH_DESTINATION_BURN SlashDestination = 3
+)
+
+var SlashDestination_name = map[int32]string{
+	0: "SLASH_DESTINATION_UNSPECIFIED",
+	1: "SLASH_DESTINATION_FEE_COLLECTOR",
+	2: "SLASH_DESTINATION_COMMUNITY_POOL",
+	3: "SLASH_DESTINATION_BURN",
+}
+
+var SlashDestination_value = map[string]int32{
+	"SLASH_DESTINATION_UNSPECIFIED":    0,
+	"SLASH_DESTINATION_FEE_COLLECTOR":  1,
+	"SLASH_DESTINATION_COMMUNITY_POOL": 2,
+	"SLASH_DESTINATION_BURN":           3,
+}
+
+func (x SlashDestination) String() string {
+	return proto.EnumName(SlashDestination_name, int32(x))
+}
+
+func (SlashDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e816f2f20f762f6a, []int{1}
+}
+
 type Params struct {
 	RewardDelayTime time.Duration `protobuf:"bytes,1,opt,name=reward_delay_time,json=rewardDelayTime,proto3,stdduration" json:"reward_delay_time"`
 	// Time interval between consecutive applications of `take_rate`
 	TakeRateClaimInterval time.Duration `protobuf:"bytes,2,opt,name=take_rate_claim_interval,json=takeRateClaimInterval,proto3,stdduration" json:"take_rate_claim_interval"`
 	// Last application of `take_rate` on assets
 	LastTakeRateClaimTime time.Time `protobuf:"bytes,3,opt,name=last_take_rate_claim_time,json=lastTakeRateClaimTime,proto3,stdtime" json:"last_take_rate_claim_time"`
+	// Time interval between consecutive sweeps that compound rewards of delegations with auto-compounding enabled.
+	// A zero interval disables the sweep.
+	AutoCompoundInterval time.Duration `protobuf:"bytes,4,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3,stdduration" json:"auto_compound_interval"`
+	// Last sweep of auto-compounding delegations
+	LastAutoCompoundTime time.Time `protobuf:"bytes,5,opt,name=last_auto_compound_time,json=lastAutoCompoundTime,proto3,stdtime" json:"last_auto_compound_time"`
+	// Maximum share of a validator's total power that can come from furya delegations.
+	// A zero value disables the limit.
+	MaxValidatorFuryaPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_validator_furya_power_share,json=maxValidatorFuryaPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_furya_power_share"`
+	// Maximum share of the total bonded power that can come from furya delegations.
+	// A zero value disables the limit.
+	MaxFuryaPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_furya_power_share,json=maxFuryaPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_furya_power_share"`
+	// Minimum time between two samples of the reward indices of a validator that are used to estimate the furya APR
+	RewardIndexSampleInterval time.Duration `protobuf:"bytes,8,opt,name=reward_index_sample_interval,json=rewardIndexSampleInterval,proto3,stdduration" json:"reward_index_sample_interval"`
+	// Number of reward index samples kept per validator. A zero value disables sampling.
+	RewardIndexSampleSize uint32 `protobuf:"varint,9,opt,name=reward_index_sample_size,json=rewardIndexSampleSize,proto3" json:"reward_index_sample_size,omitempty"`
+	// Addresses that are allowed to post prices used by price pegged reward weights
+	PriceFeeders []string `protobuf:"bytes,10,rep,name=price_feeders,json=priceFeeders,proto3" json:"price_feeders,omitempty"`
+	// Prices older than this are not used to peg reward weights. A zero value means prices do not expire.
+	MaxPriceAge time.Duration `protobuf:"bytes,11,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age"`
+	// Fee paid to the community pool for creating an incentive program. Empty means creating incentives is free
+	IncentiveCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=incentive_creation_fee,json=incentiveCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"incentive_creation_fee"`
+	// Maximum number of incentive programs that can be active at the same time. A zero value disables incentives.
+	MaxActiveIncentives uint32 `protobuf:"varint,13,opt,name=max_active_incentives,json=maxActiveIncentives,proto3" json:"max_active_incentives,omitempty"`
+	// Maximum number of reward denoms of an incentive program. A zero value disables incentives.
+	MaxIncentiveRewardDenoms uint32 `protobuf:"varint,14,opt,name=max_incentive_reward_denoms,json=maxIncentiveRewardDenoms,proto3" json:"max_incentive_reward_denoms,omitempty"`
+	// Destinations of the tokens deducted by `take_rate` and the share each of them receives.
+	// Weights must add up to 1. An empty list sends everything to the fee collector.
+	TakeRateDestinations []TakeRateDestination `protobuf:"bytes,15,rep,name=take_rate_destinations,json=takeRateDestinations,proto3" json:"take_rate_destinations"`
+	// Send rewards withdrawn while a validator has no furya delegations to the community pool
+	// instead of keeping them for the next delegators of the validator
+	SweepUnallocatedRewards bool `protobuf:"varint,16,opt,name=sweep_unallocated_rewards,json=sweepUnallocatedRewards,proto3" json:"sweep_unallocated_rewards,omitempty"`
+	// Time interval between consecutive sweeps of reward truncation dust to the community pool.
+	// A zero interval disables the sweep.
+	RewardDustSweepInterval time.Duration `protobuf:"bytes,17,opt,name=reward_dust_sweep_interval,json=rewardDustSweepInterval,proto3,stdduration" json:"reward_dust_sweep_interval"`
+	// Last sweep of reward truncation dust
+	LastRewardDustSweepTime time.Time `protobuf:"bytes,18,opt,name=last_reward_dust_sweep_time,json=lastRewardDustSweepTime,proto3,stdtime" json:"last_reward_dust_sweep_time"`
+	// Where tokens slashed from furya delegations are sent. Unspecified sends them to the fee collector
+	SlashDestination SlashDestination `protobuf:"varint,19,opt,name=slash_destination,json=slashDestination,proto3,enum=furya.furya.SlashDestination" json:"slash_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3dc4a5b6d277cc53, []int{0}
+	return fileDescriptor_e816f2f20f762f6a, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,6 +207,159 @@ func (m *Params) GetLastTakeRateClaimTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Params) GetAutoCompoundInterval() time.Duration {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetLastAutoCompoundTime() time.Time {
+	if m != nil {
+		return m.LastAutoCompoundTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetRewardIndexSampleInterval() time.Duration {
+	if m != nil {
+		return m.RewardIndexSampleInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardIndexSampleSize() uint32 {
+	if m != nil {
+		return m.RewardIndexSampleSize
+	}
+	return 0
+}
+
+func (m *Params) GetPriceFeeders() []string {
+	if m != nil {
+		return m.PriceFeeders
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetIncentiveCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.IncentiveCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveIncentives() uint32 {
+	if m != nil {
+		return m.MaxActiveIncentives
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIncentiveRewardDenoms() uint32 {
+	if m != nil {
+		return m.MaxIncentiveRewardDenoms
+	}
+	return 0
+}
+
+func (m *Params) GetTakeRateDestinations() []TakeRateDestination {
+	if m != nil {
+		return m.TakeRateDestinations
+	}
+	return nil
+}
+
+func (m *Params) GetSweepUnallocatedRewards() bool {
+	if m != nil {
+		return m.SweepUnallocatedRewards
+	}
+	return false
+}
+
+func (m *Params) GetRewardDustSweepInterval() time.Duration {
+	if m != nil {
+		return m.RewardDustSweepInterval
+	}
+	return 0
+}
+
+func (m *Params) GetLastRewardDustSweepTime() time.Time {
+	if m != nil {
+		return m.LastRewardDustSweepTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetSlashDestination() SlashDestination {
+	if m != nil {
+		return m.SlashDestination
+	}
+	return SlashDestination_SLASH_DESTINATION_UNSPECIFIED
+}
+
+type TakeRateDestination struct {
+	Type TakeRateDestinationType `protobuf:"varint,1,opt,name=type,proto3,enum=furya.furya.TakeRateDestinationType" json:"type,omitempty"`
+	// Name of the module account or bech32 address receiving the tokens.
+	// Only set for TAKE_RATE_DESTINATION_TYPE_MODULE_ACCOUNT and TAKE_RATE_DESTINATION_TYPE_ADDRESS.
+	Target string                                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *TakeRateDestination) Reset()         { *m = TakeRateDestination{} }
+func (m *TakeRateDestination) String() string { return proto.CompactTextString(m) }
+func (*TakeRateDestination) ProtoMessage()    {}
+func (*TakeRateDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e816f2f20f762f6a, []int{1}
+}
+func (m *TakeRateDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakeRateDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakeRateDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakeRateDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeRateDestination.Merge(m, src)
+}
+func (m *TakeRateDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakeRateDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeRateDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakeRateDestination proto.InternalMessageInfo
+
+func (m *TakeRateDestination) GetType() TakeRateDestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return TakeRateDestinationType_TAKE_RATE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (m *TakeRateDestination) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 type RewardHistory struct {
 	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Index github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index"`
@@ -101,7 +369,7 @@ func (m *RewardHistory) Reset()         { *m = RewardHistory{} }
 func (m *RewardHistory) String() string { return proto.CompactTextString(m) }
 func (*RewardHistory) ProtoMessage()    {}
 func (*RewardHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3dc4a5b6d277cc53, []int{1}
+	return fileDescriptor_e816f2f20f762f6a, []int{2}
 }
 func (m *RewardHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,40 +406,89 @@ func (m *RewardHistory) GetDenom() string {
 }
 
 func init() {
+	proto.RegisterEnum("furya.furya.TakeRateDestinationType", TakeRateDestinationType_name, TakeRateDestinationType_value)
+	proto.RegisterEnum("furya.furya.SlashDestination", SlashDestination_name, SlashDestination_value)
 	proto.RegisterType((*Params)(nil), "furya.furya.Params")
+	proto.RegisterType((*TakeRateDestination)(nil), "furya.furya.TakeRateDestination")
 	proto.RegisterType((*RewardHistory)(nil), "furya.furya.RewardHistory")
 }
 
-func init() { proto.RegisterFile("furya/params.proto", fileDescriptor_3dc4a5b6d277cc53) }
-
-var fileDescriptor_3dc4a5b6d277cc53 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0xaf, 0xd3, 0x30,
-	0x10, 0xc7, 0x93, 0x42, 0x2b, 0x6a, 0x84, 0x50, 0xa3, 0x56, 0x6a, 0x3b, 0x24, 0xa8, 0x03, 0x62,
-	0x69, 0x82, 0x60, 0x43, 0x4c, 0x25, 0x03, 0xb0, 0x80, 0xa2, 0x4e, 0x08, 0x11, 0xb9, 0xc9, 0x11,
-	0xac, 0xc6, 0x71, 0x64, 0xbb, 0xd0, 0x4c, 0x7c, 0x85, 0x8e, 0x2c, 0x48, 0x7c, 0x08, 0x3e, 0x44,
-	0xc7, 0x8a, 0x09, 0x31, 0x14, 0xd4, 0x2e, 0x7c, 0x8c, 0x27, 0xdb, 0xc9, 0x7b, 0x55, 0xdf, 0xf2,
-	0xa6, 0xdc, 0xe5, 0x7f, 0xfe, 0xfd, 0xef, 0x7c, 0x46, 0x03, 0x9c, 0xe7, 0x04, 0x17, 0x09, 0x04,
-	0x25, 0xe6, 0x98, 0x0a, 0xbf, 0xe4, 0x4c, 0x32, 0xa7, 0xd7, 0xfc, 0xf6, 0x9b, 0x60, 0xdc, 0xcf,
-	0x58, 0xc6, 0xb4, 0x1a, 0xa8, 0xc8, 0x14, 0x8e, 0x47, 0x09, 0x13, 0x94, 0x89, 0xd8, 0x08, 0x26,
-	0xa9, 0x25, 0x37, 0x63, 0x2c, 0xcb, 0x21, 0xd0, 0xd9, 0x62, 0xf5, 0x31, 0x48, 0x57, 0x1c, 0x4b,
-	0xc2, 0x8a, 0x5a, 0xf7, 0xce, 0x75, 0x49, 0x28, 0x08, 0x89, 0x69, 0x69, 0x0a, 0x26, 0xdf, 0x5b,
-	0xa8, 0xf3, 0x56, 0x77, 0xe5, 0xbc, 0x41, 0x3d, 0x0e, 0x5f, 0x30, 0x4f, 0xe3, 0x14, 0x72, 0x5c,
-	0xc5, 0xaa, 0x74, 0x68, 0x3f, 0xb0, 0x1f, 0xdd, 0x7d, 0x32, 0xf2, 0x0d, 0xc7, 0x6f, 0x38, 0x7e,
-	0x58, 0xfb, 0xcc, 0xee, 0x6c, 0xf7, 0x9e, 0xf5, 0xed, 0xaf, 0x67, 0x47, 0xf7, 0xcd, 0xe9, 0x50,
-	0x1d, 0x9e, 0x13, 0x0a, 0xce, 0x7b, 0x34, 0x94, 0x78, 0x09, 0x31, 0xc7, 0x12, 0xe2, 0x24, 0xc7,
-	0x84, 0xc6, 0xa4, 0x90, 0xc0, 0x3f, 0xe3, 0x7c, 0xd8, 0xba, 0x39, 0x77, 0xa0, 0x20, 0x11, 0x96,
-	0xf0, 0x42, 0x21, 0x5e, 0xd5, 0x04, 0xe7, 0x03, 0x1a, 0xe5, 0x58, 0xc8, 0xf8, 0xdc, 0x42, 0xb7,
-	0x7d, 0x4b, 0xe3, 0xc7, 0xd7, 0xf0, 0xf3, 0x66, 0x7c, 0xc3, 0xdf, 0x68, 0xbe, 0xc2, 0xcc, 0x4f,
-	0x3d, 0x54, 0xd5, 0xb3, 0xdb, 0xff, 0x7f, 0x78, 0xf6, 0xe4, 0x2b, 0xba, 0x17, 0xe9, 0xb1, 0x5e,
-	0x12, 0x21, 0x19, 0xaf, 0x9c, 0x3e, 0x6a, 0xa7, 0x50, 0x30, 0xaa, 0x6f, 0xa6, 0x1b, 0x99, 0xc4,
-	0x89, 0x50, 0x9b, 0x14, 0x29, 0xac, 0xf5, 0x5c, 0xdd, 0xd9, 0x73, 0x05, 0xff, 0xb3, 0xf7, 0x1e,
-	0x66, 0x44, 0x7e, 0x5a, 0x2d, 0xfc, 0x84, 0xd1, 0x7a, 0x6f, 0xf5, 0x67, 0x2a, 0xd2, 0x65, 0x20,
-	0xab, 0x12, 0x84, 0x1f, 0x42, 0xf2, 0xeb, 0xe7, 0x14, 0xd5, 0x6b, 0x0d, 0x21, 0x89, 0x0c, 0xca,
-	0x34, 0x30, 0x7b, 0xbd, 0x3d, 0xb8, 0xf6, 0xee, 0xe0, 0xda, 0xff, 0x0e, 0xae, 0xbd, 0x39, 0xba,
-	0xd6, 0xee, 0xe8, 0x5a, 0xbf, 0x8f, 0xae, 0xf5, 0xee, 0xf1, 0x09, 0x5c, 0x02, 0xe7, 0x78, 0x4a,
-	0x59, 0x01, 0x55, 0x70, 0xf9, 0xda, 0xd6, 0x57, 0xa1, 0xb6, 0x5a, 0x74, 0xf4, 0x3d, 0x3c, 0xbd,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xdc, 0xef, 0x87, 0x91, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("furya/params.proto", fileDescriptor_e816f2f20f762f6a) }
+
+var fileDescriptor_e816f2f20f762f6a = []byte{
+	// 1138 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0xfd, 0xf7, 0xc5, 0xe3, 0x28, 0x91, 0xc7, 0xb2, 0x4d, 0x3b, 0x5f, 0x24, 0xd5, 0x09,
+	0x0c, 0xd5, 0xa8, 0xa4, 0xc6, 0x5d, 0xb4, 0x08, 0x9a, 0x85, 0x7e, 0xe8, 0x44, 0xad, 0x2d, 0x09,
+	0x24, 0x55, 0xc0, 0x6d, 0xd0, 0xe9, 0x88, 0x1c, 0xcb, 0x44, 0x48, 0x8e, 0xc0, 0x19, 0xd9, 0x72,
+	0x36, 0x45, 0xfb, 0x04, 0xd9, 0x14, 0xe8, 0xa2, 0x28, 0xba, 0xee, 0x3a, 0x6f, 0xd0, 0x4d, 0x96,
+	0x41, 0x56, 0x45, 0x17, 0x49, 0x61, 0x6f, 0xfa, 0x18, 0xc5, 0x0c, 0xa9, 0x1f, 0x4b, 0xa9, 0xa3,
+	0x00, 0xde, 0x98, 0x1e, 0xde, 0x73, 0xcf, 0x39, 0xf7, 0xce, 0xd5, 0x25, 0x80, 0x87, 0x9d, 0xe0,
+	0x14, 0xe7, 0xdb, 0x38, 0xc0, 0x1e, 0xcb, 0xb5, 0x03, 0xca, 0x29, 0x5c, 0x94, 0xef, 0x72, 0xf2,
+	0xef, 0x46, 0xa2, 0x45, 0x5b, 0x54, 0xbe, 0xcf, 0x8b, 0xff, 0x42, 0xc8, 0xc6, 0xba, 0x45, 0x99,
+	0x47, 0x19, 0x0a, 0x03, 0xe1, 0x21, 0x0a, 0x25, 0xc3, 0x53, 0xbe, 0x89, 0x19, 0xc9, 0x1f, 0xdf,
+	0x6b, 0x12, 0x8e, 0xef, 0xe5, 0x2d, 0xea, 0xf8, 0xbd, 0x78, 0x8b, 0xd2, 0x96, 0x4b, 0xf2, 0xf2,
+	0xd4, 0xec, 0x1c, 0xe6, 0xed, 0x4e, 0x80, 0xb9, 0x43, 0x7b, 0xf1, 0xd4, 0x68, 0x9c, 0x3b, 0x1e,
+	0x61, 0x1c, 0x7b, 0xed, 0x10, 0xb0, 0xf9, 0x53, 0x0c, 0xcc, 0xd7, 0xa5, 0x5f, 0x58, 0x03, 0x4b,
+	0x01, 0x39, 0xc1, 0x81, 0x8d, 0x6c, 0xe2, 0xe2, 0x53, 0x24, 0xa0, 0xaa, 0x92, 0x56, 0x32, 0x8b,
+	0x3b, 0xeb, 0xb9, 0x90, 0x27, 0xd7, 0xe3, 0xc9, 0x95, 0x23, 0x9d, 0xe2, 0xb5, 0x17, 0xaf, 0x53,
+	0x53, 0x3f, 0xbf, 0x49, 0x29, 0xfa, 0xcd, 0x30, 0xbb, 0x2c, 0x92, 0x4d, 0xc7, 0x23, 0xf0, 0x31,
+	0x50, 0x39, 0x7e, 0x42, 0x50, 0x80, 0x39, 0x41, 0x96, 0x8b, 0x1d, 0x0f, 0x39, 0x3e, 0x27, 0xc1,
+	0x31, 0x76, 0xd5, 0xe9, 0xc9, 0x79, 0x57, 0x04, 0x89, 0x8e, 0x39, 0x29, 0x09, 0x8a, 0x4a, 0xc4,
+	0x00, 0xbf, 0x05, 0xeb, 0x2e, 0x66, 0x1c, 0x8d, 0x4a, 0x48, 0xdb, 0x33, 0x92, 0x7e, 0x63, 0x8c,
+	0xde, 0xec, 0x95, 0x1f, 0xf2, 0x3f, 0x93, 0xfc, 0x82, 0xc6, 0x1c, 0xd6, 0x90, 0xee, 0x0f, 0xc0,
+	0x2a, 0xee, 0x70, 0x8a, 0x2c, 0xea, 0xb5, 0x69, 0xc7, 0xb7, 0x07, 0xde, 0x67, 0x27, 0xf7, 0x9e,
+	0x10, 0x14, 0xa5, 0x88, 0xa1, 0x6f, 0xfd, 0x1b, 0xb0, 0x26, 0xad, 0x5f, 0xe4, 0x97, 0xc6, 0xe7,
+	0xde, 0xc3, 0x78, 0x42, 0x90, 0x14, 0x86, 0x04, 0xa4, 0xef, 0x1f, 0x15, 0x90, 0xf2, 0x70, 0x17,
+	0x1d, 0x63, 0xd7, 0xb1, 0x31, 0xa7, 0x01, 0x92, 0xb3, 0x87, 0xda, 0xf4, 0x84, 0x04, 0x88, 0x1d,
+	0xe1, 0x80, 0xa8, 0xf3, 0x69, 0x25, 0xb3, 0x50, 0xfc, 0x5c, 0x30, 0xfd, 0xf5, 0x3a, 0xb5, 0xd5,
+	0x72, 0xf8, 0x51, 0xa7, 0x99, 0xb3, 0xa8, 0x17, 0x4d, 0x5f, 0xf4, 0xc8, 0x32, 0xfb, 0x49, 0x9e,
+	0x9f, 0xb6, 0x09, 0xcb, 0x95, 0x89, 0xf5, 0xea, 0x79, 0x16, 0x44, 0xc3, 0x59, 0x26, 0x96, 0x7e,
+	0xcb, 0xc3, 0xdd, 0xaf, 0x7a, 0x1a, 0xbb, 0x42, 0xa2, 0x2e, 0x14, 0x0c, 0x21, 0x00, 0x29, 0x58,
+	0x11, 0x1e, 0xc6, 0x95, 0xff, 0x77, 0x05, 0xca, 0xd0, 0xc3, 0xdd, 0x51, 0x41, 0x1b, 0xfc, 0x3f,
+	0x1a, 0x5e, 0xc7, 0xb7, 0x49, 0x17, 0x31, 0xec, 0xb5, 0x5d, 0x32, 0xb8, 0xb3, 0x6b, 0x93, 0xdf,
+	0xd9, 0x7a, 0x48, 0x54, 0x11, 0x3c, 0x86, 0xa4, 0xe9, 0x5f, 0xdc, 0xa7, 0x40, 0x7d, 0x9b, 0x0a,
+	0x73, 0x9e, 0x12, 0x75, 0x21, 0xad, 0x64, 0x62, 0xfa, 0xca, 0x58, 0xb2, 0xe1, 0x3c, 0x25, 0xf0,
+	0x01, 0x88, 0xb5, 0x03, 0xc7, 0x22, 0xe8, 0x90, 0x10, 0x9b, 0x04, 0x4c, 0x05, 0xe9, 0x99, 0xcc,
+	0x42, 0x51, 0x7d, 0xf5, 0x3c, 0x9b, 0x88, 0x2a, 0x2b, 0xd8, 0x76, 0x40, 0x18, 0x33, 0x78, 0xe0,
+	0xf8, 0x2d, 0xfd, 0xba, 0x84, 0xef, 0x86, 0x68, 0xf8, 0x10, 0xc4, 0x44, 0x3b, 0x43, 0x0a, 0xdc,
+	0x22, 0xea, 0xe2, 0xe4, 0xe5, 0x2c, 0x7a, 0xb8, 0x5b, 0x17, 0x89, 0x85, 0x16, 0x81, 0x3f, 0x28,
+	0x60, 0xd5, 0xf1, 0x2d, 0xe2, 0x73, 0xe7, 0x98, 0x20, 0x2b, 0x20, 0x12, 0x2d, 0x5c, 0xa9, 0xd7,
+	0xd3, 0x33, 0x92, 0x32, 0xb2, 0x23, 0x36, 0x4e, 0x2e, 0xda, 0x38, 0xb9, 0x12, 0x75, 0xfc, 0xe2,
+	0xc7, 0x82, 0xf2, 0xf7, 0x37, 0xa9, 0xcc, 0x04, 0x97, 0x26, 0x12, 0x98, 0x9e, 0xe8, 0x4b, 0x95,
+	0x22, 0xa5, 0x5d, 0x42, 0xe0, 0x4e, 0x38, 0x1b, 0xd8, 0x92, 0x1e, 0xfa, 0x10, 0xa6, 0xc6, 0x64,
+	0x07, 0x97, 0x3d, 0xdc, 0x2d, 0xc8, 0x58, 0xa5, 0x1f, 0x82, 0x0f, 0x80, 0x18, 0xb7, 0x01, 0x18,
+	0xf5, 0x37, 0x95, 0x4f, 0x3d, 0xa6, 0xde, 0x90, 0x99, 0xaa, 0x87, 0xbb, 0xfd, 0x1c, 0x3d, 0x5a,
+	0x46, 0x22, 0x0e, 0x1f, 0x83, 0xd5, 0xc1, 0x9a, 0xb0, 0x09, 0xe3, 0x8e, 0x2f, 0xed, 0x30, 0xf5,
+	0xa6, 0xac, 0x3a, 0x9d, 0x1b, 0xda, 0xd2, 0xb9, 0xde, 0x2e, 0x28, 0x0f, 0x80, 0xc5, 0x59, 0x51,
+	0xbc, 0x9e, 0xe0, 0xe3, 0x21, 0x06, 0xef, 0x83, 0x75, 0x76, 0x42, 0x48, 0x1b, 0x75, 0x7c, 0xec,
+	0xba, 0xd4, 0xc2, 0x9c, 0xd8, 0x91, 0x41, 0xa6, 0xc6, 0xd3, 0x4a, 0xe6, 0x9a, 0xbe, 0x26, 0x01,
+	0x8d, 0x41, 0x3c, 0xb4, 0xc7, 0xe0, 0x77, 0x60, 0xa3, 0x57, 0x4a, 0x87, 0x71, 0x14, 0xf2, 0xf4,
+	0xa7, 0x76, 0x69, 0xf2, 0x6b, 0x5e, 0x8b, 0xb6, 0x6f, 0x87, 0x71, 0x43, 0x90, 0xf4, 0x67, 0xb6,
+	0x09, 0x6e, 0xc9, 0x65, 0x33, 0x2e, 0x23, 0x17, 0x0e, 0x7c, 0x8f, 0x85, 0x23, 0xb7, 0x96, 0x7e,
+	0x51, 0x47, 0xee, 0x9c, 0x2f, 0xc0, 0x12, 0x73, 0x31, 0x3b, 0x1a, 0xee, 0xad, 0xba, 0x9c, 0x56,
+	0x32, 0x37, 0x76, 0x6e, 0x5f, 0x68, 0xad, 0x21, 0x50, 0x43, 0xcd, 0xd3, 0xe3, 0x6c, 0xe4, 0xcd,
+	0xfd, 0xd9, 0x7f, 0x7e, 0x4b, 0x29, 0x9b, 0x7f, 0x28, 0x60, 0xf9, 0x2d, 0xf7, 0x00, 0x3f, 0x03,
+	0xb3, 0x62, 0xc2, 0xe4, 0x77, 0xe9, 0xc6, 0xce, 0xdd, 0x77, 0xdd, 0x9b, 0x79, 0xda, 0x26, 0xba,
+	0xcc, 0x80, 0xab, 0x60, 0x9e, 0xe3, 0xa0, 0x45, 0xb8, 0xfc, 0xf6, 0x2c, 0xe8, 0xd1, 0x09, 0x9a,
+	0x60, 0xfe, 0x84, 0x38, 0xad, 0x23, 0xae, 0xce, 0x5c, 0xc1, 0x6e, 0x8a, 0xb8, 0xa2, 0x2a, 0xbe,
+	0x07, 0xb1, 0xb0, 0x5d, 0x8f, 0x1c, 0xc6, 0x69, 0x70, 0x0a, 0x13, 0x60, 0x4e, 0x8e, 0xac, 0xf4,
+	0xbf, 0xa0, 0x87, 0x07, 0xa8, 0x83, 0x39, 0xb9, 0x4f, 0xd4, 0xe9, 0x2b, 0x70, 0x10, 0x52, 0x85,
+	0x06, 0xb6, 0x7f, 0x9d, 0x06, 0x6b, 0xff, 0xd1, 0x16, 0xb8, 0x0d, 0xb6, 0xcc, 0xc2, 0x97, 0x1a,
+	0xd2, 0x0b, 0xa6, 0x86, 0xca, 0x9a, 0x61, 0x56, 0xaa, 0x05, 0xb3, 0x52, 0xab, 0x22, 0xf3, 0xa0,
+	0xae, 0xa1, 0x46, 0xd5, 0xa8, 0x6b, 0xa5, 0xca, 0x6e, 0x45, 0x2b, 0xc7, 0xa7, 0xe0, 0x47, 0x20,
+	0x73, 0x09, 0x76, 0x57, 0xd3, 0x50, 0xa9, 0xb6, 0xb7, 0xa7, 0x95, 0xcc, 0x9a, 0x1e, 0x57, 0x60,
+	0x16, 0x7c, 0x78, 0x09, 0xba, 0x54, 0xdb, 0xdf, 0x6f, 0x54, 0x2b, 0xe6, 0x01, 0xaa, 0xd7, 0x6a,
+	0x7b, 0xf1, 0x69, 0x78, 0x07, 0xa4, 0x2e, 0x81, 0x17, 0x1b, 0x7a, 0x35, 0x3e, 0xf3, 0x0e, 0xce,
+	0xfd, 0x5a, 0xb9, 0xb1, 0xa7, 0xa1, 0x42, 0xa9, 0x54, 0x6b, 0x54, 0xcd, 0xf8, 0x2c, 0xdc, 0x02,
+	0x9b, 0x97, 0xc0, 0x0b, 0xe5, 0xb2, 0xae, 0x19, 0x46, 0x7c, 0x6e, 0xfb, 0x17, 0x05, 0xc4, 0x47,
+	0x87, 0x12, 0x7e, 0x00, 0x6e, 0x1b, 0x7b, 0x05, 0xe3, 0xd1, 0x85, 0xc4, 0x8b, 0x0d, 0xb9, 0x03,
+	0x52, 0xe3, 0x90, 0xd1, 0x3e, 0xdc, 0x05, 0xe9, 0x71, 0xd0, 0x58, 0xf9, 0x1b, 0x60, 0x75, 0x1c,
+	0x15, 0x56, 0x5d, 0x7c, 0xf8, 0xe2, 0x2c, 0xa9, 0xbc, 0x3c, 0x4b, 0x2a, 0x7f, 0x9f, 0x25, 0x95,
+	0x67, 0xe7, 0xc9, 0xa9, 0x97, 0xe7, 0xc9, 0xa9, 0x3f, 0xcf, 0x93, 0x53, 0x5f, 0x67, 0x87, 0x86,
+	0x43, 0x8e, 0x7f, 0x96, 0x1e, 0x1e, 0x3a, 0x96, 0x83, 0xdd, 0xf0, 0x98, 0xef, 0x46, 0x4f, 0x39,
+	0x27, 0xcd, 0x79, 0xf9, 0xc3, 0xfe, 0xe4, 0xdf, 0x01, 0x00, 0x7e, 0x5a, 0x2a, 0x7d, 0xa3, 0x0a,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -202,6 +519,99 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.LastTakeRateClaimTime.Equal(that1.LastTakeRateClaimTime) {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if !this.LastAutoCompoundTime.Equal(that1.LastAutoCompoundTime) {
+		return false
+	}
+	if !this.MaxValidatorFuryaPowerShare.Equal(that1.MaxValidatorFuryaPowerShare) {
+		return false
+	}
+	if !this.MaxFuryaPowerShare.Equal(that1.MaxFuryaPowerShare) {
+		return false
+	}
+	if this.RewardIndexSampleInterval != that1.RewardIndexSampleInterval {
+		return false
+	}
+	if this.RewardIndexSampleSize != that1.RewardIndexSampleSize {
+		return false
+	}
+	if len(this.PriceFeeders) != len(that1.PriceFeeders) {
+		return false
+	}
+	for i := range this.PriceFeeders {
+		if this.PriceFeeders[i] != that1.PriceFeeders[i] {
+			return false
+		}
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if len(this.IncentiveCreationFee) != len(that1.IncentiveCreationFee) {
+		return false
+	}
+	for i := range this.IncentiveCreationFee {
+		if !this.IncentiveCreationFee[i].Equal(&that1.IncentiveCreationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxActiveIncentives != that1.MaxActiveIncentives {
+		return false
+	}
+	if this.MaxIncentiveRewardDenoms != that1.MaxIncentiveRewardDenoms {
+		return false
+	}
+	if len(this.TakeRateDestinations) != len(that1.TakeRateDestinations) {
+		return false
+	}
+	for i := range this.TakeRateDestinations {
+		if !this.TakeRateDestinations[i].Equal(&that1.TakeRateDestinations[i]) {
+			return false
+		}
+	}
+	if this.SweepUnallocatedRewards != that1.SweepUnallocatedRewards {
+		return false
+	}
+	if this.RewardDustSweepInterval != that1.RewardDustSweepInterval {
+		return false
+	}
+	if !this.LastRewardDustSweepTime.Equal(that1.LastRewardDustSweepTime) {
+		return false
+	}
+	if this.SlashDestination != that1.SlashDestination {
+		return false
+	}
+	return true
+}
+func (this *TakeRateDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TakeRateDestination)
+	if !ok {
+		that2, ok := that.(TakeRateDestination)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *RewardHistory) Equal(that interface{}) bool {
@@ -251,34 +661,177 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTakeRateClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime):])
+	if m.SlashDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashDestination))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRewardDustSweepTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardDustSweepTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TakeRateClaimInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateClaimInterval):])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardDustSweepInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDustSweepInterval):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x12
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardDelayTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDelayTime):])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.SweepUnallocatedRewards {
+		i--
+		if m.SweepUnallocatedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.TakeRateDestinations) > 0 {
+		for iNdEx := len(m.TakeRateDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateDestinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MaxIncentiveRewardDenoms != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIncentiveRewardDenoms))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxActiveIncentives != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveIncentives))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.IncentiveCreationFee) > 0 {
+		for iNdEx := len(m.IncentiveCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x5a
+	if len(m.PriceFeeders) > 0 {
+		for iNdEx := len(m.PriceFeeders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriceFeeders[iNdEx])
+			copy(dAtA[i:], m.PriceFeeders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PriceFeeders[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RewardIndexSampleSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardIndexSampleSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardIndexSampleInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardIndexSampleInterval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxFuryaPowerShare.Size()
+		i -= size
+		if _, err := m.MaxFuryaPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxValidatorFuryaPowerShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorFuryaPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAutoCompoundTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAutoCompoundTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AutoCompoundInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundInterval):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTakeRateClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TakeRateClaimInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateClaimInterval):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardDelayTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDelayTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardHistory) Marshal() (dAtA []byte, err error) {
+func (m *TakeRateDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -288,34 +841,79 @@ func (m *RewardHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *TakeRateDestination) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TakeRateDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Index.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+	dAtA[i] = 0x1a
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Target)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	if m.Type != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
@@ -341,6 +939,73 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime)
 	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundInterval)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAutoCompoundTime)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxValidatorFuryaPowerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFuryaPowerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardIndexSampleInterval)
+	n += 1 + l + sovParams(uint64(l))
+	if m.RewardIndexSampleSize != 0 {
+		n += 1 + sovParams(uint64(m.RewardIndexSampleSize))
+	}
+	if len(m.PriceFeeders) > 0 {
+		for _, s := range m.PriceFeeders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.IncentiveCreationFee) > 0 {
+		for _, e := range m.IncentiveCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxActiveIncentives != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveIncentives))
+	}
+	if m.MaxIncentiveRewardDenoms != 0 {
+		n += 1 + sovParams(uint64(m.MaxIncentiveRewardDenoms))
+	}
+	if len(m.TakeRateDestinations) > 0 {
+		for _, e := range m.TakeRateDestinations {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SweepUnallocatedRewards {
+		n += 3
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDustSweepInterval)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardDustSweepTime)
+	n += 2 + l + sovParams(uint64(l))
+	if m.SlashDestination != 0 {
+		n += 2 + sovParams(uint64(m.SlashDestination))
+	}
+	return n
+}
+
+func (m *TakeRateDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovParams(uint64(m.Type))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -493,6 +1158,603 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AutoCompoundInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAutoCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAutoCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorFuryaPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorFuryaPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFuryaPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFuryaPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexSampleInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardIndexSampleInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexSampleSize", wireType)
+			}
+			m.RewardIndexSampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardIndexSampleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeders = append(m.PriceFeeders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveCreationFee = append(m.IncentiveCreationFee, types.Coin{})
+			if err := m.IncentiveCreationFee[len(m.IncentiveCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveIncentives", wireType)
+			}
+			m.MaxActiveIncentives = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveIncentives |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncentiveRewardDenoms", wireType)
+			}
+			m.MaxIncentiveRewardDenoms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncentiveRewardDenoms |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateDestinations = append(m.TakeRateDestinations, TakeRateDestination{})
+			if err := m.TakeRateDestinations[len(m.TakeRateDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepUnallocatedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SweepUnallocatedRewards = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDustSweepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardDustSweepInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardDustSweepTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRewardDustSweepTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDestination", wireType)
+			}
+			m.SlashDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashDestination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakeRateDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeRateDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeRateDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TakeRateDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -12,19 +12,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,7 +45,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{0}
+	return fileDescriptor_29991d92828164be, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +82,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{1}
+	return fileDescriptor_29991d92828164be, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +127,7 @@ func (m *QueryFuryasRequest) Reset()         { *m = QueryFuryasRequest{} }
 func (m *QueryFuryasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryasRequest) ProtoMessage()    {}
 func (*QueryFuryasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{2}
+	return fileDescriptor_29991d92828164be, []int{2}
 }
 func (m *QueryFuryasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +164,7 @@ func (m *QueryFuryasRequest) GetPagination() *query.PageRequest {
 }
 
 type QueryFuryasResponse struct {
-	Furyas  []FuryaAsset     `protobuf:"bytes,1,rep,name=furyas,proto3" json:"furyas"`
+	Furyas     []FuryaAsset        `protobuf:"bytes,1,rep,name=furyas,proto3" json:"furyas"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
@@ -167,7 +172,7 @@ func (m *QueryFuryasResponse) Reset()         { *m = QueryFuryasResponse{} }
 func (m *QueryFuryasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryasResponse) ProtoMessage()    {}
 func (*QueryFuryasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{3}
+	return fileDescriptor_29991d92828164be, []int{3}
 }
 func (m *QueryFuryasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +224,7 @@ func (m *QueryFuryaRequest) Reset()         { *m = QueryFuryaRequest{} }
 func (m *QueryFuryaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaRequest) ProtoMessage()    {}
 func (*QueryFuryaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{4}
+	return fileDescriptor_29991d92828164be, []int{4}
 }
 func (m *QueryFuryaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,13 +262,17 @@ func (m *QueryFuryaRequest) GetDenom() string {
 
 type QueryFuryaResponse struct {
 	Furya *FuryaAsset `protobuf:"bytes,1,opt,name=furya,proto3" json:"furya,omitempty"`
+	// Amount that can still be delegated before max_total_tokens is reached. Unset when the asset has no cap
+	RemainingCapacity *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_capacity,omitempty"`
+	// Reward weights the asset will have at its next reward changes, stopping at the reward weight bounds
+	ProjectedRewardWeights []ProjectedRewardWeight `protobuf:"bytes,3,rep,name=projected_reward_weights,json=projectedRewardWeights,proto3" json:"projected_reward_weights"`
 }
 
 func (m *QueryFuryaResponse) Reset()         { *m = QueryFuryaResponse{} }
 func (m *QueryFuryaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaResponse) ProtoMessage()    {}
 func (*QueryFuryaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{5}
+	return fileDescriptor_29991d92828164be, []int{5}
 }
 func (m *QueryFuryaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,6 +308,58 @@ func (m *QueryFuryaResponse) GetFurya() *FuryaAsset {
 	return nil
 }
 
+func (m *QueryFuryaResponse) GetProjectedRewardWeights() []ProjectedRewardWeight {
+	if m != nil {
+		return m.ProjectedRewardWeights
+	}
+	return nil
+}
+
+type ProjectedRewardWeight struct {
+	Time         time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+}
+
+func (m *ProjectedRewardWeight) Reset()         { *m = ProjectedRewardWeight{} }
+func (m *ProjectedRewardWeight) String() string { return proto.CompactTextString(m) }
+func (*ProjectedRewardWeight) ProtoMessage()    {}
+func (*ProjectedRewardWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{6}
+}
+func (m *ProjectedRewardWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedRewardWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedRewardWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedRewardWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedRewardWeight.Merge(m, src)
+}
+func (m *ProjectedRewardWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedRewardWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedRewardWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedRewardWeight proto.InternalMessageInfo
+
+func (m *ProjectedRewardWeight) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 type QueryIBCFuryaRequest struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
@@ -307,7 +368,7 @@ func (m *QueryIBCFuryaRequest) Reset()         { *m = QueryIBCFuryaRequest{} }
 func (m *QueryIBCFuryaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIBCFuryaRequest) ProtoMessage()    {}
 func (*QueryIBCFuryaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{6}
+	return fileDescriptor_29991d92828164be, []int{7}
 }
 func (m *QueryIBCFuryaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,7 +412,7 @@ func (m *QueryFuryaValidatorRequest) Reset()         { *m = QueryFuryaValidatorR
 func (m *QueryFuryaValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaValidatorRequest) ProtoMessage()    {}
 func (*QueryFuryaValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{7}
+	return fileDescriptor_29991d92828164be, []int{8}
 }
 func (m *QueryFuryaValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +449,7 @@ func (m *QueryAllFuryaValidatorsRequest) Reset()         { *m = QueryAllFuryaVal
 func (m *QueryAllFuryaValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFuryaValidatorsRequest) ProtoMessage()    {}
 func (*QueryAllFuryaValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{8}
+	return fileDescriptor_29991d92828164be, []int{9}
 }
 func (m *QueryAllFuryaValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +486,7 @@ func (m *QueryAllFuryasDelegationsRequest) Reset()         { *m = QueryAllFuryas
 func (m *QueryAllFuryasDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllFuryasDelegationsRequest) ProtoMessage()    {}
 func (*QueryAllFuryasDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{9}
+	return fileDescriptor_29991d92828164be, []int{10}
 }
 func (m *QueryAllFuryasDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +525,7 @@ func (m *QueryFuryasDelegationsRequest) Reset()         { *m = QueryFuryasDelega
 func (m *QueryFuryasDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryasDelegationsRequest) ProtoMessage()    {}
 func (*QueryFuryasDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{10}
+	return fileDescriptor_29991d92828164be, []int{11}
 }
 func (m *QueryFuryasDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,12 +564,10 @@ type QueryFuryasDelegationByValidatorRequest struct {
 func (m *QueryFuryasDelegationByValidatorRequest) Reset() {
 	*m = QueryFuryasDelegationByValidatorRequest{}
 }
-func (m *QueryFuryasDelegationByValidatorRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryFuryasDelegationByValidatorRequest) ProtoMessage() {}
+func (m *QueryFuryasDelegationByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryasDelegationByValidatorRequest) ProtoMessage()    {}
 func (*QueryFuryasDelegationByValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{11}
+	return fileDescriptor_29991d92828164be, []int{12}
 }
 func (m *QueryFuryasDelegationByValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +607,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{12}
+	return fileDescriptor_29991d92828164be, []int{13}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +659,7 @@ func (m *QueryFuryasDelegationsResponse) Reset()         { *m = QueryFuryasDeleg
 func (m *QueryFuryasDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryasDelegationsResponse) ProtoMessage()    {}
 func (*QueryFuryasDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{13}
+	return fileDescriptor_29991d92828164be, []int{14}
 }
 func (m *QueryFuryasDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +714,7 @@ func (m *QueryFuryaDelegationRequest) Reset()         { *m = QueryFuryaDelegatio
 func (m *QueryFuryaDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaDelegationRequest) ProtoMessage()    {}
 func (*QueryFuryaDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{14}
+	return fileDescriptor_29991d92828164be, []int{15}
 }
 func (m *QueryFuryaDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +754,7 @@ func (m *QueryIBCFuryaDelegationRequest) Reset()         { *m = QueryIBCFuryaDel
 func (m *QueryIBCFuryaDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIBCFuryaDelegationRequest) ProtoMessage()    {}
 func (*QueryIBCFuryaDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{15}
+	return fileDescriptor_29991d92828164be, []int{16}
 }
 func (m *QueryIBCFuryaDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +791,7 @@ func (m *QueryFuryaDelegationResponse) Reset()         { *m = QueryFuryaDelegati
 func (m *QueryFuryaDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaDelegationResponse) ProtoMessage()    {}
 func (*QueryFuryaDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{16}
+	return fileDescriptor_29991d92828164be, []int{17}
 }
 func (m *QueryFuryaDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +839,7 @@ func (m *QueryFuryaDelegationRewardsRequest) Reset()         { *m = QueryFuryaDe
 func (m *QueryFuryaDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryFuryaDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{17}
+	return fileDescriptor_29991d92828164be, []int{18}
 }
 func (m *QueryFuryaDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,13 +875,11 @@ type QueryIBCFuryaDelegationRewardsRequest struct {
 	Pagination    *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIBCFuryaDelegationRewardsRequest) Reset() {
-	*m = QueryIBCFuryaDelegationRewardsRequest{}
-}
+func (m *QueryIBCFuryaDelegationRewardsRequest) Reset()         { *m = QueryIBCFuryaDelegationRewardsRequest{} }
 func (m *QueryIBCFuryaDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryIBCFuryaDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryIBCFuryaDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{18}
+	return fileDescriptor_29991d92828164be, []int{19}
 }
 func (m *QueryIBCFuryaDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,13 +912,11 @@ type QueryFuryaDelegationRewardsResponse struct {
 	Rewards []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"rewards"`
 }
 
-func (m *QueryFuryaDelegationRewardsResponse) Reset() {
-	*m = QueryFuryaDelegationRewardsResponse{}
-}
+func (m *QueryFuryaDelegationRewardsResponse) Reset()         { *m = QueryFuryaDelegationRewardsResponse{} }
 func (m *QueryFuryaDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryFuryaDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{19}
+	return fileDescriptor_29991d92828164be, []int{20}
 }
 func (m *QueryFuryaDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +956,7 @@ func (m *QueryFuryaValidatorResponse) Reset()         { *m = QueryFuryaValidator
 func (m *QueryFuryaValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaValidatorResponse) ProtoMessage()    {}
 func (*QueryFuryaValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{20}
+	return fileDescriptor_29991d92828164be, []int{21}
 }
 func (m *QueryFuryaValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,14 +987,14 @@ var xxx_messageInfo_QueryFuryaValidatorResponse proto.InternalMessageInfo
 
 type QueryFuryaValidatorsResponse struct {
 	Validators []QueryFuryaValidatorResponse `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
-	Pagination *query.PageResponse              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageResponse           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFuryaValidatorsResponse) Reset()         { *m = QueryFuryaValidatorsResponse{} }
 func (m *QueryFuryaValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFuryaValidatorsResponse) ProtoMessage()    {}
 func (*QueryFuryaValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c4e633a06a64e02e, []int{21}
+	return fileDescriptor_29991d92828164be, []int{22}
 }
 func (m *QueryFuryaValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,1981 +1023,7163 @@ func (m *QueryFuryaValidatorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFuryaValidatorsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "furya.furya.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "furya.furya.QueryParamsResponse")
-	proto.RegisterType((*QueryFuryasRequest)(nil), "furya.furya.QueryFuryasRequest")
-	proto.RegisterType((*QueryFuryasResponse)(nil), "furya.furya.QueryFuryasResponse")
-	proto.RegisterType((*QueryFuryaRequest)(nil), "furya.furya.QueryFuryaRequest")
-	proto.RegisterType((*QueryFuryaResponse)(nil), "furya.furya.QueryFuryaResponse")
-	proto.RegisterType((*QueryIBCFuryaRequest)(nil), "furya.furya.QueryIBCFuryaRequest")
-	proto.RegisterType((*QueryFuryaValidatorRequest)(nil), "furya.furya.QueryFuryaValidatorRequest")
-	proto.RegisterType((*QueryAllFuryaValidatorsRequest)(nil), "furya.furya.QueryAllFuryaValidatorsRequest")
-	proto.RegisterType((*QueryAllFuryasDelegationsRequest)(nil), "furya.furya.QueryAllFuryasDelegationsRequest")
-	proto.RegisterType((*QueryFuryasDelegationsRequest)(nil), "furya.furya.QueryFuryasDelegationsRequest")
-	proto.RegisterType((*QueryFuryasDelegationByValidatorRequest)(nil), "furya.furya.QueryFuryasDelegationByValidatorRequest")
-	proto.RegisterType((*DelegationResponse)(nil), "furya.furya.DelegationResponse")
-	proto.RegisterType((*QueryFuryasDelegationsResponse)(nil), "furya.furya.QueryFuryasDelegationsResponse")
-	proto.RegisterType((*QueryFuryaDelegationRequest)(nil), "furya.furya.QueryFuryaDelegationRequest")
-	proto.RegisterType((*QueryIBCFuryaDelegationRequest)(nil), "furya.furya.QueryIBCFuryaDelegationRequest")
-	proto.RegisterType((*QueryFuryaDelegationResponse)(nil), "furya.furya.QueryFuryaDelegationResponse")
-	proto.RegisterType((*QueryFuryaDelegationRewardsRequest)(nil), "furya.furya.QueryFuryaDelegationRewardsRequest")
-	proto.RegisterType((*QueryIBCFuryaDelegationRewardsRequest)(nil), "furya.furya.QueryIBCFuryaDelegationRewardsRequest")
-	proto.RegisterType((*QueryFuryaDelegationRewardsResponse)(nil), "furya.furya.QueryFuryaDelegationRewardsResponse")
-	proto.RegisterType((*QueryFuryaValidatorResponse)(nil), "furya.furya.QueryFuryaValidatorResponse")
-	proto.RegisterType((*QueryFuryaValidatorsResponse)(nil), "furya.furya.QueryFuryaValidatorsResponse")
+type QueryFuryaWithdrawAddressRequest struct {
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
 }
 
-func init() { proto.RegisterFile("furya/query.proto", fileDescriptor_c4e633a06a64e02e) }
-
-var fileDescriptor_c4e633a06a64e02e = []byte{
-	// 1282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x69, 0x9a, 0xbc, 0x40, 0x69, 0x5f, 0x13, 0x92, 0x98, 0xc4, 0x0e, 0x5b, 0xf2,
-	0x41, 0xd4, 0x78, 0x9b, 0x34, 0x7c, 0xb4, 0x7c, 0x88, 0x7c, 0x34, 0xa5, 0xad, 0x52, 0xa5, 0xae,
-	0x00, 0xa9, 0x97, 0x68, 0xec, 0x5d, 0x39, 0x56, 0x6d, 0xaf, 0xbb, 0xbb, 0x69, 0x1b, 0xa2, 0x08,
-	0x89, 0x53, 0x8f, 0x48, 0x5c, 0xf8, 0xb8, 0xf4, 0x04, 0x27, 0xb8, 0x72, 0xe0, 0xc8, 0xa5, 0x12,
-	0x20, 0x55, 0x02, 0x01, 0x42, 0x34, 0x42, 0x09, 0x48, 0xfc, 0x19, 0xc8, 0xb3, 0x33, 0xbb, 0xe3,
-	0xdd, 0xf5, 0xc7, 0x26, 0x36, 0x12, 0xa7, 0x6e, 0xc7, 0xf3, 0xde, 0xfb, 0xfd, 0xde, 0xfb, 0xcd,
-	0x9b, 0x37, 0x81, 0x01, 0x5a, 0x2c, 0x16, 0x68, 0x39, 0xa7, 0xab, 0x77, 0xb6, 0x74, 0x73, 0x3b,
-	0x5d, 0x31, 0x0d, 0xdb, 0xc0, 0x53, 0x62, 0x35, 0x2d, 0x3e, 0x12, 0x03, 0x79, 0x23, 0x6f, 0xb0,
-	0x5f, 0xd5, 0xea, 0x97, 0xb3, 0x31, 0x31, 0x9a, 0x37, 0x8c, 0x7c, 0x51, 0x57, 0x69, 0xa5, 0xa0,
-	0xd2, 0x72, 0xd9, 0xb0, 0xa9, 0x5d, 0x30, 0xca, 0x16, 0xff, 0x75, 0x26, 0x67, 0x58, 0x25, 0xc3,
-	0x52, 0xb3, 0xd4, 0xe2, 0xfe, 0xd5, 0xbb, 0x73, 0x59, 0xdd, 0xa6, 0x73, 0x6a, 0x85, 0xe6, 0x0b,
-	0x65, 0xb6, 0x99, 0xef, 0x1d, 0x74, 0x81, 0x54, 0xa8, 0x49, 0x4b, 0xc2, 0xc5, 0x90, 0xbb, 0xec,
-	0x42, 0x72, 0x7e, 0x48, 0xca, 0xbe, 0x85, 0xd7, 0x9c, 0x51, 0x10, 0xfe, 0x12, 0xae, 0xa1, 0xa6,
-	0x17, 0xf5, 0xbc, 0x8c, 0x4b, 0x19, 0x00, 0xbc, 0x51, 0x45, 0xb3, 0xce, 0x22, 0x65, 0xf4, 0x3b,
-	0x5b, 0xba, 0x65, 0x2b, 0xd7, 0xe1, 0x74, 0xcd, 0xaa, 0x55, 0x31, 0xca, 0x96, 0x8e, 0xaf, 0x40,
-	0x8f, 0x83, 0x68, 0x98, 0x8c, 0x93, 0xe9, 0xfe, 0xf9, 0x91, 0x74, 0x20, 0x39, 0x69, 0xc7, 0x64,
-	0xa9, 0xfb, 0xd1, 0x5e, 0x2a, 0x96, 0xe1, 0xdb, 0x95, 0x0d, 0x18, 0x64, 0xfe, 0x16, 0xf9, 0x2e,
-	0x11, 0x08, 0x57, 0x01, 0x3c, 0xfa, 0xdc, 0xeb, 0x64, 0xda, 0xe1, 0x93, 0xae, 0xf2, 0x49, 0x3b,
-	0xb5, 0xe0, 0xac, 0xd2, 0xeb, 0x34, 0xaf, 0x73, 0xdb, 0x8c, 0x64, 0xa9, 0x7c, 0x49, 0xe0, 0x59,
-	0x7f, 0x04, 0x0e, 0x7a, 0x05, 0xfa, 0x04, 0xb8, 0x2a, 0xee, 0xae, 0xe9, 0xfe, 0xf9, 0xf1, 0x10,
-	0xdc, 0xc2, 0x70, 0xd1, 0xb2, 0x74, 0x9b, 0xc3, 0xf7, 0x0c, 0xf1, 0x72, 0x0d, 0xd0, 0x38, 0x03,
-	0x3a, 0xd5, 0x14, 0xa8, 0x03, 0xa1, 0x06, 0xe9, 0x59, 0x18, 0xa8, 0x01, 0x2a, 0x32, 0x31, 0x00,
-	0xc7, 0x34, 0xbd, 0x6c, 0x94, 0x58, 0x12, 0xfa, 0x32, 0xce, 0x7f, 0x94, 0x77, 0x7c, 0x89, 0x73,
-	0x59, 0xbd, 0x0e, 0xbd, 0x02, 0x1c, 0x4f, 0x5b, 0x53, 0x52, 0x19, 0xd7, 0x42, 0x99, 0x85, 0x21,
-	0xe6, 0xf6, 0xca, 0xd2, 0xb2, 0x1f, 0x07, 0x42, 0xf7, 0x26, 0xb5, 0x36, 0x39, 0x0c, 0xf6, 0xad,
-	0xac, 0xc3, 0x58, 0x0d, 0x8a, 0x77, 0x69, 0xb1, 0xa0, 0x51, 0xdb, 0x30, 0x85, 0xd1, 0x04, 0x9c,
-	0xb8, 0x2b, 0xd6, 0x36, 0xa8, 0xa6, 0x99, 0xdc, 0xfc, 0x69, 0x77, 0x75, 0x51, 0xd3, 0xcc, 0x8b,
-	0xbd, 0x0f, 0x1e, 0xa6, 0x62, 0xff, 0x3c, 0x4c, 0xc5, 0x94, 0x2d, 0x78, 0x5e, 0x78, 0x0c, 0x38,
-	0x6d, 0xb7, 0x38, 0xa4, 0xb0, 0xf7, 0xe0, 0x8c, 0x3f, 0xac, 0xb5, 0xe2, 0x9d, 0x89, 0xce, 0x05,
-	0xfe, 0x9c, 0xc0, 0x78, 0xad, 0x3e, 0x43, 0xc2, 0x4e, 0xc0, 0x09, 0x7e, 0x40, 0x7d, 0x59, 0x74,
-	0x57, 0xab, 0x59, 0xf4, 0xa1, 0x8b, 0xb7, 0x01, 0xdd, 0x0f, 0x04, 0x66, 0xea, 0xa1, 0x5b, 0xda,
-	0x0e, 0xab, 0x76, 0x2b, 0x38, 0x83, 0xa2, 0x88, 0x87, 0x88, 0xc2, 0x47, 0xa7, 0xab, 0x0d, 0x74,
-	0x3e, 0x23, 0x80, 0x1e, 0x01, 0xf7, 0xc8, 0x2c, 0x03, 0x78, 0xfd, 0x8f, 0x57, 0x75, 0x2c, 0xe4,
-	0xd0, 0x48, 0xdc, 0x9d, 0x36, 0x20, 0x99, 0xe1, 0x05, 0x38, 0x9e, 0xa5, 0x45, 0x76, 0xec, 0xe2,
-	0xbc, 0x07, 0xca, 0x50, 0x05, 0xc8, 0x65, 0xa3, 0x20, 0xac, 0xc5, 0xfe, 0x8b, 0xdd, 0x0c, 0xdc,
-	0xb7, 0xc4, 0x93, 0x7e, 0x88, 0x12, 0x38, 0xd6, 0x35, 0xe8, 0x97, 0x7a, 0x35, 0x6f, 0x5b, 0x13,
-	0x0d, 0xc1, 0x0a, 0x5b, 0x1e, 0x56, 0xb6, 0x6f, 0x5f, 0xf7, 0xfa, 0x85, 0x40, 0xb2, 0x06, 0xbd,
-	0x1c, 0xbf, 0x13, 0xea, 0x70, 0xdb, 0x62, 0x97, 0xd4, 0x16, 0x7d, 0x9a, 0xe9, 0x6e, 0x83, 0x66,
-	0x7e, 0x15, 0x65, 0x91, 0x5a, 0x62, 0xa7, 0xb9, 0x89, 0x56, 0xdb, 0xe5, 0xb5, 0xda, 0x0e, 0x30,
-	0x2b, 0x43, 0xaa, 0x6e, 0xc5, 0xb8, 0xda, 0xae, 0x85, 0x9c, 0x8c, 0x48, 0x62, 0x93, 0xcc, 0x95,
-	0x27, 0x04, 0x26, 0xea, 0x06, 0xbc, 0x47, 0x4d, 0xcd, 0xfa, 0x7f, 0x2b, 0x65, 0x8f, 0xc0, 0x74,
-	0x23, 0xa5, 0x74, 0x90, 0xe2, 0x7f, 0x23, 0x98, 0x4f, 0x08, 0x4c, 0x36, 0x2b, 0x20, 0x17, 0x8e,
-	0x06, 0xc7, 0x4d, 0x67, 0x89, 0xb7, 0xa8, 0x06, 0xdd, 0x50, 0xad, 0x2a, 0xe5, 0xf7, 0xbd, 0xd4,
-	0x54, 0xbe, 0x60, 0x6f, 0x6e, 0x65, 0xd3, 0x39, 0xa3, 0xa4, 0xf2, 0xc1, 0xd5, 0xf9, 0x67, 0xd6,
-	0xd2, 0x6e, 0xab, 0xf6, 0x76, 0x45, 0xb7, 0x98, 0x41, 0x46, 0xb8, 0x96, 0xa0, 0x7d, 0x17, 0xf7,
-	0xb5, 0x1f, 0xe9, 0x6e, 0xe2, 0x90, 0x5a, 0x1b, 0x45, 0xf0, 0x16, 0x0c, 0xd9, 0x86, 0x4d, 0x8b,
-	0x1b, 0x9e, 0x72, 0x37, 0xac, 0x4d, 0x6a, 0xea, 0xd6, 0x70, 0x9c, 0x31, 0x19, 0x0d, 0x65, 0xb2,
-	0xa2, 0xe7, 0xa4, 0xd6, 0x3e, 0xc8, 0x5c, 0x78, 0xe9, 0xb9, 0xc9, 0x1c, 0xe0, 0x1a, 0x9c, 0xf4,
-	0x20, 0x70, 0xa7, 0x5d, 0x2d, 0x3b, 0x7d, 0xc6, 0xb5, 0xe5, 0xee, 0x2e, 0xc1, 0x53, 0x0e, 0x54,
-	0xcb, 0xa6, 0xb7, 0x75, 0x6d, 0xb8, 0xbb, 0x65, 0x57, 0xfd, 0xcc, 0xee, 0x26, 0x33, 0x93, 0xb2,
-	0xf8, 0x23, 0xf1, 0xb5, 0x04, 0x79, 0xf4, 0xe2, 0x69, 0x7c, 0x0f, 0xc0, 0xc5, 0x21, 0x8a, 0x3b,
-	0x17, 0xd2, 0x12, 0x1a, 0x57, 0x43, 0xb4, 0x07, 0xcf, 0x55, 0xdb, 0xae, 0x22, 0x8f, 0xcf, 0xfc,
-	0xa7, 0x08, 0xc7, 0x18, 0x0e, 0xbc, 0x0f, 0x3d, 0xce, 0xfb, 0x03, 0x27, 0xea, 0x61, 0xad, 0x79,
-	0xe8, 0x24, 0x26, 0x9b, 0x6d, 0x73, 0x02, 0x2b, 0xa9, 0x0f, 0x7f, 0xfa, 0xeb, 0xe3, 0xf8, 0x08,
-	0x0e, 0xa9, 0xb6, 0x6e, 0x9a, 0xd4, 0x7d, 0x81, 0x59, 0xfc, 0x89, 0x86, 0xef, 0x43, 0x9f, 0x7b,
-	0xa1, 0xe3, 0x74, 0xb3, 0x44, 0xb9, 0xf1, 0x5f, 0x6c, 0x61, 0x27, 0x87, 0x30, 0xcc, 0x20, 0x20,
-	0x9e, 0xf4, 0x43, 0xc0, 0x07, 0x04, 0xfa, 0xa5, 0x66, 0x84, 0x33, 0xf5, 0x9c, 0x06, 0xc7, 0xfd,
-	0x44, 0x53, 0xa8, 0x6e, 0xfc, 0x33, 0x2c, 0xfe, 0x18, 0x3e, 0x17, 0x48, 0x41, 0x21, 0x9b, 0x53,
-	0x77, 0xaa, 0xcd, 0x68, 0x17, 0xbf, 0x22, 0x30, 0x54, 0x67, 0xb8, 0xc6, 0x97, 0x1b, 0x84, 0x6a,
-	0x30, 0x16, 0x27, 0x16, 0x9a, 0xe6, 0x28, 0x64, 0x82, 0x52, 0x5e, 0x60, 0x70, 0x93, 0x38, 0x1a,
-	0x80, 0x2b, 0x0f, 0x46, 0x5f, 0x13, 0x38, 0x15, 0x50, 0x2f, 0x9e, 0x8b, 0x20, 0x74, 0x07, 0x63,
-	0xf4, 0xa3, 0xa1, 0x2c, 0x30, 0x80, 0x69, 0x3c, 0x1b, 0x00, 0xe8, 0x9d, 0x16, 0x75, 0xa7, 0xb6,
-	0x97, 0xed, 0xe2, 0x17, 0x04, 0x06, 0x43, 0x1f, 0x4d, 0xb8, 0xd0, 0x42, 0x7a, 0x03, 0x6f, 0xac,
-	0xc4, 0x7c, 0xcb, 0xc0, 0xad, 0x16, 0x94, 0x20, 0x9d, 0xf3, 0x6f, 0x08, 0x9c, 0x0e, 0x29, 0x10,
-	0x9e, 0x8f, 0x56, 0xcd, 0xa3, 0x48, 0xe0, 0x25, 0x86, 0x53, 0xc5, 0xd9, 0x46, 0x12, 0x50, 0x77,
-	0x6a, 0x2f, 0xe8, 0x5d, 0x7c, 0x42, 0x20, 0xd9, 0xf8, 0x21, 0x84, 0x6f, 0x44, 0xc0, 0x13, 0x7c,
-	0x40, 0x1d, 0x92, 0xce, 0x2a, 0xa3, 0xf3, 0x16, 0xbe, 0x19, 0x89, 0x4e, 0x50, 0x42, 0xdf, 0x13,
-	0xc0, 0xe0, 0xd5, 0x8e, 0x4d, 0x25, 0x1c, 0x18, 0x87, 0x9b, 0x8b, 0x27, 0x38, 0x30, 0x2a, 0xd7,
-	0x19, 0x8b, 0xb7, 0x71, 0xf5, 0x68, 0x2c, 0xaa, 0x3b, 0xca, 0x46, 0x69, 0x17, 0x7f, 0x26, 0x30,
-	0x18, 0x3a, 0x89, 0xd5, 0x3f, 0x10, 0x8d, 0x46, 0xfc, 0x43, 0x71, 0xba, 0xc1, 0x38, 0x5d, 0xc3,
-	0x2b, 0x47, 0xe4, 0x24, 0x35, 0xd2, 0x3f, 0x08, 0x8c, 0xd4, 0x9d, 0xbf, 0xf0, 0xd5, 0x28, 0x20,
-	0xe5, 0x81, 0x34, 0x71, 0xe1, 0x10, 0x96, 0x9c, 0xe5, 0x55, 0xc6, 0x72, 0x05, 0x97, 0x02, 0x2c,
-	0xf9, 0xa0, 0x16, 0xa1, 0x6a, 0x7f, 0x13, 0x18, 0x6d, 0x34, 0x3f, 0xe3, 0x6b, 0x11, 0x8b, 0xd7,
-	0x2e, 0x92, 0x6b, 0x8c, 0xe4, 0x65, 0xbc, 0x74, 0x04, 0x92, 0x52, 0x19, 0x3f, 0x80, 0x5e, 0xf7,
-	0x5a, 0x9e, 0x6a, 0x7e, 0xd5, 0x46, 0xbd, 0x93, 0xc7, 0x19, 0xda, 0x04, 0x0e, 0x07, 0xd0, 0xf2,
-	0x44, 0x2f, 0x5d, 0x7d, 0xb4, 0x9f, 0x24, 0x8f, 0xf7, 0x93, 0xe4, 0xcf, 0xfd, 0x24, 0xf9, 0xe8,
-	0x20, 0x19, 0x7b, 0x7c, 0x90, 0x8c, 0xfd, 0x76, 0x90, 0x8c, 0xdd, 0x3a, 0x27, 0xcd, 0xe1, 0xcc,
-	0x7a, 0xb6, 0x64, 0x94, 0xf5, 0x6d, 0xd7, 0x87, 0x7a, 0xdf, 0xfb, 0x64, 0x53, 0x79, 0xb6, 0x87,
-	0xfd, 0xc9, 0xf8, 0xfc, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x29, 0xb4, 0x46, 0xac, 0x29, 0x17,
-	0x00, 0x00,
+func (m *QueryFuryaWithdrawAddressRequest) Reset()         { *m = QueryFuryaWithdrawAddressRequest{} }
+func (m *QueryFuryaWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryFuryaWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{23}
+}
+func (m *QueryFuryaWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFuryaWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryFuryaWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaWithdrawAddressRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFuryaWithdrawAddressRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query paginated furyas
-	Furyas(ctx context.Context, in *QueryFuryasRequest, opts ...grpc.CallOption) (*QueryFuryasResponse, error)
-	// Query a specific furya by ibc hash
-	IBCFurya(ctx context.Context, in *QueryIBCFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error)
-	// Query all paginated furya delegations
-	AllFuryasDelegations(ctx context.Context, in *QueryAllFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
-	// Query furya validator
-	FuryaValidator(ctx context.Context, in *QueryFuryaValidatorRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorResponse, error)
-	// Query all paginated furya validators
-	AllFuryaValidators(ctx context.Context, in *QueryAllFuryaValidatorsRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorsResponse, error)
-	// Query all paginated furya delegations for a delegator addr
-	FuryasDelegation(ctx context.Context, in *QueryFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
-	// Query all paginated furya delegations for a delegator addr and validator_addr
-	FuryasDelegationByValidator(ctx context.Context, in *QueryFuryasDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
-	// Query a delegation to an furya by delegator addr, validator_addr and denom
-	FuryaDelegation(ctx context.Context, in *QueryFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error)
-	// Query a delegation to an furya by delegator addr, validator_addr and denom
-	IBCFuryaDelegation(ctx context.Context, in *QueryIBCFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	FuryaDelegationRewards(ctx context.Context, in *QueryFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	IBCFuryaDelegationRewards(ctx context.Context, in *QueryIBCFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error)
-	// Query a specific furya by denom
-	Furya(ctx context.Context, in *QueryFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error)
+type QueryFuryaWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFuryaWithdrawAddressResponse) Reset()         { *m = QueryFuryaWithdrawAddressResponse{} }
+func (m *QueryFuryaWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryFuryaWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{24}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFuryaWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Furyas(ctx context.Context, in *QueryFuryasRequest, opts ...grpc.CallOption) (*QueryFuryasResponse, error) {
-	out := new(QueryFuryasResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/Furyas", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFuryaWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryFuryaWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IBCFurya(ctx context.Context, in *QueryIBCFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error) {
-	out := new(QueryFuryaResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFurya", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryFuryaWithdrawAddressResponse proto.InternalMessageInfo
+
+type QueryFuryaPowerClampsRequest struct {
 }
 
-func (c *queryClient) AllFuryasDelegations(ctx context.Context, in *QueryAllFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
-	out := new(QueryFuryasDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/AllFuryasDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaPowerClampsRequest) Reset()         { *m = QueryFuryaPowerClampsRequest{} }
+func (m *QueryFuryaPowerClampsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaPowerClampsRequest) ProtoMessage()    {}
+func (*QueryFuryaPowerClampsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{25}
+}
+func (m *QueryFuryaPowerClampsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaPowerClampsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaPowerClampsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFuryaPowerClampsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaPowerClampsRequest.Merge(m, src)
+}
+func (m *QueryFuryaPowerClampsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaPowerClampsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaPowerClampsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) FuryaValidator(ctx context.Context, in *QueryFuryaValidatorRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorResponse, error) {
-	out := new(QueryFuryaValidatorResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryFuryaPowerClampsRequest proto.InternalMessageInfo
+
+type QueryFuryaPowerClampsResponse struct {
+	Clamps []FuryaPowerClamp `protobuf:"bytes,1,rep,name=clamps,proto3" json:"clamps"`
 }
 
-func (c *queryClient) AllFuryaValidators(ctx context.Context, in *QueryAllFuryaValidatorsRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorsResponse, error) {
-	out := new(QueryFuryaValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/AllFuryaValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaPowerClampsResponse) Reset()         { *m = QueryFuryaPowerClampsResponse{} }
+func (m *QueryFuryaPowerClampsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaPowerClampsResponse) ProtoMessage()    {}
+func (*QueryFuryaPowerClampsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{26}
+}
+func (m *QueryFuryaPowerClampsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaPowerClampsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaPowerClampsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFuryaPowerClampsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaPowerClampsResponse.Merge(m, src)
+}
+func (m *QueryFuryaPowerClampsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaPowerClampsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaPowerClampsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FuryasDelegation(ctx context.Context, in *QueryFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
-	out := new(QueryFuryasDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryasDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaPowerClampsResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaPowerClampsResponse) GetClamps() []FuryaPowerClamp {
+	if m != nil {
+		return m.Clamps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FuryasDelegationByValidator(ctx context.Context, in *QueryFuryasDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
-	out := new(QueryFuryasDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryasDelegationByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type FuryaPowerClamp struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Amount of stake that the furya assets delegated to the validator are worth without limits
+	ExpectedBondAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=expected_bond_amount,json=expectedBondAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expected_bond_amount"`
+	// Amount of stake that is bonded to the validator after applying the limits
+	BondAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bond_amount,json=bondAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bond_amount"`
+	// Amount of stake removed by the limits
+	ClampedAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=clamped_amount,json=clampedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"clamped_amount"`
 }
 
-func (c *queryClient) FuryaDelegation(ctx context.Context, in *QueryFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error) {
-	out := new(QueryFuryaDelegationResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FuryaPowerClamp) Reset()         { *m = FuryaPowerClamp{} }
+func (m *FuryaPowerClamp) String() string { return proto.CompactTextString(m) }
+func (*FuryaPowerClamp) ProtoMessage()    {}
+func (*FuryaPowerClamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{27}
+}
+func (m *FuryaPowerClamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaPowerClamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaPowerClamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *FuryaPowerClamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaPowerClamp.Merge(m, src)
+}
+func (m *FuryaPowerClamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaPowerClamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaPowerClamp.DiscardUnknown(m)
 }
 
-func (c *queryClient) IBCFuryaDelegation(ctx context.Context, in *QueryIBCFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error) {
-	out := new(QueryFuryaDelegationResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFuryaDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FuryaPowerClamp proto.InternalMessageInfo
+
+func (m *FuryaPowerClamp) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FuryaDelegationRewards(ctx context.Context, in *QueryFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error) {
-	out := new(QueryFuryaDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryFuryaSnapshotCountsRequest struct {
 }
 
-func (c *queryClient) IBCFuryaDelegationRewards(ctx context.Context, in *QueryIBCFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error) {
-	out := new(QueryFuryaDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFuryaDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFuryaSnapshotCountsRequest) Reset()         { *m = QueryFuryaSnapshotCountsRequest{} }
+func (m *QueryFuryaSnapshotCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaSnapshotCountsRequest) ProtoMessage()    {}
+func (*QueryFuryaSnapshotCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{28}
 }
-
-func (c *queryClient) Furya(ctx context.Context, in *QueryFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error) {
-	out := new(QueryFuryaResponse)
-	err := c.cc.Invoke(ctx, "/furya.furya.Query/Furya", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaSnapshotCountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaSnapshotCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaSnapshotCountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query paginated furyas
-	Furyas(context.Context, *QueryFuryasRequest) (*QueryFuryasResponse, error)
-	// Query a specific furya by ibc hash
-	IBCFurya(context.Context, *QueryIBCFuryaRequest) (*QueryFuryaResponse, error)
-	// Query all paginated furya delegations
-	AllFuryasDelegations(context.Context, *QueryAllFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error)
-	// Query furya validator
-	FuryaValidator(context.Context, *QueryFuryaValidatorRequest) (*QueryFuryaValidatorResponse, error)
-	// Query all paginated furya validators
-	AllFuryaValidators(context.Context, *QueryAllFuryaValidatorsRequest) (*QueryFuryaValidatorsResponse, error)
-	// Query all paginated furya delegations for a delegator addr
-	FuryasDelegation(context.Context, *QueryFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error)
-	// Query all paginated furya delegations for a delegator addr and validator_addr
-	FuryasDelegationByValidator(context.Context, *QueryFuryasDelegationByValidatorRequest) (*QueryFuryasDelegationsResponse, error)
-	// Query a delegation to an furya by delegator addr, validator_addr and denom
-	FuryaDelegation(context.Context, *QueryFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error)
-	// Query a delegation to an furya by delegator addr, validator_addr and denom
-	IBCFuryaDelegation(context.Context, *QueryIBCFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	FuryaDelegationRewards(context.Context, *QueryFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	IBCFuryaDelegationRewards(context.Context, *QueryIBCFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error)
-	// Query a specific furya by denom
-	Furya(context.Context, *QueryFuryaRequest) (*QueryFuryaResponse, error)
+func (m *QueryFuryaSnapshotCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaSnapshotCountsRequest.Merge(m, src)
+}
+func (m *QueryFuryaSnapshotCountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaSnapshotCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaSnapshotCountsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryFuryaSnapshotCountsRequest proto.InternalMessageInfo
+
+type QueryFuryaSnapshotCountsResponse struct {
+	Counts []FuryaSnapshotCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts"`
+	Total  uint64               `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) Reset()         { *m = QueryFuryaSnapshotCountsResponse{} }
+func (m *QueryFuryaSnapshotCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaSnapshotCountsResponse) ProtoMessage()    {}
+func (*QueryFuryaSnapshotCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{29}
 }
-func (*UnimplementedQueryServer) Furyas(ctx context.Context, req *QueryFuryasRequest) (*QueryFuryasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Furyas not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IBCFurya(ctx context.Context, req *QueryIBCFuryaRequest) (*QueryFuryaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCFurya not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaSnapshotCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllFuryasDelegations(ctx context.Context, req *QueryAllFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllFuryasDelegations not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaSnapshotCountsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FuryaValidator(ctx context.Context, req *QueryFuryaValidatorRequest) (*QueryFuryaValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FuryaValidator not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllFuryaValidators(ctx context.Context, req *QueryAllFuryaValidatorsRequest) (*QueryFuryaValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllFuryaValidators not implemented")
+func (m *QueryFuryaSnapshotCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaSnapshotCountsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) FuryasDelegation(ctx context.Context, req *QueryFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FuryasDelegation not implemented")
+
+var xxx_messageInfo_QueryFuryaSnapshotCountsResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaSnapshotCountsResponse) GetCounts() []FuryaSnapshotCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) FuryasDelegationByValidator(ctx context.Context, req *QueryFuryasDelegationByValidatorRequest) (*QueryFuryasDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FuryasDelegationByValidator not implemented")
+
+func (m *QueryFuryaSnapshotCountsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) FuryaDelegation(ctx context.Context, req *QueryFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FuryaDelegation not implemented")
+
+type FuryaSnapshotCount struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Count            uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Lowest last reward claim height of the delegations, snapshots below it are garbage collected
+	OldestClaimHeight uint64 `protobuf:"varint,4,opt,name=oldest_claim_height,json=oldestClaimHeight,proto3" json:"oldest_claim_height,omitempty"`
 }
-func (*UnimplementedQueryServer) IBCFuryaDelegation(ctx context.Context, req *QueryIBCFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCFuryaDelegation not implemented")
+
+func (m *FuryaSnapshotCount) Reset()         { *m = FuryaSnapshotCount{} }
+func (m *FuryaSnapshotCount) String() string { return proto.CompactTextString(m) }
+func (*FuryaSnapshotCount) ProtoMessage()    {}
+func (*FuryaSnapshotCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{30}
 }
-func (*UnimplementedQueryServer) FuryaDelegationRewards(ctx context.Context, req *QueryFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FuryaDelegationRewards not implemented")
+func (m *FuryaSnapshotCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IBCFuryaDelegationRewards(ctx context.Context, req *QueryIBCFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCFuryaDelegationRewards not implemented")
+func (m *FuryaSnapshotCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaSnapshotCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Furya(ctx context.Context, req *QueryFuryaRequest) (*QueryFuryaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Furya not implemented")
+func (m *FuryaSnapshotCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaSnapshotCount.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *FuryaSnapshotCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaSnapshotCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaSnapshotCount.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_FuryaSnapshotCount proto.InternalMessageInfo
+
+func (m *FuryaSnapshotCount) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Furyas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryasRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Furyas(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/Furyas",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Furyas(ctx, req.(*QueryFuryasRequest))
+func (m *FuryaSnapshotCount) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IBCFurya_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCFuryaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCFurya(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/IBCFurya",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCFurya(ctx, req.(*QueryIBCFuryaRequest))
+func (m *FuryaSnapshotCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_AllFuryasDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllFuryasDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllFuryasDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/AllFuryasDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllFuryasDelegations(ctx, req.(*QueryAllFuryasDelegationsRequest))
+func (m *FuryaSnapshotCount) GetOldestClaimHeight() uint64 {
+	if m != nil {
+		return m.OldestClaimHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_FuryaValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryaValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FuryaValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/FuryaValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FuryaValidator(ctx, req.(*QueryFuryaValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryFuryaAPRRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Validator to estimate the rewards for. The estimate is weighted across all validators if empty.
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func _Query_AllFuryaValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllFuryaValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllFuryaValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/AllFuryaValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllFuryaValidators(ctx, req.(*QueryAllFuryaValidatorsRequest))
+func (m *QueryFuryaAPRRequest) Reset()         { *m = QueryFuryaAPRRequest{} }
+func (m *QueryFuryaAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaAPRRequest) ProtoMessage()    {}
+func (*QueryFuryaAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{31}
+}
+func (m *QueryFuryaAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFuryaAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaAPRRequest.Merge(m, src)
+}
+func (m *QueryFuryaAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaAPRRequest.DiscardUnknown(m)
 }
 
-func _Query_FuryasDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryasDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FuryasDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/FuryasDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FuryasDelegation(ctx, req.(*QueryFuryasDelegationsRequest))
+var xxx_messageInfo_QueryFuryaAPRRequest proto.InternalMessageInfo
+
+func (m *QueryFuryaAPRRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FuryasDelegationByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryasDelegationByValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryFuryaAPRRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FuryasDelegationByValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/FuryasDelegationByValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FuryasDelegationByValidator(ctx, req.(*QueryFuryasDelegationByValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FuryaDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryaDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FuryaDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/FuryaDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FuryaDelegation(ctx, req.(*QueryFuryaDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryFuryaAPRResponse struct {
+	// Estimated rewards per year and token of the asset, net of the take rate and of the furya commissions
+	RewardsApr github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards_apr,json=rewardsApr,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_apr"`
+	// Share of the asset that is deducted by the take rate per year
+	TakeRateApr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=take_rate_apr,json=takeRateApr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate_apr"`
+	Validators  []FuryaValidatorAPR                    `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
 }
 
-func _Query_IBCFuryaDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCFuryaDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCFuryaDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/IBCFuryaDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCFuryaDelegation(ctx, req.(*QueryIBCFuryaDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryFuryaAPRResponse) Reset()         { *m = QueryFuryaAPRResponse{} }
+func (m *QueryFuryaAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaAPRResponse) ProtoMessage()    {}
+func (*QueryFuryaAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{32}
 }
-
-func _Query_FuryaDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryaDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FuryaDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/FuryaDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FuryaDelegationRewards(ctx, req.(*QueryFuryaDelegationRewardsRequest))
+func (m *QueryFuryaAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFuryaAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaAPRResponse.Merge(m, src)
+}
+func (m *QueryFuryaAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaAPRResponse.DiscardUnknown(m)
 }
 
-func _Query_IBCFuryaDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCFuryaDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCFuryaDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/IBCFuryaDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCFuryaDelegationRewards(ctx, req.(*QueryIBCFuryaDelegationRewardsRequest))
+var xxx_messageInfo_QueryFuryaAPRResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaAPRResponse) GetRewardsApr() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsApr
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Furya_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFuryaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Furya(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.furya.Query/Furya",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Furya(ctx, req.(*QueryFuryaRequest))
+func (m *QueryFuryaAPRResponse) GetValidators() []FuryaValidatorAPR {
+	if m != nil {
+		return m.Validators
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "furya.furya.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Furyas",
-			Handler:    _Query_Furyas_Handler,
-		},
-		{
-			MethodName: "IBCFurya",
-			Handler:    _Query_IBCFurya_Handler,
-		},
-		{
-			MethodName: "AllFuryasDelegations",
-			Handler:    _Query_AllFuryasDelegations_Handler,
-		},
-		{
-			MethodName: "FuryaValidator",
-			Handler:    _Query_FuryaValidator_Handler,
-		},
-		{
-			MethodName: "AllFuryaValidators",
-			Handler:    _Query_AllFuryaValidators_Handler,
-		},
-		{
-			MethodName: "FuryasDelegation",
-			Handler:    _Query_FuryasDelegation_Handler,
-		},
-		{
-			MethodName: "FuryasDelegationByValidator",
-			Handler:    _Query_FuryasDelegationByValidator_Handler,
-		},
-		{
-			MethodName: "FuryaDelegation",
-			Handler:    _Query_FuryaDelegation_Handler,
-		},
-		{
-			MethodName: "IBCFuryaDelegation",
-			Handler:    _Query_IBCFuryaDelegation_Handler,
-		},
-		{
-			MethodName: "FuryaDelegationRewards",
-			Handler:    _Query_FuryaDelegationRewards_Handler,
-		},
-		{
-			MethodName: "IBCFuryaDelegationRewards",
-			Handler:    _Query_IBCFuryaDelegationRewards_Handler,
-		},
-		{
-			MethodName: "Furya",
-			Handler:    _Query_Furya_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "furya/query.proto",
+type FuryaValidatorAPR struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Estimated rewards per year and token of the asset delegated to the validator, net of the take rate and of the
+	// furya commission of the validator
+	RewardsApr github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards_apr,json=rewardsApr,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_apr"`
+	// Furya commission rate of the validator. Reward indices are sampled after the commission is taken.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// Time span covered by the reward index samples
+	SampleDuration time.Duration `protobuf:"bytes,4,opt,name=sample_duration,json=sampleDuration,proto3,stdduration" json:"sample_duration"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FuryaValidatorAPR) Reset()         { *m = FuryaValidatorAPR{} }
+func (m *FuryaValidatorAPR) String() string { return proto.CompactTextString(m) }
+func (*FuryaValidatorAPR) ProtoMessage()    {}
+func (*FuryaValidatorAPR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{33}
+}
+func (m *FuryaValidatorAPR) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FuryaValidatorAPR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FuryaValidatorAPR.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *FuryaValidatorAPR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FuryaValidatorAPR.Merge(m, src)
+}
+func (m *FuryaValidatorAPR) XXX_Size() int {
+	return m.Size()
+}
+func (m *FuryaValidatorAPR) XXX_DiscardUnknown() {
+	xxx_messageInfo_FuryaValidatorAPR.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_FuryaValidatorAPR proto.InternalMessageInfo
+
+func (m *FuryaValidatorAPR) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *FuryaValidatorAPR) GetRewardsApr() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsApr
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FuryaValidatorAPR) GetSampleDuration() time.Duration {
+	if m != nil {
+		return m.SampleDuration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaPricesRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFuryaPricesRequest) Reset()         { *m = QueryFuryaPricesRequest{} }
+func (m *QueryFuryaPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaPricesRequest) ProtoMessage()    {}
+func (*QueryFuryaPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{34}
+}
+func (m *QueryFuryaPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFuryasRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFuryaPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaPricesRequest.Merge(m, src)
+}
+func (m *QueryFuryaPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaPricesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFuryasRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFuryaPricesRequest proto.InternalMessageInfo
+
+type QueryFuryaPricesResponse struct {
+	Prices []FuryaPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
 }
 
-func (m *QueryFuryasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaPricesResponse) Reset()         { *m = QueryFuryaPricesResponse{} }
+func (m *QueryFuryaPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaPricesResponse) ProtoMessage()    {}
+func (*QueryFuryaPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{35}
+}
+func (m *QueryFuryaPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFuryaPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaPricesResponse.Merge(m, src)
+}
+func (m *QueryFuryaPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFuryasResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaPricesResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaPricesResponse) GetPrices() []FuryaPrice {
+	if m != nil {
+		return m.Prices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFuryasResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaIncentivesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFuryasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Furyas) > 0 {
-		for iNdEx := len(m.Furyas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Furyas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFuryaIncentivesRequest) Reset()         { *m = QueryFuryaIncentivesRequest{} }
+func (m *QueryFuryaIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaIncentivesRequest) ProtoMessage()    {}
+func (*QueryFuryaIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{36}
+}
+func (m *QueryFuryaIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFuryaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFuryaIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaIncentivesRequest.Merge(m, src)
 }
-
-func (m *QueryFuryaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFuryaIncentivesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFuryaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFuryaIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaIncentivesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFuryaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryFuryaIncentivesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFuryaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaIncentivesResponse struct {
+	Incentives []FuryaIncentive    `protobuf:"bytes,1,rep,name=incentives,proto3" json:"incentives"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFuryaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Furya != nil {
-		{
-			size, err := m.Furya.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaIncentivesResponse) Reset()         { *m = QueryFuryaIncentivesResponse{} }
+func (m *QueryFuryaIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaIncentivesResponse) ProtoMessage()    {}
+func (*QueryFuryaIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{37}
+}
+func (m *QueryFuryaIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFuryaIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaIncentivesResponse.Merge(m, src)
+}
+func (m *QueryFuryaIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaIncentivesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIBCFuryaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaIncentivesResponse) GetIncentives() []FuryaIncentive {
+	if m != nil {
+		return m.Incentives
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIBCFuryaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFuryaIncentivesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIBCFuryaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryFuryaTakeRateRevenueRequest struct {
 }
 
-func (m *QueryFuryaValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaTakeRateRevenueRequest) Reset()         { *m = QueryFuryaTakeRateRevenueRequest{} }
+func (m *QueryFuryaTakeRateRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaTakeRateRevenueRequest) ProtoMessage()    {}
+func (*QueryFuryaTakeRateRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{38}
+}
+func (m *QueryFuryaTakeRateRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaTakeRateRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaTakeRateRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFuryaTakeRateRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaTakeRateRevenueRequest.Merge(m, src)
+}
+func (m *QueryFuryaTakeRateRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaTakeRateRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaTakeRateRevenueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFuryaValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFuryaTakeRateRevenueRequest proto.InternalMessageInfo
+
+type QueryFuryaTakeRateRevenueResponse struct {
+	Revenues github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=revenues,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenues"`
 }
 
-func (m *QueryFuryaValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFuryaTakeRateRevenueResponse) Reset()         { *m = QueryFuryaTakeRateRevenueResponse{} }
+func (m *QueryFuryaTakeRateRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaTakeRateRevenueResponse) ProtoMessage()    {}
+func (*QueryFuryaTakeRateRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{39}
+}
+func (m *QueryFuryaTakeRateRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaTakeRateRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaTakeRateRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFuryaTakeRateRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaTakeRateRevenueResponse.Merge(m, src)
+}
+func (m *QueryFuryaTakeRateRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaTakeRateRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaTakeRateRevenueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllFuryaValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaTakeRateRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaTakeRateRevenueResponse) GetRevenues() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenues
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllFuryaValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaUnallocatedRewardsRequest struct {
 }
 
-func (m *QueryAllFuryaValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaUnallocatedRewardsRequest) Reset()         { *m = QueryFuryaUnallocatedRewardsRequest{} }
+func (m *QueryFuryaUnallocatedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaUnallocatedRewardsRequest) ProtoMessage()    {}
+func (*QueryFuryaUnallocatedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{40}
+}
+func (m *QueryFuryaUnallocatedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaUnallocatedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaUnallocatedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllFuryasDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFuryaUnallocatedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaUnallocatedRewardsRequest.Merge(m, src)
+}
+func (m *QueryFuryaUnallocatedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaUnallocatedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaUnallocatedRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllFuryasDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFuryaUnallocatedRewardsRequest proto.InternalMessageInfo
+
+type QueryFuryaUnallocatedRewardsResponse struct {
+	UnallocatedRewards []ValidatorUnallocatedRewards `protobuf:"bytes,1,rep,name=unallocated_rewards,json=unallocatedRewards,proto3" json:"unallocated_rewards"`
 }
 
-func (m *QueryAllFuryasDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaUnallocatedRewardsResponse) Reset()         { *m = QueryFuryaUnallocatedRewardsResponse{} }
+func (m *QueryFuryaUnallocatedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaUnallocatedRewardsResponse) ProtoMessage()    {}
+func (*QueryFuryaUnallocatedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{41}
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaUnallocatedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaUnallocatedRewardsResponse.Merge(m, src)
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaUnallocatedRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFuryasDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFuryaUnallocatedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaUnallocatedRewardsResponse) GetUnallocatedRewards() []ValidatorUnallocatedRewards {
+	if m != nil {
+		return m.UnallocatedRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFuryasDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorUnallocatedRewards struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *QueryFuryasDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ValidatorUnallocatedRewards) Reset()         { *m = ValidatorUnallocatedRewards{} }
+func (m *ValidatorUnallocatedRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUnallocatedRewards) ProtoMessage()    {}
+func (*ValidatorUnallocatedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{42}
+}
+func (m *ValidatorUnallocatedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUnallocatedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUnallocatedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorUnallocatedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUnallocatedRewards.Merge(m, src)
+}
+func (m *ValidatorUnallocatedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUnallocatedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUnallocatedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUnallocatedRewards proto.InternalMessageInfo
+
+func (m *ValidatorUnallocatedRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFuryasDelegationByValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorUnallocatedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFuryasDelegationByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaRewardDustRequest struct {
 }
 
-func (m *QueryFuryasDelegationByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaRewardDustRequest) Reset()         { *m = QueryFuryaRewardDustRequest{} }
+func (m *QueryFuryaRewardDustRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaRewardDustRequest) ProtoMessage()    {}
+func (*QueryFuryaRewardDustRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{43}
+}
+func (m *QueryFuryaRewardDustRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaRewardDustRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaRewardDustRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFuryaRewardDustRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaRewardDustRequest.Merge(m, src)
+}
+func (m *QueryFuryaRewardDustRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaRewardDustRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaRewardDustRequest.DiscardUnknown(m)
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFuryaRewardDustRequest proto.InternalMessageInfo
+
+type QueryFuryaRewardDustResponse struct {
+	RewardDust RewardDust `protobuf:"bytes,1,opt,name=reward_dust,json=rewardDust,proto3" json:"reward_dust"`
+	// Balance of the rewards pool that is not owed to delegators, token holders, validators or incentive funders
+	Excess github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=excess,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"excess"`
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFuryaRewardDustResponse) Reset()         { *m = QueryFuryaRewardDustResponse{} }
+func (m *QueryFuryaRewardDustResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaRewardDustResponse) ProtoMessage()    {}
+func (*QueryFuryaRewardDustResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{44}
+}
+func (m *QueryFuryaRewardDustResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaRewardDustResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaRewardDustResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryFuryaRewardDustResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaRewardDustResponse.Merge(m, src)
+}
+func (m *QueryFuryaRewardDustResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaRewardDustResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaRewardDustResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFuryaRewardDustResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaRewardDustResponse) GetRewardDust() RewardDust {
+	if m != nil {
+		return m.RewardDust
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return RewardDust{}
 }
 
-func (m *QueryFuryasDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaRewardDustResponse) GetExcess() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Excess
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFuryasDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFuryaSlashesRequest struct {
+	// Optional filters, empty values match every slash
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// Matches slashes of the validators the delegator delegates to and slashes of the delegator's redelegations and undelegations
+	DelegatorAddr string             `protobuf:"bytes,2,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Denom         string             `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFuryasDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFuryaSlashesRequest) Reset()         { *m = QueryFuryaSlashesRequest{} }
+func (m *QueryFuryaSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaSlashesRequest) ProtoMessage()    {}
+func (*QueryFuryaSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{45}
+}
+func (m *QueryFuryaSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFuryaDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFuryaSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaSlashesRequest.Merge(m, src)
+}
+func (m *QueryFuryaSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaSlashesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFuryaDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFuryaSlashesRequest proto.InternalMessageInfo
+
+type QueryFuryaSlashesResponse struct {
+	Slashes    []FuryaSlash        `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFuryaDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFuryaSlashesResponse) Reset()         { *m = QueryFuryaSlashesResponse{} }
+func (m *QueryFuryaSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFuryaSlashesResponse) ProtoMessage()    {}
+func (*QueryFuryaSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29991d92828164be, []int{46}
+}
+func (m *QueryFuryaSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFuryaSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFuryaSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryFuryaSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFuryaSlashesResponse.Merge(m, src)
+}
+func (m *QueryFuryaSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFuryaSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFuryaSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFuryaSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryFuryaSlashesResponse) GetSlashes() []FuryaSlash {
+	if m != nil {
+		return m.Slashes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryIBCFuryaDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFuryaSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIBCFuryaDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "furya.furya.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "furya.furya.QueryParamsResponse")
+	proto.RegisterType((*QueryFuryasRequest)(nil), "furya.furya.QueryFuryasRequest")
+	proto.RegisterType((*QueryFuryasResponse)(nil), "furya.furya.QueryFuryasResponse")
+	proto.RegisterType((*QueryFuryaRequest)(nil), "furya.furya.QueryFuryaRequest")
+	proto.RegisterType((*QueryFuryaResponse)(nil), "furya.furya.QueryFuryaResponse")
+	proto.RegisterType((*ProjectedRewardWeight)(nil), "furya.furya.ProjectedRewardWeight")
+	proto.RegisterType((*QueryIBCFuryaRequest)(nil), "furya.furya.QueryIBCFuryaRequest")
+	proto.RegisterType((*QueryFuryaValidatorRequest)(nil), "furya.furya.QueryFuryaValidatorRequest")
+	proto.RegisterType((*QueryAllFuryaValidatorsRequest)(nil), "furya.furya.QueryAllFuryaValidatorsRequest")
+	proto.RegisterType((*QueryAllFuryasDelegationsRequest)(nil), "furya.furya.QueryAllFuryasDelegationsRequest")
+	proto.RegisterType((*QueryFuryasDelegationsRequest)(nil), "furya.furya.QueryFuryasDelegationsRequest")
+	proto.RegisterType((*QueryFuryasDelegationByValidatorRequest)(nil), "furya.furya.QueryFuryasDelegationByValidatorRequest")
+	proto.RegisterType((*DelegationResponse)(nil), "furya.furya.DelegationResponse")
+	proto.RegisterType((*QueryFuryasDelegationsResponse)(nil), "furya.furya.QueryFuryasDelegationsResponse")
+	proto.RegisterType((*QueryFuryaDelegationRequest)(nil), "furya.furya.QueryFuryaDelegationRequest")
+	proto.RegisterType((*QueryIBCFuryaDelegationRequest)(nil), "furya.furya.QueryIBCFuryaDelegationRequest")
+	proto.RegisterType((*QueryFuryaDelegationResponse)(nil), "furya.furya.QueryFuryaDelegationResponse")
+	proto.RegisterType((*QueryFuryaDelegationRewardsRequest)(nil), "furya.furya.QueryFuryaDelegationRewardsRequest")
+	proto.RegisterType((*QueryIBCFuryaDelegationRewardsRequest)(nil), "furya.furya.QueryIBCFuryaDelegationRewardsRequest")
+	proto.RegisterType((*QueryFuryaDelegationRewardsResponse)(nil), "furya.furya.QueryFuryaDelegationRewardsResponse")
+	proto.RegisterType((*QueryFuryaValidatorResponse)(nil), "furya.furya.QueryFuryaValidatorResponse")
+	proto.RegisterType((*QueryFuryaValidatorsResponse)(nil), "furya.furya.QueryFuryaValidatorsResponse")
+	proto.RegisterType((*QueryFuryaWithdrawAddressRequest)(nil), "furya.furya.QueryFuryaWithdrawAddressRequest")
+	proto.RegisterType((*QueryFuryaWithdrawAddressResponse)(nil), "furya.furya.QueryFuryaWithdrawAddressResponse")
+	proto.RegisterType((*QueryFuryaPowerClampsRequest)(nil), "furya.furya.QueryFuryaPowerClampsRequest")
+	proto.RegisterType((*QueryFuryaPowerClampsResponse)(nil), "furya.furya.QueryFuryaPowerClampsResponse")
+	proto.RegisterType((*FuryaPowerClamp)(nil), "furya.furya.FuryaPowerClamp")
+	proto.RegisterType((*QueryFuryaSnapshotCountsRequest)(nil), "furya.furya.QueryFuryaSnapshotCountsRequest")
+	proto.RegisterType((*QueryFuryaSnapshotCountsResponse)(nil), "furya.furya.QueryFuryaSnapshotCountsResponse")
+	proto.RegisterType((*FuryaSnapshotCount)(nil), "furya.furya.FuryaSnapshotCount")
+	proto.RegisterType((*QueryFuryaAPRRequest)(nil), "furya.furya.QueryFuryaAPRRequest")
+	proto.RegisterType((*QueryFuryaAPRResponse)(nil), "furya.furya.QueryFuryaAPRResponse")
+	proto.RegisterType((*FuryaValidatorAPR)(nil), "furya.furya.FuryaValidatorAPR")
+	proto.RegisterType((*QueryFuryaPricesRequest)(nil), "furya.furya.QueryFuryaPricesRequest")
+	proto.RegisterType((*QueryFuryaPricesResponse)(nil), "furya.furya.QueryFuryaPricesResponse")
+	proto.RegisterType((*QueryFuryaIncentivesRequest)(nil), "furya.furya.QueryFuryaIncentivesRequest")
+	proto.RegisterType((*QueryFuryaIncentivesResponse)(nil), "furya.furya.QueryFuryaIncentivesResponse")
+	proto.RegisterType((*QueryFuryaTakeRateRevenueRequest)(nil), "furya.furya.QueryFuryaTakeRateRevenueRequest")
+	proto.RegisterType((*QueryFuryaTakeRateRevenueResponse)(nil), "furya.furya.QueryFuryaTakeRateRevenueResponse")
+	proto.RegisterType((*QueryFuryaUnallocatedRewardsRequest)(nil), "furya.furya.QueryFuryaUnallocatedRewardsRequest")
+	proto.RegisterType((*QueryFuryaUnallocatedRewardsResponse)(nil), "furya.furya.QueryFuryaUnallocatedRewardsResponse")
+	proto.RegisterType((*ValidatorUnallocatedRewards)(nil), "furya.furya.ValidatorUnallocatedRewards")
+	proto.RegisterType((*QueryFuryaRewardDustRequest)(nil), "furya.furya.QueryFuryaRewardDustRequest")
+	proto.RegisterType((*QueryFuryaRewardDustResponse)(nil), "furya.furya.QueryFuryaRewardDustResponse")
+	proto.RegisterType((*QueryFuryaSlashesRequest)(nil), "furya.furya.QueryFuryaSlashesRequest")
+	proto.RegisterType((*QueryFuryaSlashesResponse)(nil), "furya.furya.QueryFuryaSlashesResponse")
+}
+
+func init() { proto.RegisterFile("furya/query.proto", fileDescriptor_29991d92828164be) }
+
+var fileDescriptor_29991d92828164be = []byte{
+	// 2430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xac, 0x37, 0x4e, 0x7a, 0x9c, 0xd8, 0xf1, 0xb5, 0x1d, 0xdb, 0x63, 0x7b, 0xd7, 0x9e,
+	0xd6, 0x71, 0x6c, 0xd7, 0xbb, 0xb5, 0x0b, 0x2a, 0x04, 0x15, 0xe4, 0x8f, 0x7c, 0x01, 0x2d, 0xee,
+	0xba, 0x25, 0x50, 0x90, 0x96, 0xd9, 0xd9, 0xeb, 0xdd, 0xa1, 0xbb, 0x33, 0xdb, 0xb9, 0xb3, 0x71,
+	0xac, 0x90, 0x97, 0xbe, 0x00, 0x12, 0xa0, 0x4a, 0x10, 0x54, 0x1e, 0x0a, 0x7d, 0x01, 0x89, 0x3e,
+	0x20, 0x01, 0xaf, 0x3c, 0x50, 0x01, 0x52, 0x78, 0x40, 0x8a, 0x54, 0x1e, 0x50, 0x2b, 0xb5, 0x28,
+	0xe1, 0x81, 0x3f, 0x03, 0xcd, 0xfd, 0x98, 0xb9, 0xb3, 0x33, 0xb3, 0x1e, 0x3b, 0xeb, 0x48, 0xbc,
+	0x24, 0xde, 0x7b, 0xcf, 0x39, 0xf7, 0x77, 0xce, 0xb9, 0xf7, 0xdc, 0x7b, 0x7e, 0x03, 0x23, 0x7b,
+	0x6d, 0xe7, 0x40, 0x2f, 0xbe, 0xd9, 0xc6, 0xce, 0x41, 0xa1, 0xe5, 0xd8, 0xae, 0x8d, 0x06, 0xe9,
+	0x50, 0x81, 0xfe, 0xab, 0x8e, 0xd5, 0xec, 0x9a, 0x4d, 0xc7, 0x8b, 0xde, 0x5f, 0x4c, 0x44, 0x9d,
+	0xa9, 0xd9, 0x76, 0xad, 0x81, 0x8b, 0x7a, 0xcb, 0x2c, 0xea, 0x96, 0x65, 0xbb, 0xba, 0x6b, 0xda,
+	0x16, 0xe1, 0xb3, 0x39, 0x3e, 0x4b, 0x7f, 0x55, 0xda, 0x7b, 0xc5, 0x6a, 0xdb, 0xa1, 0x02, 0x7c,
+	0x3e, 0xdf, 0x39, 0xef, 0x9a, 0x4d, 0x4c, 0x5c, 0xbd, 0xd9, 0xe2, 0x02, 0xcb, 0x86, 0x4d, 0x9a,
+	0x36, 0x29, 0x56, 0x74, 0x82, 0x19, 0xb4, 0xe2, 0xad, 0xb5, 0x0a, 0x76, 0xf5, 0xb5, 0x62, 0x4b,
+	0xaf, 0x99, 0x96, 0x6c, 0x0c, 0x31, 0x07, 0x5a, 0xba, 0xa3, 0x37, 0x05, 0x00, 0xee, 0x14, 0xfd,
+	0x57, 0x60, 0x92, 0x4d, 0x0a, 0x63, 0x86, 0x6d, 0x0a, 0x33, 0x13, 0x4c, 0xa5, 0x8a, 0x1b, 0xb8,
+	0x16, 0x72, 0x66, 0x9c, 0x4d, 0x98, 0x96, 0x81, 0x2d, 0xd7, 0xbc, 0x85, 0xc3, 0x4b, 0x90, 0x86,
+	0x4e, 0xea, 0x6c, 0x48, 0x1b, 0x03, 0xf4, 0x8a, 0x87, 0x75, 0x87, 0x42, 0x29, 0xe1, 0x37, 0xdb,
+	0x98, 0xb8, 0xda, 0x75, 0x18, 0x0d, 0x8d, 0x92, 0x96, 0x6d, 0x11, 0x8c, 0xd6, 0x60, 0x80, 0x41,
+	0x9e, 0x54, 0xe6, 0x94, 0x4b, 0x83, 0xeb, 0xa3, 0x05, 0x29, 0xea, 0x05, 0x26, 0xbc, 0x99, 0xbd,
+	0xff, 0x49, 0xbe, 0xaf, 0xc4, 0x05, 0xb5, 0x6f, 0x73, 0xfb, 0x57, 0x3d, 0x11, 0x61, 0x1f, 0x5d,
+	0x05, 0x08, 0x62, 0xc2, 0x8d, 0x5d, 0x2c, 0x30, 0x6f, 0x0b, 0x9e, 0xb7, 0x05, 0x96, 0x5b, 0xee,
+	0x73, 0x61, 0x47, 0xaf, 0x61, 0xae, 0x5b, 0x92, 0x34, 0xb5, 0x7b, 0x0a, 0x8c, 0x86, 0xcc, 0x73,
+	0xa0, 0x9f, 0x85, 0x01, 0x8a, 0xc9, 0x03, 0xda, 0x7f, 0x69, 0x70, 0x7d, 0x22, 0x04, 0x94, 0x0a,
+	0x6f, 0x10, 0x82, 0x5d, 0x01, 0x96, 0x09, 0xa3, 0x6b, 0x21, 0x58, 0x19, 0x0a, 0x6b, 0xf1, 0x50,
+	0x58, 0x6c, 0xcd, 0x10, 0xae, 0x25, 0x18, 0x09, 0x60, 0x09, 0xa7, 0xc7, 0xe0, 0x54, 0x15, 0x5b,
+	0x76, 0x93, 0xfa, 0xfb, 0x54, 0x89, 0xfd, 0xd0, 0xde, 0xca, 0xc8, 0x11, 0xf2, 0x3d, 0x58, 0x85,
+	0x53, 0x14, 0x14, 0x0f, 0x4e, 0x92, 0x03, 0x25, 0x26, 0x85, 0xbe, 0x09, 0xc8, 0xc1, 0x4d, 0xdd,
+	0xb4, 0x4c, 0xab, 0x56, 0x36, 0xf4, 0x96, 0x6e, 0x98, 0xee, 0x01, 0xf5, 0xe0, 0xa9, 0xcd, 0xe5,
+	0x8f, 0x3e, 0xc9, 0x5f, 0xac, 0x99, 0x6e, 0xbd, 0x5d, 0x29, 0x18, 0x76, 0xb3, 0xc8, 0x37, 0x15,
+	0xfb, 0x6f, 0x95, 0x54, 0xdf, 0x28, 0xba, 0x07, 0x2d, 0x4c, 0x0a, 0x37, 0x2c, 0xb7, 0x34, 0xe2,
+	0x5b, 0xd9, 0xe2, 0x46, 0x50, 0x05, 0x26, 0x5b, 0x8e, 0xfd, 0x5d, 0x6c, 0xb8, 0xb8, 0x5a, 0x76,
+	0xf0, 0xbe, 0xee, 0x54, 0xcb, 0xfb, 0xd8, 0xac, 0xd5, 0x5d, 0x32, 0xd9, 0x4f, 0xa3, 0xab, 0x85,
+	0xb7, 0x81, 0x10, 0x2e, 0x51, 0xd9, 0x9b, 0x54, 0x94, 0x07, 0xfa, 0x42, 0x2b, 0x6e, 0x92, 0x68,
+	0xbf, 0x51, 0x60, 0x3c, 0x56, 0x0f, 0x7d, 0x0e, 0xb2, 0xde, 0x41, 0xe3, 0x61, 0x50, 0x0b, 0xec,
+	0x14, 0x16, 0xc4, 0x29, 0x2c, 0xbc, 0x2a, 0x4e, 0xe1, 0xe6, 0x19, 0x6f, 0x85, 0xb7, 0x3f, 0xcd,
+	0x2b, 0x25, 0xaa, 0x81, 0x76, 0xe1, 0x5c, 0x08, 0x2d, 0x8f, 0x46, 0xc1, 0x13, 0x4b, 0x19, 0x91,
+	0x6d, 0x6c, 0x94, 0xce, 0x3a, 0x12, 0x1c, 0x6d, 0x19, 0xc6, 0x68, 0xb2, 0x6e, 0x6c, 0x6e, 0x85,
+	0x72, 0x8b, 0x20, 0x5b, 0xd7, 0x49, 0x9d, 0xa7, 0x96, 0xfe, 0xad, 0xbd, 0x04, 0x6a, 0x90, 0xd8,
+	0xaf, 0xeb, 0x0d, 0xb3, 0xaa, 0xbb, 0xb6, 0x23, 0x34, 0x16, 0x60, 0xe8, 0x96, 0x18, 0x2b, 0xeb,
+	0xd5, 0xaa, 0xc3, 0x75, 0xcf, 0xf9, 0xa3, 0x1b, 0xd5, 0xaa, 0x73, 0xf9, 0xcc, 0x0f, 0xde, 0xcb,
+	0xf7, 0xfd, 0xf7, 0xbd, 0x7c, 0x9f, 0xe6, 0x40, 0x8e, 0x9a, 0xdb, 0x68, 0x34, 0xc2, 0x16, 0x7b,
+	0x7d, 0xaa, 0xa4, 0x35, 0x5d, 0x98, 0x0b, 0xad, 0x49, 0xb6, 0x83, 0x52, 0x73, 0x72, 0xab, 0xbe,
+	0xa3, 0xc0, 0xac, 0x74, 0xaa, 0x63, 0xd6, 0x5c, 0x80, 0x21, 0x5e, 0xf4, 0x3a, 0x82, 0xe7, 0x8f,
+	0x7a, 0xc1, 0xeb, 0x80, 0x96, 0xe9, 0x01, 0xb4, 0xbf, 0x2b, 0xb0, 0x18, 0x0b, 0x6d, 0xf3, 0x20,
+	0x2e, 0xc3, 0x69, 0x40, 0x46, 0x37, 0x42, 0x26, 0x66, 0x23, 0x74, 0xf8, 0xd2, 0xdf, 0x03, 0x5f,
+	0x7e, 0xa6, 0x00, 0x0a, 0x1c, 0xf0, 0x2b, 0xcf, 0x8b, 0x00, 0xc1, 0x85, 0x12, 0x5b, 0x7e, 0x24,
+	0xaf, 0xd9, 0xb1, 0x96, 0x14, 0xd0, 0xe7, 0xe1, 0x74, 0x45, 0x6f, 0xe8, 0x96, 0x81, 0x79, 0xc0,
+	0xa7, 0x42, 0x20, 0x05, 0xbc, 0x2d, 0xdb, 0x14, 0xda, 0x42, 0xfe, 0x72, 0x96, 0xc2, 0xfa, 0x83,
+	0x02, 0xb9, 0xd8, 0x10, 0x07, 0xe5, 0xfd, 0x1a, 0x0c, 0x06, 0x2b, 0x8a, 0x1a, 0x9f, 0x4f, 0xc0,
+	0x28, 0xb4, 0xf8, 0x6a, 0xb2, 0x66, 0xef, 0x0a, 0xfe, 0x87, 0x0a, 0x4c, 0x07, 0xa0, 0xe5, 0xc5,
+	0x4f, 0x62, 0x2f, 0xf8, 0x37, 0x49, 0xbf, 0x74, 0x93, 0x74, 0xec, 0x90, 0x6c, 0x0f, 0x76, 0xc8,
+	0x3f, 0x45, 0x2a, 0x44, 0xb9, 0x3b, 0x69, 0xc7, 0x44, 0x19, 0xed, 0x0f, 0xca, 0xe8, 0x09, 0xb8,
+	0x85, 0x61, 0x26, 0x3e, 0x57, 0x7c, 0x7b, 0x5d, 0x89, 0x39, 0x01, 0x29, 0x77, 0x97, 0xa4, 0xa8,
+	0x7d, 0xa4, 0x80, 0x16, 0xbf, 0x8e, 0x77, 0xa1, 0x90, 0xff, 0xef, 0xad, 0xf1, 0xb1, 0x02, 0x0b,
+	0x89, 0x5b, 0xe3, 0x04, 0xfd, 0x7b, 0x32, 0x3b, 0xe4, 0x9e, 0x02, 0x4f, 0x77, 0x4d, 0x1d, 0xdf,
+	0x29, 0x55, 0x38, 0xcd, 0x9e, 0x07, 0xa2, 0x08, 0x75, 0x29, 0x76, 0x45, 0xfe, 0xf0, 0x58, 0x4c,
+	0xf1, 0xf0, 0xf0, 0x14, 0x4a, 0xc2, 0xb4, 0x84, 0xeb, 0xcf, 0x19, 0xb9, 0xcc, 0x48, 0x37, 0x0e,
+	0xc7, 0x93, 0xee, 0x51, 0x81, 0x5e, 0x87, 0x09, 0xd7, 0x76, 0xf5, 0x46, 0x39, 0xd8, 0xad, 0x65,
+	0x52, 0xd7, 0x1d, 0x4c, 0x26, 0x33, 0xd4, 0x8d, 0x99, 0x58, 0x37, 0xb6, 0xb1, 0x21, 0x95, 0xed,
+	0x71, 0x6a, 0x22, 0x88, 0xcd, 0x2e, 0x35, 0x80, 0x5e, 0x82, 0xf3, 0x01, 0x04, 0x6e, 0xb4, 0x3f,
+	0xb5, 0xd1, 0x61, 0x5f, 0x97, 0x9b, 0xbb, 0x02, 0x67, 0x19, 0x54, 0xe2, 0xea, 0x6f, 0xe0, 0xea,
+	0x64, 0x36, 0xb5, 0xa9, 0x41, 0xaa, 0xb7, 0x4b, 0xd5, 0xa4, 0x10, 0x7e, 0xa0, 0xc0, 0x4c, 0x4c,
+	0x08, 0x83, 0x9c, 0xbe, 0x0c, 0xe0, 0x83, 0x10, 0x69, 0xbd, 0x14, 0x3a, 0xfd, 0x5d, 0x32, 0x20,
+	0xca, 0x40, 0x60, 0xa1, 0x67, 0x77, 0x8c, 0xe4, 0xc3, 0x2e, 0xcc, 0x05, 0x18, 0x6e, 0x9a, 0x6e,
+	0xbd, 0xea, 0xe8, 0xfb, 0x5e, 0x66, 0x31, 0x39, 0xe2, 0xb1, 0x93, 0x8c, 0x7e, 0x03, 0xe6, 0xbb,
+	0x18, 0xe5, 0xc1, 0x59, 0x82, 0xf3, 0xfb, 0x7c, 0x8a, 0x1a, 0xc5, 0x84, 0x70, 0xbb, 0xc3, 0xfb,
+	0x61, 0x15, 0xc9, 0x72, 0x4e, 0x8e, 0xf8, 0x8e, 0xbd, 0x8f, 0x9d, 0xad, 0x86, 0xde, 0x6c, 0xf9,
+	0xdd, 0xe6, 0xb7, 0x60, 0x36, 0x61, 0x9e, 0xaf, 0x7a, 0x19, 0x06, 0x0c, 0x3a, 0xc2, 0xd3, 0x31,
+	0x13, 0xed, 0x86, 0x02, 0x35, 0xd1, 0xd3, 0x31, 0x0d, 0xed, 0x7e, 0x06, 0x86, 0x3b, 0x24, 0xd0,
+	0x0a, 0x8c, 0x84, 0x8f, 0x49, 0xe0, 0xc6, 0xf9, 0xd0, 0x49, 0xc1, 0x84, 0xa0, 0xef, 0xc0, 0x18,
+	0xbe, 0xdd, 0x62, 0xed, 0x4f, 0xc5, 0xb6, 0xaa, 0x65, 0xbd, 0x69, 0xb7, 0xad, 0xe3, 0xb6, 0x13,
+	0x48, 0xd8, 0xda, 0xb4, 0xad, 0xea, 0x06, 0xb5, 0x84, 0xbe, 0x06, 0x83, 0xb2, 0xe1, 0xfe, 0x63,
+	0x19, 0x86, 0x4a, 0x60, 0xf0, 0x35, 0x18, 0xa2, 0xde, 0x63, 0xdf, 0x66, 0xf6, 0x58, 0x36, 0xcf,
+	0x71, 0x2b, 0xcc, 0xac, 0x36, 0x0f, 0xf9, 0x20, 0x4f, 0xbb, 0x96, 0xde, 0x22, 0x75, 0xdb, 0xdd,
+	0xf2, 0xa6, 0xfc, 0x54, 0xee, 0xc3, 0x5c, 0xb2, 0x88, 0xff, 0xc0, 0x1c, 0x30, 0xe8, 0x48, 0xec,
+	0xc3, 0x2d, 0xaa, 0xe9, 0x27, 0x94, 0x2a, 0x79, 0x37, 0x1c, 0x3d, 0xd9, 0x34, 0x01, 0xd9, 0x12,
+	0xfb, 0xa1, 0xbd, 0xab, 0x00, 0x8a, 0xaa, 0xc6, 0xf7, 0xdc, 0xf1, 0xf9, 0xcf, 0x24, 0xe4, 0x7f,
+	0x0c, 0x4e, 0x19, 0x7e, 0x5e, 0xb2, 0x25, 0xf6, 0x03, 0x15, 0x60, 0xd4, 0x6e, 0x54, 0x31, 0x71,
+	0xcb, 0x46, 0x43, 0x37, 0x9b, 0xe5, 0x3a, 0xeb, 0x31, 0xb3, 0x54, 0x66, 0x84, 0x4d, 0x6d, 0x79,
+	0x33, 0xd7, 0xe9, 0x84, 0xb6, 0xcb, 0x1b, 0x47, 0xd6, 0xba, 0xef, 0x94, 0xba, 0x92, 0x02, 0x29,
+	0x2f, 0x43, 0xed, 0xd7, 0x19, 0x18, 0xef, 0xb0, 0xca, 0x63, 0xec, 0xc0, 0x20, 0xbf, 0x3d, 0xca,
+	0x7a, 0xcb, 0xf1, 0x8f, 0x4d, 0xb7, 0xaa, 0xf9, 0xbc, 0x17, 0xe5, 0xf7, 0x3f, 0xcd, 0xaf, 0xa4,
+	0xdb, 0x1c, 0x9e, 0x0e, 0x29, 0x01, 0x5f, 0x65, 0xa3, 0xe5, 0xa0, 0x12, 0x9c, 0xf3, 0x8a, 0x6d,
+	0xd9, 0xd1, 0x5d, 0x4c, 0x57, 0x3d, 0xde, 0x09, 0x19, 0xf4, 0x8c, 0x94, 0x74, 0x17, 0x7b, 0x36,
+	0xb7, 0x43, 0xc5, 0x98, 0xdd, 0x23, 0xb9, 0xe8, 0x7e, 0xf1, 0xeb, 0xf0, 0xc6, 0x4e, 0x29, 0x5a,
+	0x82, 0xb5, 0x8f, 0x33, 0x30, 0x12, 0x91, 0x3b, 0x5a, 0x15, 0xe8, 0x08, 0x68, 0xe6, 0x49, 0x04,
+	0xf4, 0x26, 0x0c, 0x1b, 0x76, 0xb3, 0x69, 0x12, 0xe2, 0x5d, 0xd0, 0x5e, 0x58, 0x8f, 0x59, 0x1b,
+	0x86, 0x02, 0x33, 0x5e, 0x60, 0xd1, 0x57, 0x61, 0x98, 0xe8, 0xcd, 0x56, 0x03, 0x97, 0x05, 0xc9,
+	0xc9, 0x5f, 0x4d, 0x53, 0x11, 0x7e, 0x65, 0x9b, 0x0b, 0x30, 0x7a, 0xe5, 0x1d, 0x8f, 0x5e, 0x19,
+	0x62, 0xba, 0x62, 0x46, 0x9b, 0x82, 0x09, 0xa9, 0x7c, 0x3b, 0xa6, 0x81, 0xfd, 0x72, 0xf0, 0x0a,
+	0x4c, 0x46, 0xa7, 0x02, 0x8e, 0xae, 0x45, 0x47, 0x92, 0x39, 0x3a, 0xaa, 0xe1, 0x13, 0x8a, 0x54,
+	0x58, 0xc3, 0xf2, 0x0b, 0xe8, 0x86, 0x20, 0x38, 0x7b, 0xce, 0x2c, 0xbe, 0x1f, 0x7a, 0x26, 0xc8,
+	0xeb, 0x70, 0xf8, 0x1b, 0x00, 0x3e, 0xbd, 0x2a, 0x5c, 0x98, 0x8e, 0xba, 0xe0, 0x6b, 0x8a, 0x6d,
+	0x19, 0x28, 0xf5, 0xae, 0xfb, 0xd4, 0xe4, 0xaa, 0xfb, 0x2a, 0x3f, 0x3e, 0x25, 0x7c, 0x0b, 0x5b,
+	0x6d, 0xe1, 0x9c, 0xf6, 0x23, 0x05, 0xe6, 0xbb, 0x08, 0x71, 0xaf, 0x6a, 0x70, 0xc6, 0x61, 0x43,
+	0x29, 0x5e, 0xb4, 0xcf, 0xf1, 0x0d, 0x7e, 0x29, 0xe5, 0x8b, 0x96, 0x94, 0x7c, 0xe3, 0xda, 0x82,
+	0xfc, 0xc0, 0x7e, 0xcd, 0xd2, 0x1b, 0x0d, 0xdb, 0xd0, 0x7d, 0xf2, 0xcf, 0xdf, 0x40, 0xdf, 0x57,
+	0xe0, 0x99, 0xee, 0x72, 0x1c, 0x78, 0x19, 0x46, 0xdb, 0xc1, 0x6c, 0x39, 0xfc, 0x2a, 0x0f, 0x3f,
+	0xdf, 0xfc, 0x22, 0x10, 0x35, 0xc7, 0x93, 0x84, 0xda, 0x91, 0x19, 0xed, 0xf7, 0x0a, 0x4c, 0x77,
+	0xd1, 0x3c, 0x5a, 0x35, 0xc1, 0x41, 0xdf, 0x90, 0xe9, 0x7d, 0x94, 0x85, 0x6d, 0x6d, 0x56, 0x3e,
+	0x2b, 0x0c, 0xe8, 0x76, 0x9b, 0xb8, 0x22, 0xb8, 0x7f, 0x09, 0xed, 0x71, 0x79, 0x9e, 0x07, 0xf5,
+	0x8b, 0xa2, 0xe8, 0x95, 0xab, 0x6d, 0xe2, 0xc6, 0x72, 0x41, 0x81, 0x96, 0xd8, 0xe0, 0x8e, 0x3f,
+	0x82, 0x0c, 0x18, 0xc0, 0xb7, 0x0d, 0x4c, 0x4e, 0xc4, 0x4b, 0x6e, 0x5a, 0x7b, 0xa0, 0xc8, 0x45,
+	0x66, 0xd7, 0xfb, 0xb6, 0x81, 0xc9, 0xd1, 0x58, 0xd6, 0x98, 0xc7, 0x72, 0x26, 0xae, 0x47, 0x7d,
+	0x52, 0xcd, 0xf5, 0xbb, 0x0a, 0x4c, 0xc5, 0xb8, 0xc4, 0xb3, 0xf2, 0x02, 0x9c, 0x26, 0x6c, 0x28,
+	0xb9, 0x72, 0x52, 0x1d, 0xc1, 0xaf, 0x71, 0xe9, 0x9e, 0xd5, 0x9b, 0xf5, 0x0f, 0x66, 0xe0, 0x14,
+	0xc5, 0x87, 0x4c, 0x18, 0x60, 0x9f, 0x7d, 0x50, 0x3e, 0xda, 0x22, 0x85, 0xbe, 0x29, 0xa9, 0x73,
+	0xc9, 0x02, 0x6c, 0x09, 0x6d, 0xe6, 0xad, 0x0f, 0xff, 0xf3, 0xd3, 0xcc, 0x05, 0x34, 0x56, 0x74,
+	0xb1, 0xe3, 0xf0, 0x4f, 0x61, 0x84, 0x7f, 0x25, 0x43, 0x15, 0x18, 0xa0, 0xae, 0xc5, 0x2e, 0x15,
+	0xfa, 0xbc, 0xa4, 0xce, 0x25, 0x0b, 0xf0, 0xa5, 0xc6, 0xe9, 0x52, 0xc3, 0xe8, 0x5c, 0x68, 0x29,
+	0xd4, 0x82, 0x33, 0x82, 0xcf, 0x40, 0xf3, 0x51, 0x23, 0x1d, 0xac, 0xbf, 0x9a, 0x04, 0xc4, 0x5f,
+	0x66, 0x8e, 0x2e, 0xa3, 0xa2, 0xc9, 0xb0, 0x47, 0x66, 0xc5, 0x28, 0xde, 0xf1, 0xa8, 0x8b, 0xbb,
+	0xe8, 0x9e, 0x02, 0x63, 0x71, 0xec, 0x3a, 0x5a, 0x8d, 0xda, 0xee, 0xc2, 0xc2, 0xab, 0x2b, 0x49,
+	0x2e, 0xc7, 0xf0, 0xa7, 0xda, 0x3c, 0x85, 0x35, 0x8d, 0xa6, 0xc2, 0xb0, 0x64, 0x66, 0xf4, 0xe7,
+	0x0a, 0x0c, 0x85, 0x9f, 0x4c, 0x68, 0xf1, 0xf0, 0x26, 0x98, 0x61, 0x49, 0xdd, 0x2d, 0x6b, 0x6b,
+	0x14, 0xc8, 0x0a, 0x5a, 0x0a, 0x03, 0x09, 0x9e, 0x6e, 0xc5, 0x3b, 0xe1, 0xe3, 0x7b, 0x17, 0xfd,
+	0x44, 0x01, 0x14, 0xfd, 0x04, 0x82, 0x56, 0x92, 0xc3, 0x15, 0xf9, 0x50, 0xa2, 0x2e, 0x1d, 0x06,
+	0x90, 0x1c, 0x96, 0x41, 0xa9, 0xbf, 0xff, 0x95, 0x02, 0xe7, 0x3b, 0x43, 0x8d, 0x96, 0x53, 0xa5,
+	0xe3, 0x18, 0xa9, 0x5b, 0xa7, 0x78, 0x9e, 0x45, 0xcb, 0x89, 0xa9, 0x2b, 0xde, 0x09, 0x97, 0xb2,
+	0xbb, 0xe8, 0x6f, 0x0a, 0x4c, 0x77, 0xf9, 0x5e, 0x81, 0x3e, 0x73, 0x38, 0x80, 0xe8, 0xe7, 0x8d,
+	0xa3, 0xc1, 0xde, 0xa2, 0xb0, 0x5f, 0x44, 0x5f, 0x48, 0x0f, 0x3b, 0x9a, 0xfa, 0x3f, 0x2a, 0xbc,
+	0x95, 0x97, 0x02, 0x9d, 0xb4, 0xd7, 0x22, 0x4c, 0xb5, 0xba, 0x94, 0x42, 0x92, 0xa3, 0xfd, 0x0a,
+	0x45, 0x7b, 0x05, 0x6d, 0x3d, 0x06, 0x5a, 0x4f, 0xc2, 0xb2, 0x9b, 0x77, 0xd1, 0x9f, 0x14, 0x40,
+	0x51, 0x92, 0x34, 0x6e, 0xc3, 0x26, 0xb2, 0xec, 0x47, 0xc1, 0xfe, 0x32, 0xc5, 0x7e, 0x1d, 0x5d,
+	0x7d, 0x1c, 0xec, 0x52, 0x81, 0xfa, 0xab, 0x02, 0x17, 0xe2, 0x59, 0x50, 0x54, 0x4c, 0x81, 0x4a,
+	0x7e, 0xcd, 0xa9, 0xcf, 0xa5, 0x57, 0xe0, 0xde, 0x5c, 0xa3, 0xde, 0x6c, 0xa0, 0x2f, 0x85, 0xbd,
+	0xe1, 0x0f, 0x9c, 0x23, 0x64, 0xe1, 0x1f, 0x0a, 0x4c, 0x25, 0x52, 0xd5, 0x68, 0x3d, 0x5d, 0x32,
+	0x1e, 0xd3, 0x99, 0x2f, 0x53, 0x67, 0xb6, 0xd1, 0xe6, 0x71, 0x9d, 0x91, 0xd2, 0x52, 0x83, 0x53,
+	0xec, 0x9a, 0xca, 0x25, 0xde, 0x41, 0x29, 0xef, 0xa8, 0x59, 0x8a, 0x6a, 0x02, 0x8d, 0x87, 0x51,
+	0x89, 0xc0, 0xfd, 0x4e, 0x81, 0xb1, 0x38, 0x4a, 0x30, 0xee, 0x82, 0xea, 0xc2, 0x47, 0xaa, 0x85,
+	0xb4, 0xe2, 0x1c, 0xd6, 0x0b, 0x14, 0xd6, 0x1a, 0x2a, 0x86, 0x61, 0x75, 0xb2, 0x8f, 0xd1, 0x6a,
+	0xf7, 0x63, 0x51, 0x8f, 0x25, 0x26, 0x11, 0x25, 0x1d, 0xa0, 0x28, 0x1b, 0xa9, 0x2e, 0xa7, 0x11,
+	0xe5, 0x20, 0x35, 0x0a, 0x72, 0x06, 0xa9, 0x1d, 0x2f, 0x16, 0x4f, 0xb4, 0xcc, 0x08, 0x48, 0xf4,
+	0x0b, 0x05, 0x46, 0x63, 0xe8, 0x30, 0xf4, 0x6c, 0xc2, 0x3a, 0xb1, 0xc4, 0x9a, 0xba, 0x9a, 0x52,
+	0x9a, 0x03, 0x5b, 0xa0, 0xc0, 0xf2, 0x68, 0x36, 0x0c, 0x8c, 0x70, 0xe9, 0x32, 0xe7, 0xd2, 0x5c,
+	0x38, 0x23, 0xa8, 0xa3, 0xb8, 0xf7, 0x4e, 0x07, 0x59, 0xa5, 0x6a, 0xdd, 0x44, 0xba, 0xbf, 0x2d,
+	0xf4, 0x96, 0xe3, 0x6f, 0xa9, 0xdb, 0x30, 0x28, 0x11, 0x02, 0xe8, 0x99, 0xa4, 0x80, 0xcb, 0x54,
+	0x82, 0xba, 0x70, 0x88, 0xd4, 0x21, 0x6f, 0x48, 0xb6, 0xd4, 0x3d, 0x05, 0xc6, 0x19, 0x62, 0xc3,
+	0x6b, 0xc1, 0x83, 0xb6, 0x3e, 0xf1, 0x1e, 0x89, 0x30, 0x0c, 0xea, 0x52, 0x0a, 0x49, 0x0e, 0x66,
+	0x91, 0x82, 0x99, 0x47, 0xf9, 0x8e, 0xe7, 0x9f, 0x2f, 0x59, 0xd4, 0x29, 0x0e, 0x6f, 0x8f, 0x4c,
+	0x50, 0x23, 0x57, 0x4d, 0xcb, 0x24, 0x75, 0x5c, 0x3d, 0x69, 0x64, 0x4b, 0x14, 0xd9, 0xd3, 0x68,
+	0x3e, 0x11, 0xd9, 0x1e, 0x47, 0x82, 0x7e, 0x29, 0x0a, 0x40, 0x07, 0x67, 0x90, 0x58, 0x00, 0xe2,
+	0x09, 0x08, 0xb5, 0x90, 0x56, 0xbc, 0x7b, 0xf0, 0x02, 0x8a, 0x91, 0x73, 0x09, 0xe8, 0xb7, 0x22,
+	0x78, 0x31, 0x5d, 0x79, 0x52, 0x91, 0x4e, 0x64, 0x1c, 0xd4, 0xb5, 0x23, 0x68, 0x74, 0x0f, 0x66,
+	0x0c, 0x1f, 0x81, 0x7e, 0x28, 0x9e, 0x30, 0x41, 0xdf, 0x9c, 0x98, 0xe0, 0x48, 0xc3, 0xae, 0x2e,
+	0xa5, 0x90, 0xec, 0x7e, 0x0c, 0xa5, 0x76, 0x1e, 0x7d, 0x0f, 0xce, 0xca, 0xfd, 0x25, 0x4a, 0x3a,
+	0x61, 0xe1, 0x96, 0x5a, 0xbd, 0x78, 0x98, 0x58, 0xf7, 0x7b, 0x85, 0x37, 0xa3, 0x9b, 0xd7, 0xee,
+	0x3f, 0xcc, 0x29, 0x0f, 0x1e, 0xe6, 0x94, 0x7f, 0x3f, 0xcc, 0x29, 0x6f, 0x3f, 0xca, 0xf5, 0x3d,
+	0x78, 0x94, 0xeb, 0xfb, 0xd7, 0xa3, 0x5c, 0xdf, 0xeb, 0xab, 0x12, 0x05, 0x40, 0x95, 0x56, 0xed,
+	0xbd, 0x3d, 0xd3, 0x30, 0xf5, 0x06, 0xfb, 0x59, 0xbc, 0xcd, 0xff, 0xa7, 0x6c, 0x40, 0x65, 0x80,
+	0x72, 0x95, 0xcf, 0xff, 0x6f, 0x00, 0xde, 0x55, 0x5c, 0xe9, 0x15, 0x2a, 0x00, 0x00,
 }
 
-func (m *QueryIBCFuryaDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query paginated furyas
+	Furyas(ctx context.Context, in *QueryFuryasRequest, opts ...grpc.CallOption) (*QueryFuryasResponse, error)
+	// Query a specific furya by ibc hash
+	IBCFurya(ctx context.Context, in *QueryIBCFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error)
+	// Query all paginated furya delegations
+	AllFuryasDelegations(ctx context.Context, in *QueryAllFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
+	// Query furya validator
+	FuryaValidator(ctx context.Context, in *QueryFuryaValidatorRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorResponse, error)
+	// Query all paginated furya validators
+	AllFuryaValidators(ctx context.Context, in *QueryAllFuryaValidatorsRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorsResponse, error)
+	// Query all paginated furya delegations for a delegator addr
+	FuryasDelegation(ctx context.Context, in *QueryFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
+	// Query all paginated furya delegations for a delegator addr and validator_addr
+	FuryasDelegationByValidator(ctx context.Context, in *QueryFuryasDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error)
+	// Query a delegation to an furya by delegator addr, validator_addr and denom
+	FuryaDelegation(ctx context.Context, in *QueryFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error)
+	// Query a delegation to an furya by delegator addr, validator_addr and denom
+	IBCFuryaDelegation(ctx context.Context, in *QueryIBCFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	FuryaDelegationRewards(ctx context.Context, in *QueryFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	IBCFuryaDelegationRewards(ctx context.Context, in *QueryIBCFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error)
+	// Query a specific furya by denom
+	Furya(ctx context.Context, in *QueryFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error)
+	// Query the address that furya rewards of a delegator are sent to
+	FuryaWithdrawAddress(ctx context.Context, in *QueryFuryaWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryFuryaWithdrawAddressResponse, error)
+	// Query how much the furya voting power of each bonded validator is clamped by the power share limits
+	FuryaPowerClamps(ctx context.Context, in *QueryFuryaPowerClampsRequest, opts ...grpc.CallOption) (*QueryFuryaPowerClampsResponse, error)
+	// Query the number of reward weight change snapshots stored per furya asset and validator
+	FuryaSnapshotCounts(ctx context.Context, in *QueryFuryaSnapshotCountsRequest, opts ...grpc.CallOption) (*QueryFuryaSnapshotCountsResponse, error)
+	// Query the estimated annualized rewards of an furya asset for a validator or weighted across all validators
+	FuryaAPR(ctx context.Context, in *QueryFuryaAPRRequest, opts ...grpc.CallOption) (*QueryFuryaAPRResponse, error)
+	// Query the latest prices posted by the price feeders
+	FuryaPrices(ctx context.Context, in *QueryFuryaPricesRequest, opts ...grpc.CallOption) (*QueryFuryaPricesResponse, error)
+	// Query the incentive programs that are still streaming rewards
+	FuryaActiveIncentives(ctx context.Context, in *QueryFuryaIncentivesRequest, opts ...grpc.CallOption) (*QueryFuryaIncentivesResponse, error)
+	// Query the incentive programs that have ended
+	FuryaFinishedIncentives(ctx context.Context, in *QueryFuryaIncentivesRequest, opts ...grpc.CallOption) (*QueryFuryaIncentivesResponse, error)
+	// Query the cumulative take rate revenue of every furya asset
+	FuryaTakeRateRevenue(ctx context.Context, in *QueryFuryaTakeRateRevenueRequest, opts ...grpc.CallOption) (*QueryFuryaTakeRateRevenueResponse, error)
+	// Query the rewards of validators that could not be allocated to any furya delegation yet
+	FuryaUnallocatedRewards(ctx context.Context, in *QueryFuryaUnallocatedRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaUnallocatedRewardsResponse, error)
+	// Query the reward truncation dust and the excess of the rewards pool over the outstanding rewards
+	FuryaRewardDust(ctx context.Context, in *QueryFuryaRewardDustRequest, opts ...grpc.CallOption) (*QueryFuryaRewardDustResponse, error)
+	// Query the slashing history of furya delegations by validator, by delegator and by denom
+	FuryaSlashes(ctx context.Context, in *QueryFuryaSlashesRequest, opts ...grpc.CallOption) (*QueryFuryaSlashesResponse, error)
 }
 
-func (m *QueryFuryaDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryFuryaDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryFuryaDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Furyas(ctx context.Context, in *QueryFuryasRequest, opts ...grpc.CallOption) (*QueryFuryasResponse, error) {
+	out := new(QueryFuryasResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/Furyas", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IBCFurya(ctx context.Context, in *QueryIBCFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error) {
+	out := new(QueryFuryaResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFurya", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AllFuryasDelegations(ctx context.Context, in *QueryAllFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
+	out := new(QueryFuryasDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/AllFuryasDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryIBCFuryaDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryaValidator(ctx context.Context, in *QueryFuryaValidatorRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorResponse, error) {
+	out := new(QueryFuryaValidatorResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIBCFuryaDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllFuryaValidators(ctx context.Context, in *QueryAllFuryaValidatorsRequest, opts ...grpc.CallOption) (*QueryFuryaValidatorsResponse, error) {
+	out := new(QueryFuryaValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/AllFuryaValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIBCFuryaDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) FuryasDelegation(ctx context.Context, in *QueryFuryasDelegationsRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
+	out := new(QueryFuryasDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryasDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryasDelegationByValidator(ctx context.Context, in *QueryFuryasDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryFuryasDelegationsResponse, error) {
+	out := new(QueryFuryasDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryasDelegationByValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryaDelegation(ctx context.Context, in *QueryFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error) {
+	out := new(QueryFuryaDelegationResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFuryaDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) IBCFuryaDelegation(ctx context.Context, in *QueryIBCFuryaDelegationRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationResponse, error) {
+	out := new(QueryFuryaDelegationResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFuryaDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryaDelegationRewards(ctx context.Context, in *QueryFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error) {
+	out := new(QueryFuryaDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaDelegationRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IBCFuryaDelegationRewards(ctx context.Context, in *QueryIBCFuryaDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaDelegationRewardsResponse, error) {
+	out := new(QueryFuryaDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/IBCFuryaDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalStaked) > 0 {
-		for iNdEx := len(m.TotalStaked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalStaked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ValidatorShares) > 0 {
-		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TotalDelegationShares) > 0 {
-		for iNdEx := len(m.TotalDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDelegationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (c *queryClient) Furya(ctx context.Context, in *QueryFuryaRequest, opts ...grpc.CallOption) (*QueryFuryaResponse, error) {
+	out := new(QueryFuryaResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/Furya", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) FuryaWithdrawAddress(ctx context.Context, in *QueryFuryaWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryFuryaWithdrawAddressResponse, error) {
+	out := new(QueryFuryaWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryaPowerClamps(ctx context.Context, in *QueryFuryaPowerClampsRequest, opts ...grpc.CallOption) (*QueryFuryaPowerClampsResponse, error) {
+	out := new(QueryFuryaPowerClampsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaPowerClamps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FuryaSnapshotCounts(ctx context.Context, in *QueryFuryaSnapshotCountsRequest, opts ...grpc.CallOption) (*QueryFuryaSnapshotCountsResponse, error) {
+	out := new(QueryFuryaSnapshotCountsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaSnapshotCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) FuryaAPR(ctx context.Context, in *QueryFuryaAPRRequest, opts ...grpc.CallOption) (*QueryFuryaAPRResponse, error) {
+	out := new(QueryFuryaAPRResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FuryaPrices(ctx context.Context, in *QueryFuryaPricesRequest, opts ...grpc.CallOption) (*QueryFuryaPricesResponse, error) {
+	out := new(QueryFuryaPricesResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FuryaActiveIncentives(ctx context.Context, in *QueryFuryaIncentivesRequest, opts ...grpc.CallOption) (*QueryFuryaIncentivesResponse, error) {
+	out := new(QueryFuryaIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaActiveIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryFuryasRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FuryaFinishedIncentives(ctx context.Context, in *QueryFuryaIncentivesRequest, opts ...grpc.CallOption) (*QueryFuryaIncentivesResponse, error) {
+	out := new(QueryFuryaIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaFinishedIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFuryasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Furyas) > 0 {
-		for _, e := range m.Furyas {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FuryaTakeRateRevenue(ctx context.Context, in *QueryFuryaTakeRateRevenueRequest, opts ...grpc.CallOption) (*QueryFuryaTakeRateRevenueResponse, error) {
+	out := new(QueryFuryaTakeRateRevenueResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaTakeRateRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFuryaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FuryaUnallocatedRewards(ctx context.Context, in *QueryFuryaUnallocatedRewardsRequest, opts ...grpc.CallOption) (*QueryFuryaUnallocatedRewardsResponse, error) {
+	out := new(QueryFuryaUnallocatedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaUnallocatedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFuryaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Furya != nil {
-		l = m.Furya.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FuryaRewardDust(ctx context.Context, in *QueryFuryaRewardDustRequest, opts ...grpc.CallOption) (*QueryFuryaRewardDustResponse, error) {
+	out := new(QueryFuryaRewardDustResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaRewardDust", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIBCFuryaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FuryaSlashes(ctx context.Context, in *QueryFuryaSlashesRequest, opts ...grpc.CallOption) (*QueryFuryaSlashesResponse, error) {
+	out := new(QueryFuryaSlashesResponse)
+	err := c.cc.Invoke(ctx, "/furya.furya.Query/FuryaSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFuryaValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query paginated furyas
+	Furyas(context.Context, *QueryFuryasRequest) (*QueryFuryasResponse, error)
+	// Query a specific furya by ibc hash
+	IBCFurya(context.Context, *QueryIBCFuryaRequest) (*QueryFuryaResponse, error)
+	// Query all paginated furya delegations
+	AllFuryasDelegations(context.Context, *QueryAllFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error)
+	// Query furya validator
+	FuryaValidator(context.Context, *QueryFuryaValidatorRequest) (*QueryFuryaValidatorResponse, error)
+	// Query all paginated furya validators
+	AllFuryaValidators(context.Context, *QueryAllFuryaValidatorsRequest) (*QueryFuryaValidatorsResponse, error)
+	// Query all paginated furya delegations for a delegator addr
+	FuryasDelegation(context.Context, *QueryFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error)
+	// Query all paginated furya delegations for a delegator addr and validator_addr
+	FuryasDelegationByValidator(context.Context, *QueryFuryasDelegationByValidatorRequest) (*QueryFuryasDelegationsResponse, error)
+	// Query a delegation to an furya by delegator addr, validator_addr and denom
+	FuryaDelegation(context.Context, *QueryFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error)
+	// Query a delegation to an furya by delegator addr, validator_addr and denom
+	IBCFuryaDelegation(context.Context, *QueryIBCFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	FuryaDelegationRewards(context.Context, *QueryFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	IBCFuryaDelegationRewards(context.Context, *QueryIBCFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error)
+	// Query a specific furya by denom
+	Furya(context.Context, *QueryFuryaRequest) (*QueryFuryaResponse, error)
+	// Query the address that furya rewards of a delegator are sent to
+	FuryaWithdrawAddress(context.Context, *QueryFuryaWithdrawAddressRequest) (*QueryFuryaWithdrawAddressResponse, error)
+	// Query how much the furya voting power of each bonded validator is clamped by the power share limits
+	FuryaPowerClamps(context.Context, *QueryFuryaPowerClampsRequest) (*QueryFuryaPowerClampsResponse, error)
+	// Query the number of reward weight change snapshots stored per furya asset and validator
+	FuryaSnapshotCounts(context.Context, *QueryFuryaSnapshotCountsRequest) (*QueryFuryaSnapshotCountsResponse, error)
+	// Query the estimated annualized rewards of an furya asset for a validator or weighted across all validators
+	FuryaAPR(context.Context, *QueryFuryaAPRRequest) (*QueryFuryaAPRResponse, error)
+	// Query the latest prices posted by the price feeders
+	FuryaPrices(context.Context, *QueryFuryaPricesRequest) (*QueryFuryaPricesResponse, error)
+	// Query the incentive programs that are still streaming rewards
+	FuryaActiveIncentives(context.Context, *QueryFuryaIncentivesRequest) (*QueryFuryaIncentivesResponse, error)
+	// Query the incentive programs that have ended
+	FuryaFinishedIncentives(context.Context, *QueryFuryaIncentivesRequest) (*QueryFuryaIncentivesResponse, error)
+	// Query the cumulative take rate revenue of every furya asset
+	FuryaTakeRateRevenue(context.Context, *QueryFuryaTakeRateRevenueRequest) (*QueryFuryaTakeRateRevenueResponse, error)
+	// Query the rewards of validators that could not be allocated to any furya delegation yet
+	FuryaUnallocatedRewards(context.Context, *QueryFuryaUnallocatedRewardsRequest) (*QueryFuryaUnallocatedRewardsResponse, error)
+	// Query the reward truncation dust and the excess of the rewards pool over the outstanding rewards
+	FuryaRewardDust(context.Context, *QueryFuryaRewardDustRequest) (*QueryFuryaRewardDustResponse, error)
+	// Query the slashing history of furya delegations by validator, by delegator and by denom
+	FuryaSlashes(context.Context, *QueryFuryaSlashesRequest) (*QueryFuryaSlashesResponse, error)
 }
 
-func (m *QueryAllFuryaValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllFuryasDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryFuryasDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) Furyas(ctx context.Context, req *QueryFuryasRequest) (*QueryFuryasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Furyas not implemented")
 }
-
-func (m *QueryFuryasDelegationByValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) IBCFurya(ctx context.Context, req *QueryIBCFuryaRequest) (*QueryFuryaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCFurya not implemented")
 }
-
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) AllFuryasDelegations(ctx context.Context, req *QueryAllFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllFuryasDelegations not implemented")
 }
-
-func (m *QueryFuryasDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) FuryaValidator(ctx context.Context, req *QueryFuryaValidatorRequest) (*QueryFuryaValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaValidator not implemented")
+}
+func (*UnimplementedQueryServer) AllFuryaValidators(ctx context.Context, req *QueryAllFuryaValidatorsRequest) (*QueryFuryaValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllFuryaValidators not implemented")
+}
+func (*UnimplementedQueryServer) FuryasDelegation(ctx context.Context, req *QueryFuryasDelegationsRequest) (*QueryFuryasDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryasDelegation not implemented")
+}
+func (*UnimplementedQueryServer) FuryasDelegationByValidator(ctx context.Context, req *QueryFuryasDelegationByValidatorRequest) (*QueryFuryasDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryasDelegationByValidator not implemented")
+}
+func (*UnimplementedQueryServer) FuryaDelegation(ctx context.Context, req *QueryFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaDelegation not implemented")
+}
+func (*UnimplementedQueryServer) IBCFuryaDelegation(ctx context.Context, req *QueryIBCFuryaDelegationRequest) (*QueryFuryaDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCFuryaDelegation not implemented")
+}
+func (*UnimplementedQueryServer) FuryaDelegationRewards(ctx context.Context, req *QueryFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) IBCFuryaDelegationRewards(ctx context.Context, req *QueryIBCFuryaDelegationRewardsRequest) (*QueryFuryaDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCFuryaDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) Furya(ctx context.Context, req *QueryFuryaRequest) (*QueryFuryaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Furya not implemented")
+}
+func (*UnimplementedQueryServer) FuryaWithdrawAddress(ctx context.Context, req *QueryFuryaWithdrawAddressRequest) (*QueryFuryaWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) FuryaPowerClamps(ctx context.Context, req *QueryFuryaPowerClampsRequest) (*QueryFuryaPowerClampsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaPowerClamps not implemented")
+}
+func (*UnimplementedQueryServer) FuryaSnapshotCounts(ctx context.Context, req *QueryFuryaSnapshotCountsRequest) (*QueryFuryaSnapshotCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaSnapshotCounts not implemented")
+}
+func (*UnimplementedQueryServer) FuryaAPR(ctx context.Context, req *QueryFuryaAPRRequest) (*QueryFuryaAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaAPR not implemented")
+}
+func (*UnimplementedQueryServer) FuryaPrices(ctx context.Context, req *QueryFuryaPricesRequest) (*QueryFuryaPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaPrices not implemented")
+}
+func (*UnimplementedQueryServer) FuryaActiveIncentives(ctx context.Context, req *QueryFuryaIncentivesRequest) (*QueryFuryaIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaActiveIncentives not implemented")
+}
+func (*UnimplementedQueryServer) FuryaFinishedIncentives(ctx context.Context, req *QueryFuryaIncentivesRequest) (*QueryFuryaIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaFinishedIncentives not implemented")
+}
+func (*UnimplementedQueryServer) FuryaTakeRateRevenue(ctx context.Context, req *QueryFuryaTakeRateRevenueRequest) (*QueryFuryaTakeRateRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaTakeRateRevenue not implemented")
+}
+func (*UnimplementedQueryServer) FuryaUnallocatedRewards(ctx context.Context, req *QueryFuryaUnallocatedRewardsRequest) (*QueryFuryaUnallocatedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaUnallocatedRewards not implemented")
+}
+func (*UnimplementedQueryServer) FuryaRewardDust(ctx context.Context, req *QueryFuryaRewardDustRequest) (*QueryFuryaRewardDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaRewardDust not implemented")
+}
+func (*UnimplementedQueryServer) FuryaSlashes(ctx context.Context, req *QueryFuryaSlashesRequest) (*QueryFuryaSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuryaSlashes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFuryaDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_Furyas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Furyas(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/Furyas",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Furyas(ctx, req.(*QueryFuryasRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIBCFuryaDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_IBCFurya_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCFuryaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).IBCFurya(ctx, in)
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/IBCFurya",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCFurya(ctx, req.(*QueryIBCFuryaRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFuryaDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllFuryasDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFuryasDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).AllFuryasDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/AllFuryasDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllFuryasDelegations(ctx, req.(*QueryAllFuryasDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFuryaDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_FuryaValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaValidator(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaValidator",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaValidator(ctx, req.(*QueryFuryaValidatorRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIBCFuryaDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_AllFuryaValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFuryaValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AllFuryaValidators(ctx, in)
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/AllFuryaValidators",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllFuryaValidators(ctx, req.(*QueryAllFuryaValidatorsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFuryaDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FuryasDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryasDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryasDelegation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryasDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryasDelegation(ctx, req.(*QueryFuryasDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFuryaValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FuryasDelegationByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryasDelegationByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FuryasDelegationByValidator(ctx, in)
 	}
-	if len(m.TotalDelegationShares) > 0 {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryasDelegationByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryasDelegationByValidator(ctx, req.(*QueryFuryasDelegationByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaDelegation(ctx, req.(*QueryFuryaDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCFuryaDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCFuryaDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCFuryaDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/IBCFuryaDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCFuryaDelegation(ctx, req.(*QueryIBCFuryaDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaDelegationRewards(ctx, req.(*QueryFuryaDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCFuryaDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCFuryaDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCFuryaDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/IBCFuryaDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCFuryaDelegationRewards(ctx, req.(*QueryIBCFuryaDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Furya_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Furya(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/Furya",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Furya(ctx, req.(*QueryFuryaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaWithdrawAddress(ctx, req.(*QueryFuryaWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaPowerClamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaPowerClampsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaPowerClamps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaPowerClamps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaPowerClamps(ctx, req.(*QueryFuryaPowerClampsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaSnapshotCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaSnapshotCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaSnapshotCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaSnapshotCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaSnapshotCounts(ctx, req.(*QueryFuryaSnapshotCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaAPR(ctx, req.(*QueryFuryaAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaPrices(ctx, req.(*QueryFuryaPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaActiveIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaActiveIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaActiveIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaActiveIncentives(ctx, req.(*QueryFuryaIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaFinishedIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaFinishedIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaFinishedIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaFinishedIncentives(ctx, req.(*QueryFuryaIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaTakeRateRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaTakeRateRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaTakeRateRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaTakeRateRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaTakeRateRevenue(ctx, req.(*QueryFuryaTakeRateRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaUnallocatedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaUnallocatedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaUnallocatedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaUnallocatedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaUnallocatedRewards(ctx, req.(*QueryFuryaUnallocatedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaRewardDust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaRewardDustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaRewardDust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaRewardDust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaRewardDust(ctx, req.(*QueryFuryaRewardDustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FuryaSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFuryaSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FuryaSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.furya.Query/FuryaSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FuryaSlashes(ctx, req.(*QueryFuryaSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "furya.furya.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Furyas",
+			Handler:    _Query_Furyas_Handler,
+		},
+		{
+			MethodName: "IBCFurya",
+			Handler:    _Query_IBCFurya_Handler,
+		},
+		{
+			MethodName: "AllFuryasDelegations",
+			Handler:    _Query_AllFuryasDelegations_Handler,
+		},
+		{
+			MethodName: "FuryaValidator",
+			Handler:    _Query_FuryaValidator_Handler,
+		},
+		{
+			MethodName: "AllFuryaValidators",
+			Handler:    _Query_AllFuryaValidators_Handler,
+		},
+		{
+			MethodName: "FuryasDelegation",
+			Handler:    _Query_FuryasDelegation_Handler,
+		},
+		{
+			MethodName: "FuryasDelegationByValidator",
+			Handler:    _Query_FuryasDelegationByValidator_Handler,
+		},
+		{
+			MethodName: "FuryaDelegation",
+			Handler:    _Query_FuryaDelegation_Handler,
+		},
+		{
+			MethodName: "IBCFuryaDelegation",
+			Handler:    _Query_IBCFuryaDelegation_Handler,
+		},
+		{
+			MethodName: "FuryaDelegationRewards",
+			Handler:    _Query_FuryaDelegationRewards_Handler,
+		},
+		{
+			MethodName: "IBCFuryaDelegationRewards",
+			Handler:    _Query_IBCFuryaDelegationRewards_Handler,
+		},
+		{
+			MethodName: "Furya",
+			Handler:    _Query_Furya_Handler,
+		},
+		{
+			MethodName: "FuryaWithdrawAddress",
+			Handler:    _Query_FuryaWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "FuryaPowerClamps",
+			Handler:    _Query_FuryaPowerClamps_Handler,
+		},
+		{
+			MethodName: "FuryaSnapshotCounts",
+			Handler:    _Query_FuryaSnapshotCounts_Handler,
+		},
+		{
+			MethodName: "FuryaAPR",
+			Handler:    _Query_FuryaAPR_Handler,
+		},
+		{
+			MethodName: "FuryaPrices",
+			Handler:    _Query_FuryaPrices_Handler,
+		},
+		{
+			MethodName: "FuryaActiveIncentives",
+			Handler:    _Query_FuryaActiveIncentives_Handler,
+		},
+		{
+			MethodName: "FuryaFinishedIncentives",
+			Handler:    _Query_FuryaFinishedIncentives_Handler,
+		},
+		{
+			MethodName: "FuryaTakeRateRevenue",
+			Handler:    _Query_FuryaTakeRateRevenue_Handler,
+		},
+		{
+			MethodName: "FuryaUnallocatedRewards",
+			Handler:    _Query_FuryaUnallocatedRewards_Handler,
+		},
+		{
+			MethodName: "FuryaRewardDust",
+			Handler:    _Query_FuryaRewardDust_Handler,
+		},
+		{
+			MethodName: "FuryaSlashes",
+			Handler:    _Query_FuryaSlashes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "furya/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Furyas) > 0 {
+		for iNdEx := len(m.Furyas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Furyas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedRewardWeights) > 0 {
+		for iNdEx := len(m.ProjectedRewardWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedRewardWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RemainingCapacity != nil {
+		{
+			size := m.RemainingCapacity.Size()
+			i -= size
+			if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Furya != nil {
+		{
+			size, err := m.Furya.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedRewardWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedRewardWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedRewardWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCFuryaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCFuryaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCFuryaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFuryaValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFuryaValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFuryaValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFuryasDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFuryasDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFuryasDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryasDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryasDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryasDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryasDelegationByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryasDelegationByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryasDelegationByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryasDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryasDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryasDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCFuryaDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCFuryaDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCFuryaDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCFuryaDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCFuryaDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCFuryaDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalStaked) > 0 {
+		for iNdEx := len(m.TotalStaked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalStaked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorShares) > 0 {
+		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalDelegationShares) > 0 {
+		for iNdEx := len(m.TotalDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaPowerClampsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaPowerClampsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaPowerClampsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaPowerClampsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaPowerClampsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaPowerClampsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clamps) > 0 {
+		for iNdEx := len(m.Clamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FuryaPowerClamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaPowerClamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaPowerClamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClampedAmount.Size()
+		i -= size
+		if _, err := m.ClampedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondAmount.Size()
+		i -= size
+		if _, err := m.BondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExpectedBondAmount.Size()
+		i -= size
+		if _, err := m.ExpectedBondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaSnapshotCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaSnapshotCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaSnapshotCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaSnapshotCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaSnapshotCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaSnapshotCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Counts) > 0 {
+		for iNdEx := len(m.Counts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FuryaSnapshotCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaSnapshotCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaSnapshotCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestClaimHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestClaimHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TakeRateApr.Size()
+		i -= size
+		if _, err := m.TakeRateApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RewardsApr) > 0 {
+		for iNdEx := len(m.RewardsApr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsApr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FuryaValidatorAPR) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FuryaValidatorAPR) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FuryaValidatorAPR) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SampleDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SampleDuration):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardsApr) > 0 {
+		for iNdEx := len(m.RewardsApr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsApr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Incentives) > 0 {
+		for iNdEx := len(m.Incentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Incentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaTakeRateRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaTakeRateRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaTakeRateRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaTakeRateRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaTakeRateRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaTakeRateRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaUnallocatedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaUnallocatedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaUnallocatedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaUnallocatedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaUnallocatedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaUnallocatedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnallocatedRewards) > 0 {
+		for iNdEx := len(m.UnallocatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnallocatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUnallocatedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUnallocatedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUnallocatedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaRewardDustRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaRewardDustRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaRewardDustRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaRewardDustResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaRewardDustResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaRewardDustResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Excess) > 0 {
+		for iNdEx := len(m.Excess) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Excess[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RewardDust.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFuryaSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFuryaSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFuryaSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFuryasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Furyas) > 0 {
+		for _, e := range m.Furyas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Furya != nil {
+		l = m.Furya.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingCapacity != nil {
+		l = m.RemainingCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ProjectedRewardWeights) > 0 {
+		for _, e := range m.ProjectedRewardWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedRewardWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCFuryaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFuryaValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFuryasDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryasDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryasDelegationByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFuryasDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCFuryaDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFuryaDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCFuryaDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TotalDelegationShares) > 0 {
 		for _, e := range m.TotalDelegationShares {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.ValidatorShares) > 0 {
-		for _, e := range m.ValidatorShares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorShares) > 0 {
+		for _, e := range m.ValidatorShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalStaked) > 0 {
+		for _, e := range m.TotalStaked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaPowerClampsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaPowerClampsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clamps) > 0 {
+		for _, e := range m.Clamps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FuryaPowerClamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ExpectedBondAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClampedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFuryaSnapshotCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaSnapshotCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counts) > 0 {
+		for _, e := range m.Counts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *FuryaSnapshotCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.OldestClaimHeight != 0 {
+		n += 1 + sovQuery(uint64(m.OldestClaimHeight))
+	}
+	return n
+}
+
+func (m *QueryFuryaAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsApr) > 0 {
+		for _, e := range m.RewardsApr {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TakeRateApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FuryaValidatorAPR) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.RewardsApr) > 0 {
+		for _, e := range m.RewardsApr {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SampleDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFuryaPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaTakeRateRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaTakeRateRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaUnallocatedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaUnallocatedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnallocatedRewards) > 0 {
+		for _, e := range m.UnallocatedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorUnallocatedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaRewardDustRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFuryaRewardDustResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardDust.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Excess) > 0 {
+		for _, e := range m.Excess {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFuryaSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFuryaSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Furyas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Furyas = append(m.Furyas, FuryaAsset{})
+			if err := m.Furyas[len(m.Furyas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Furya", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Furya == nil {
+				m.Furya = &FuryaAsset{}
+			}
+			if err := m.Furya.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.RemainingCapacity = &v
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedRewardWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedRewardWeights = append(m.ProjectedRewardWeights, ProjectedRewardWeight{})
+			if err := m.ProjectedRewardWeights[len(m.ProjectedRewardWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedRewardWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedRewardWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedRewardWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCFuryaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCFuryaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCFuryaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFuryaValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFuryaValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFuryaValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFuryasDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFuryasDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryasDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryasDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryasDelegationByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryasDelegationByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryasDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryasDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCFuryaDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCFuryaDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.TotalStaked) > 0 {
-		for _, e := range m.TotalStaked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryFuryaValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2952,25 +8189,157 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCFuryaDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCFuryaDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2992,7 +8361,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,15 +8384,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3050,7 +8419,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3075,7 +8445,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryasRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3098,15 +8468,47 @@ func (m *QueryFuryasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3133,10 +8535,76 @@ func (m *QueryFuryasRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TotalDelegationShares = append(m.TotalDelegationShares, types.DecCoin{})
+			if err := m.TotalDelegationShares[len(m.TotalDelegationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
+			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalStaked = append(m.TotalStaked, types.DecCoin{})
+			if err := m.TotalStaked[len(m.TotalStaked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3161,7 +8629,7 @@ func (m *QueryFuryasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3184,15 +8652,15 @@ func (m *QueryFuryasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Furyas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3219,8 +8687,8 @@ func (m *QueryFuryasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Furyas = append(m.Furyas, FuryaAsset{})
-			if err := m.Furyas[len(m.Furyas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, QueryFuryaValidatorResponse{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3281,7 +8749,89 @@ func (m *QueryFuryasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaWithdrawAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3304,15 +8854,15 @@ func (m *QueryFuryaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3340,7 +8890,7 @@ func (m *QueryFuryaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3363,7 +8913,7 @@ func (m *QueryFuryaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaPowerClampsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3386,48 +8936,12 @@ func (m *QueryFuryaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaPowerClampsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaPowerClampsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Furya", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Furya == nil {
-				m.Furya = &FuryaAsset{}
-			}
-			if err := m.Furya.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3449,7 +8963,7 @@ func (m *QueryFuryaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCFuryaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaPowerClampsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3472,17 +8986,17 @@ func (m *QueryIBCFuryaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCFuryaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaPowerClampsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCFuryaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaPowerClampsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3492,23 +9006,25 @@ func (m *QueryIBCFuryaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Clamps = append(m.Clamps, FuryaPowerClamp{})
+			if err := m.Clamps[len(m.Clamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3531,7 +9047,7 @@ func (m *QueryIBCFuryaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *FuryaPowerClamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3554,15 +9070,15 @@ func (m *QueryFuryaValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FuryaPowerClamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FuryaPowerClamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3590,63 +9106,81 @@ func (m *QueryFuryaValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedBondAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllFuryaValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.ExpectedBondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFuryaValidatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFuryaValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3656,25 +9190,23 @@ func (m *QueryAllFuryaValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClampedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3699,7 +9231,7 @@ func (m *QueryAllFuryaValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaSnapshotCountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3722,48 +9254,12 @@ func (m *QueryAllFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFuryasDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaSnapshotCountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFuryasDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaSnapshotCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3785,7 +9281,7 @@ func (m *QueryAllFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaSnapshotCountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3808,17 +9304,17 @@ func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryasDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaSnapshotCountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryasDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaSnapshotCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3828,29 +9324,31 @@ func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Counts = append(m.Counts, FuryaSnapshotCount{})
+			if err := m.Counts[len(m.Counts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3860,28 +9358,11 @@ func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3903,7 +9384,7 @@ func (m *QueryFuryasDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *FuryaSnapshotCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,15 +9407,15 @@ func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryasDelegationByValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FuryaSnapshotCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryasDelegationByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FuryaSnapshotCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3962,11 +9443,11 @@ func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3994,13 +9475,13 @@ func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4010,28 +9491,30 @@ func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestClaimHeight", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldestClaimHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestClaimHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4053,7 +9536,7 @@ func (m *QueryFuryasDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4076,17 +9559,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4096,30 +9579,29 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4129,24 +9611,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4169,7 +9650,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4192,15 +9673,15 @@ func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryasDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryasDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsApr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4227,14 +9708,48 @@ func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardsApr = append(m.RewardsApr, types.DecCoin{})
+			if err := m.RewardsApr[len(m.RewardsApr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateApr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRateApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4261,10 +9776,8 @@ func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, FuryaValidatorAPR{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4289,7 +9802,7 @@ func (m *QueryFuryasDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *FuryaValidatorAPR) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4312,15 +9825,15 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FuryaValidatorAPR: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FuryaValidatorAPR: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4348,13 +9861,13 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsApr", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4364,27 +9877,29 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.RewardsApr = append(m.RewardsApr, types.DecCoin{})
+			if err := m.RewardsApr[len(m.RewardsApr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4412,11 +9927,146 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SampleDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4443,10 +10093,8 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, FuryaPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4471,7 +10119,7 @@ func (m *QueryFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaIncentivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4494,17 +10142,17 @@ func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCFuryaDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaIncentivesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCFuryaDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4514,61 +10162,83 @@ func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4578,25 +10248,27 @@ func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Incentives = append(m.Incentives, FuryaIncentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4626,7 +10298,7 @@ func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4653,7 +10325,7 @@ func (m *QueryIBCFuryaDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaTakeRateRevenueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4676,45 +10348,12 @@ func (m *QueryFuryaDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaTakeRateRevenueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaTakeRateRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4736,7 +10375,7 @@ func (m *QueryFuryaDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaTakeRateRevenueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4759,17 +10398,17 @@ func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaTakeRateRevenueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaTakeRateRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4779,91 +10418,129 @@ func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			m.Revenues = append(m.Revenues, types.Coin{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaUnallocatedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaUnallocatedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaUnallocatedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaUnallocatedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaUnallocatedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaUnallocatedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnallocatedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4890,10 +10567,8 @@ func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnallocatedRewards = append(m.UnallocatedRewards, ValidatorUnallocatedRewards{})
+			if err := m.UnallocatedRewards[len(m.UnallocatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4918,7 +10593,7 @@ func (m *QueryFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUnallocatedRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4941,15 +10616,15 @@ func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCFuryaDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUnallocatedRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCFuryaDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUnallocatedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4977,13 +10652,13 @@ func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4993,92 +10668,76 @@ func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFuryaRewardDustRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFuryaRewardDustRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFuryaRewardDustRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5100,7 +10759,7 @@ func (m *QueryIBCFuryaDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaRewardDustResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5123,15 +10782,15 @@ func (m *QueryFuryaDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaRewardDustResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaRewardDustResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDust", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5158,8 +10817,41 @@ func (m *QueryFuryaDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardDust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Excess = append(m.Excess, types.Coin{})
+			if err := m.Excess[len(m.Excess)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5184,7 +10876,7 @@ func (m *QueryFuryaDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5207,10 +10899,10 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5247,9 +10939,9 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5259,31 +10951,29 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegationShares = append(m.TotalDelegationShares, types.DecCoin{})
-			if err := m.TotalDelegationShares[len(m.TotalDelegationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5293,29 +10983,27 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
-			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5342,8 +11030,10 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalStaked = append(m.TotalStaked, types.DecCoin{})
-			if err := m.TotalStaked[len(m.TotalStaked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5368,7 +11058,7 @@ func (m *QueryFuryaValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFuryaValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFuryaSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5391,15 +11081,15 @@ func (m *QueryFuryaValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFuryaValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFuryaSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFuryaValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFuryaSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5426,8 +11116,8 @@ func (m *QueryFuryaValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, QueryFuryaValidatorResponse{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, FuryaSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
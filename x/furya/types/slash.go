@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Denoms returns the denoms of the furya assets affected by the slash
+func (s FuryaSlash) Denoms() map[string]bool {
+	denoms := make(map[string]bool)
+	for _, shares := range s.SlashedValidatorShares {
+		denoms[shares.Denom] = true
+	}
+	for _, redelegation := range s.Redelegations {
+		denoms[redelegation.Amount.Denom] = true
+	}
+	for _, undelegation := range s.Undelegations {
+		denoms[undelegation.Amount.Denom] = true
+	}
+	return denoms
+}
+
+// EffectiveFraction returns the fraction the delegations of the denom were slashed by
+func (s FuryaSlash) EffectiveFraction(denom string) sdk.Dec {
+	for _, f := range s.EffectiveFractions {
+		if f.Denom == denom {
+			return f.Fraction
+		}
+	}
+	return s.Fraction
+}
+
+// HasDelegatorEntries returns true if redelegations or undelegations of the delegator were slashed.
+// An empty denom matches entries of every denom.
+func (s FuryaSlash) HasDelegatorEntries(delAddr sdk.AccAddress, denom string) bool {
+	for _, redelegation := range s.Redelegations {
+		if redelegation.DelegatorAddress == delAddr.String() && (denom == "" || redelegation.Amount.Denom == denom) {
+			return true
+		}
+	}
+	for _, undelegation := range s.Undelegations {
+		if undelegation.DelegatorAddress == delAddr.String() && (denom == "" || undelegation.Amount.Denom == denom) {
+			return true
+		}
+	}
+	return false
+}
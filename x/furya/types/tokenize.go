@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizedDenomPrefix is the prefix of all bank denoms that represent tokenized furya delegation shares
+const TokenizedDenomPrefix = ModuleName + "/"
+
+// GetTokenizedDenom returns the bank denom that represents tokenized shares of a validator for a furya asset
+func GetTokenizedDenom(valAddr sdk.ValAddress, denom string) string {
+	return fmt.Sprintf("%s%s/%s", TokenizedDenomPrefix, valAddr.String(), denom)
+}
+
+func IsTokenizedDenom(denom string) bool {
+	return strings.HasPrefix(denom, TokenizedDenomPrefix)
+}
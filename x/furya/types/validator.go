@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"golang.org/x/exp/slices"
 )
 
 type FuryaValidator struct {
@@ -73,3 +74,42 @@ func (v FuryaValidator) TotalDecTokensWithAsset(asset FuryaAsset) sdk.Dec {
 	shares := v.ValidatorSharesWithDenom(asset.Denom)
 	return ConvertNewShareToDecToken(sdk.NewDecFromInt(asset.TotalTokens), asset.TotalValidatorShares, shares)
 }
+
+// IncentiveRewardHistory returns the reward indices of the incentives of an asset delegated to the validator
+func (v FuryaValidator) IncentiveRewardHistory(denom string) RewardHistories {
+	for _, history := range v.IncentiveRewardHistories {
+		if history.Denom == denom {
+			return history.RewardHistory
+		}
+	}
+	return RewardHistories{}
+}
+
+// AddIncentiveRewards increments the incentive reward indices of an asset by the rewards per token
+func (v *FuryaValidator) AddIncentiveRewards(denom string, rewardsPerToken sdk.DecCoins) {
+	idx := slices.IndexFunc(v.IncentiveRewardHistories, func(h AssetRewardHistory) bool {
+		return h.Denom == denom
+	})
+	if idx < 0 {
+		v.IncentiveRewardHistories = append(v.IncentiveRewardHistories, AssetRewardHistory{Denom: denom})
+		idx = len(v.IncentiveRewardHistories) - 1
+	}
+	histories := make(RewardHistories, len(v.IncentiveRewardHistories[idx].RewardHistory))
+	copy(histories, v.IncentiveRewardHistories[idx].RewardHistory)
+	for _, reward := range rewardsPerToken {
+		if history, found := histories.GetIndexByDenom(reward.Denom); found {
+			history.Index = history.Index.Add(reward.Amount)
+		} else {
+			histories = append(histories, RewardHistory{Denom: reward.Denom, Index: reward.Amount})
+		}
+	}
+	v.IncentiveRewardHistories[idx].RewardHistory = histories
+}
+
+// IsDenomAllowed returns false if the denom is blocked or if there is an allow list that does not contain the denom
+func (p ValidatorFuryaPreferences) IsDenomAllowed(denom string) bool {
+	if slices.Contains(p.BlockedDenoms, denom) {
+		return false
+	}
+	return len(p.AllowedDenoms) == 0 || slices.Contains(p.AllowedDenoms, denom)
+}